@@ -0,0 +1,39 @@
+package communication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//DevEmailService is a fake email service that logs the email that should be sent,
+// if a Directory is set the email is written to it as an .eml file that can be opened with a mail client
+type DevEmailService struct {
+	Directory string
+	From      string
+}
+
+//Send sends an email
+func (s *DevEmailService) Send(recipients []string, subject string, textBody string, htmlBody string) (err error) {
+	if s.Directory == "" {
+		log.Infof("In production an email would be sent to %s with subject %q and the following content:\n%s", strings.Join(recipients, ", "), subject, textBody)
+		return
+	}
+	now := time.Now()
+	message, err := buildEmailMessage(s.From, recipients, subject, textBody, htmlBody, now)
+	if err != nil {
+		log.Error("Error creating email: ", err)
+		return
+	}
+	filename := filepath.Join(s.Directory, fmt.Sprintf("%d.eml", now.UnixNano()))
+	if err = ioutil.WriteFile(filename, message, 0600); err != nil {
+		log.Error("Error writing email: ", err)
+		return
+	}
+	log.Infof("In production an email would be sent to %s, it is written to %s", strings.Join(recipients, ", "), filename)
+	return
+}
@@ -0,0 +1,116 @@
+package communication
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//EmailService defines an email communication channel
+type EmailService interface {
+	Send(recipients []string, subject string, textBody string, htmlBody string) (err error)
+}
+
+//SMTPEmailService is an email communication channel using an smtp server
+type SMTPEmailService struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+//Send sends an email, STARTTLS is used if the smtp server supports it
+func (s *SMTPEmailService) Send(recipients []string, subject string, textBody string, htmlBody string) (err error) {
+	sender, err := envelopeSender(s.From)
+	if err != nil {
+		log.Error("Invalid from address: ", err)
+		return
+	}
+	message, err := buildEmailMessage(s.From, recipients, subject, textBody, htmlBody, time.Now())
+	if err != nil {
+		log.Error("Error creating email: ", err)
+		return
+	}
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+	err = smtp.SendMail(net.JoinHostPort(s.Host, strconv.Itoa(s.Port)), auth, sender, recipients, message)
+	if err != nil {
+		log.Error("Error sending email via smtp: ", err)
+	}
+	return
+}
+
+//envelopeSender returns the bare address of a from address like "itsyou.online <noreply@itsyou.online>",
+// the smtp envelope only takes the address while the From header keeps the display name
+func envelopeSender(from string) (sender string, err error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return
+	}
+	sender = address.Address
+	return
+}
+
+//buildEmailMessage creates a multipart/alternative email with a text and an html body
+func buildEmailMessage(from string, recipients []string, subject string, textBody string, htmlBody string, date time.Time) (message []byte, err error) {
+	if len(recipients) == 0 {
+		err = errors.New("No recipients")
+		return
+	}
+	for _, address := range append([]string{from}, recipients...) {
+		if strings.ContainsAny(address, "\r\n") {
+			err = fmt.Errorf("Invalid email address %q", address)
+			return
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	body := multipart.NewWriter(buffer)
+	fmt.Fprintf(buffer, "From: %s\r\n", from)
+	fmt.Fprintf(buffer, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(buffer, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(buffer, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(buffer, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", body.Boundary())
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", textBody},
+		{"text/html; charset=utf-8", htmlBody},
+	} {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		var partWriter io.Writer
+		if partWriter, err = body.CreatePart(header); err != nil {
+			return
+		}
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err = encoder.Write([]byte(part.content)); err != nil {
+			return
+		}
+		if err = encoder.Close(); err != nil {
+			return
+		}
+	}
+	if err = body.Close(); err != nil {
+		return
+	}
+	message = buffer.Bytes()
+	return
+}
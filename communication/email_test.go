@@ -0,0 +1,58 @@
+package communication
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildEmailMessage(t *testing.T) {
+	date := time.Date(2016, 5, 1, 12, 0, 0, 0, time.UTC)
+	message, err := buildEmailMessage("noreply@itsyou.online", []string{"bob@example.com"}, "Héllo", "text body", "<p>html body</p>", date)
+	assert.NoError(t, err)
+	content := string(message)
+	assert.True(t, strings.HasPrefix(content, "From: noreply@itsyou.online\r\nTo: bob@example.com\r\n"))
+	assert.Contains(t, content, "Subject: =?utf-8?q?H=C3=A9llo?=\r\n")
+	assert.Contains(t, content, "Date: Sun, 01 May 2016 12:00:00 +0000\r\n")
+	assert.Contains(t, content, "Content-Type: multipart/alternative; boundary=")
+	assert.Contains(t, content, "text body")
+	assert.Contains(t, content, "<p>html body</p>")
+
+	_, err = buildEmailMessage("noreply@itsyou.online", []string{"bob@example.com\r\nBcc: eve@example.com"}, "Hello", "", "", date)
+	assert.Error(t, err)
+	_, err = buildEmailMessage("noreply@itsyou.online", nil, "Hello", "", "", date)
+	assert.Error(t, err)
+}
+
+func TestEnvelopeSender(t *testing.T) {
+	sender, err := envelopeSender("itsyou.online <noreply@itsyou.online>")
+	assert.NoError(t, err)
+	assert.Equal(t, "noreply@itsyou.online", sender)
+
+	sender, err = envelopeSender("noreply@itsyou.online")
+	assert.NoError(t, err)
+	assert.Equal(t, "noreply@itsyou.online", sender)
+
+	_, err = envelopeSender("itsyou.online")
+	assert.Error(t, err)
+
+	message, err := buildEmailMessage("itsyou.online <noreply@itsyou.online>", []string{"bob@example.com"}, "Hello", "", "", time.Now())
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(message), "From: itsyou.online <noreply@itsyou.online>\r\n"))
+}
+
+func TestEmailTemplateRender(t *testing.T) {
+	data := struct {
+		Organization string
+		Role         string
+		Link         string
+	}{"<acme>", "member", "https://itsyou.online/"}
+	subject, textBody, htmlBody, err := InvitationEmail.Render(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "You are invited to join <acme> on itsyou.online", subject)
+	assert.Contains(t, textBody, "the organization <acme> as member")
+	assert.Contains(t, htmlBody, "<b>&lt;acme&gt;</b>")
+	assert.Contains(t, htmlBody, "<html>")
+}
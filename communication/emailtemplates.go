@@ -0,0 +1,108 @@
+package communication
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+//emailLayout is the html around the html body of every email
+const emailLayout = `<!DOCTYPE html>
+<html>
+<head>
+<meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+</head>
+<body style="font-family: Arial, sans-serif; color: #333333;">
+<h2 style="color: #3091bb;">It's You Online</h2>
+{{template "content" .}}
+<p style="color: #999999; font-size: small;">This email was sent by itsyou.online, you can not reply to it.</p>
+</body>
+</html>
+`
+
+//EmailTemplate is an email of which the subject, text body and html body are rendered with the same data
+type EmailTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+//NewEmailTemplate parses the templates of an email, it panics if they are invalid
+func NewEmailTemplate(subject, text, html string) *EmailTemplate {
+	return &EmailTemplate{
+		subject: texttemplate.Must(texttemplate.New("subject").Parse(subject)),
+		text:    texttemplate.Must(texttemplate.New("text").Parse(text)),
+		html:    htmltemplate.Must(htmltemplate.Must(htmltemplate.New("layout").Parse(emailLayout)).New("content").Parse(html)),
+	}
+}
+
+//Render renders the subject and bodies of an email
+func (t *EmailTemplate) Render(data interface{}) (subject, textBody, htmlBody string, err error) {
+	buffer := &bytes.Buffer{}
+	if err = t.subject.Execute(buffer, data); err != nil {
+		return
+	}
+	subject = buffer.String()
+	buffer.Reset()
+	if err = t.text.Execute(buffer, data); err != nil {
+		return
+	}
+	textBody = buffer.String()
+	buffer.Reset()
+	if err = t.html.ExecuteTemplate(buffer, "layout", data); err != nil {
+		return
+	}
+	htmlBody = buffer.String()
+	return
+}
+
+//SendTemplate renders an email template and sends it
+func SendTemplate(service EmailService, recipients []string, t *EmailTemplate, data interface{}) (err error) {
+	subject, textBody, htmlBody, err := t.Render(data)
+	if err != nil {
+		return
+	}
+	err = service.Send(recipients, subject, textBody, htmlBody)
+	return
+}
+
+//PasswordResetEmail is sent to a user that forgot the password, the data has a Link and an ExpirationMinutes
+var PasswordResetEmail = NewEmailTemplate(
+	"Reset your itsyou.online password",
+	`Someone requested to reset the password of your itsyou.online account.
+
+To choose a new password open the following link within {{.ExpirationMinutes}} minutes:
+{{.Link}}
+
+If this was not you, you can ignore this email.
+`,
+	`<p>Someone requested to reset the password of your itsyou.online account.</p>
+<p>To choose a new password open the following link within {{.ExpirationMinutes}} minutes:<br>
+<a href="{{.Link}}">Reset password</a></p>
+<p>If this was not you, you can ignore this email.</p>`)
+
+//InvitationEmail is sent to a user that is invited to join an organization, the data has an Organization, a Role and a Link
+var InvitationEmail = NewEmailTemplate(
+	"You are invited to join {{.Organization}} on itsyou.online",
+	`You are invited to join the organization {{.Organization}} as {{.Role}} on itsyou.online.
+
+Open the following link to accept or reject the invitation:
+{{.Link}}
+`,
+	`<p>You are invited to join the organization <b>{{.Organization}}</b> as {{.Role}} on itsyou.online.</p>
+<p><a href="{{.Link}}">Accept or reject the invitation</a></p>`)
+
+//EmailAddressValidationEmail is sent to verify an email address, the data has a Username and a Link
+var EmailAddressValidationEmail = NewEmailTemplate(
+	"Verify your email address on itsyou.online",
+	`The itsyou.online user {{.Username}} added this email address.
+
+To verify this email address open the following link within 24 hours:
+{{.Link}}
+
+If you do not know this user, you can ignore this email.
+`,
+	`<p>The itsyou.online user <b>{{.Username}}</b> added this email address.</p>
+<p>To verify this email address open the following link within 24 hours:<br>
+<a href="{{.Link}}">Verify email address</a></p>
+<p>If you do not know this user, you can ignore this email.</p>`)
@@ -0,0 +1,85 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+//breachedPrefixLength is the number of hex characters of the SHA1 hash a range of breached passwords is looked up by
+const breachedPrefixLength = 5
+
+//BreachedPasswords is an offline list of SHA1 hashes of passwords that appeared in data breaches.
+// Like the k-anonymity range api of haveibeenpwned, the hashes are grouped in ranges by the first 5 hex characters
+// and a password is looked up by comparing the rest of its hash with the suffixes in its range.
+// The whole list is kept in memory, about 120 bytes per hash, so lists of up to 10 million hashes are supported.
+// Use a subset of the full haveibeenpwned download, for example the hashes that appeared most often.
+type BreachedPasswords struct {
+	ranges map[string][]string
+	count  int
+}
+
+//LoadBreachedPasswords reads a breached password list from a local file
+func LoadBreachedPasswords(path string) (list *BreachedPasswords, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	list, err = ReadBreachedPasswords(f)
+	return
+}
+
+//ReadBreachedPasswords reads a breached password list, every line contains a SHA1 hash in hex,
+// optionally followed by a colon and the number of times it appeared like in the haveibeenpwned downloads.
+// Empty lines and lines starting with # are ignored.
+func ReadBreachedPasswords(r io.Reader) (list *BreachedPasswords, err error) {
+	list = &BreachedPasswords{ranges: make(map[string][]string)}
+	scanner := bufio.NewScanner(r)
+	linenumber := 0
+	for scanner.Scan() {
+		linenumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, ":"); i >= 0 {
+			line = line[:i]
+		}
+		hash := strings.ToUpper(line)
+		if _, decodeErr := hex.DecodeString(hash); decodeErr != nil || len(hash) != sha1.Size*2 {
+			err = fmt.Errorf("Invalid SHA1 hash on line %d of the breached password list", linenumber)
+			return
+		}
+		prefix := hash[:breachedPrefixLength]
+		list.ranges[prefix] = append(list.ranges[prefix], hash[breachedPrefixLength:])
+		list.count++
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	for _, suffixes := range list.ranges {
+		sort.Strings(suffixes)
+	}
+	return
+}
+
+//Len returns the number of hashes in the list
+func (list *BreachedPasswords) Len() int {
+	return list.count
+}
+
+//Contains checks if a password appeared in a data breach
+func (list *BreachedPasswords) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	suffixes := list.ranges[hash[:breachedPrefixLength]]
+	suffix := hash[breachedPrefixLength:]
+	i := sort.SearchStrings(suffixes, suffix)
+	return i < len(suffixes) && suffixes[i] == suffix
+}
@@ -0,0 +1,32 @@
+package password
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//testBreachedPasswordList contains the SHA1 hashes of "password1" and "123456" in the haveibeenpwned download format
+func testBreachedPasswordList() io.Reader {
+	return strings.NewReader(`# breached passwords
+E38AD214943DAAD1D64C102FAEC29DE4AFE9DA3D:2413945
+
+7c4a8d09ca3762af61e59520943dc26494f8941b:37359195
+`)
+}
+
+func TestReadBreachedPasswords(t *testing.T) {
+	list, err := ReadBreachedPasswords(testBreachedPasswordList())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.Len())
+	assert.True(t, list.Contains("password1"))
+	assert.True(t, list.Contains("123456"))
+	assert.False(t, list.Contains("Password1"))
+
+	_, err = ReadBreachedPasswords(strings.NewReader("E38AD214943DAAD1D64C102FAEC29DE4AFE9DA3\n"))
+	assert.Error(t, err)
+	_, err = ReadBreachedPasswords(strings.NewReader("not a hash\n"))
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ const (
 type userPass struct {
 	Username string
 	Password string
+	History  []string //Keys of the previous passwords, most recent first
 }
 
 //InitModels initializes models in mongo, if required.
@@ -30,6 +31,8 @@ func InitModels() {
 	}
 
 	db.EnsureIndex(mongoCollectionName, index)
+
+	initResetTokenModels()
 }
 
 //Manager stores and validates passwords
@@ -63,22 +66,99 @@ func (pwm *Manager) Validate(username, password string) (bool, error) {
 		return false, err
 	}
 	match := keyderivation.Check(password, storedPassword.Password)
+	if match && keyderivation.NeedsRehash(storedPassword.Password) {
+		pwm.rehash(username, password)
+	}
 	return match, nil
 }
 
-// Save stores a password for a specific username.
-func (pwm *Manager) Save(username, password string) error {
-	//TODO: username and password validation
+//rehash stores a key created by the current key derivation function for a password that is validated,
+// this way legacy keys are migrated when users log in. A failure does not fail the validation.
+func (pwm *Manager) rehash(username, password string) {
+	passwordHash, err := keyderivation.Hash(password)
+	if err != nil {
+		log.Error("ERROR rehashing password: ", err)
+		return
+	}
+	if err = pwm.collection.Update(bson.M{"username": username}, bson.M{"$set": bson.M{"password": passwordHash}}); err != nil {
+		log.Error("ERROR storing rehashed password: ", err)
+		return
+	}
+	log.Debug("Rehashed the password of ", username)
+}
+
+//getStoredPassword returns the stored password of a user, or nil if the user has no password yet
+func (pwm *Manager) getStoredPassword(username string) (storedPassword *userPass, err error) {
+	storedPassword = &userPass{}
+	err = pwm.collection.Find(bson.M{"username": username}).One(storedPassword)
+	if err == mgo.ErrNotFound {
+		storedPassword = nil
+		err = nil
+	}
+	return
+}
+
+//CheckPolicy checks if a new password of a user is acceptable according to the current policy,
+// including that it is not one of the last passwords of the user
+func (pwm *Manager) CheckPolicy(username, password string, emailaddresses []string) error {
+	storedPassword, err := pwm.getStoredPassword(username)
+	if err != nil {
+		log.Error("ERROR getting the password history: ", err)
+		return errors.New("internal_error")
+	}
+	return checkPolicy(username, password, emailaddresses, storedPassword)
+}
+
+//checkPolicy checks a new password against the current policy and the stored password of the user, which is nil if there is none
+func checkPolicy(username, password string, emailaddresses []string, storedPassword *userPass) error {
+	if err := CurrentPolicy.Check(username, password, emailaddresses); err != nil {
+		return err
+	}
+	if storedPassword != nil && isReused(password, storedPassword, CurrentPolicy.HistorySize) {
+		return ErrPasswordReused
+	}
+	return nil
+}
+
+//isReused checks if a password is one of the last historySize passwords of a user
+func isReused(password string, storedPassword *userPass, historySize int) bool {
+	if historySize <= 0 {
+		return false
+	}
+	keys := append([]string{storedPassword.Password}, storedPassword.History...)
+	if len(keys) > historySize {
+		keys = keys[:historySize]
+	}
+	for _, key := range keys {
+		if keyderivation.Check(password, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Save stores a password for a specific username after checking it against the password policy,
+// the email addresses of the user are needed to check the password does not contain them.
+func (pwm *Manager) Save(username, password string, emailaddresses []string) error {
+	//TODO: username validation
+	currentPassword, err := pwm.getStoredPassword(username)
+	if err != nil {
+		log.Error("ERROR getting the password history: ", err)
+		return errors.New("internal_error")
+	}
+	if err = checkPolicy(username, password, emailaddresses, currentPassword); err != nil {
+		return err
+	}
 	passwordHash, err := keyderivation.Hash(password)
 	if err != nil {
 		log.Error("ERROR hashing password")
 		log.Debug("ERROR hashing password: ", err)
 		return errors.New("internal_error")
 	}
-	if len(password) < 6 {
-		return errors.New("invalid_password")
-	}
 	storedPassword := userPass{Username: username, Password: passwordHash}
+	if currentPassword != nil {
+		storedPassword.History = updatedHistory(currentPassword.Password, currentPassword.History, CurrentPolicy.HistorySize)
+	}
 
 	_, err = pwm.collection.Upsert(bson.M{"username": username}, storedPassword)
 
@@ -0,0 +1,119 @@
+package keyderivation
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix     = "$argon2id$"
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+)
+
+//Argon2id is the argon2id key derivation function with configurable cost parameters,
+// keys are encoded as `$argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>`
+type Argon2id struct {
+	//Time is the number of passes over the memory
+	Time uint32
+	//Memory is the amount of memory that is used in KiB
+	Memory uint32
+	//Threads is the number of threads that is used
+	Threads uint8
+}
+
+//NewArgon2id creates an Argon2id with validated cost parameters,
+// at least 1 pass and 1 up to 255 threads with 8 KiB of memory per thread
+func NewArgon2id(time, memory, threads int) (a *Argon2id, err error) {
+	if time < 1 || int64(time) > math.MaxUint32 {
+		err = fmt.Errorf("Invalid argon2id time %d", time)
+		return
+	}
+	if threads < 1 || threads > math.MaxUint8 {
+		err = fmt.Errorf("Invalid argon2id threads %d, it should be between 1 and %d", threads, math.MaxUint8)
+		return
+	}
+	if memory < 8*threads || int64(memory) > math.MaxUint32 {
+		err = fmt.Errorf("Invalid argon2id memory %d, it should be at least 8 KiB per thread", memory)
+		return
+	}
+	a = &Argon2id{Time: uint32(time), Memory: uint32(memory), Threads: uint8(threads)}
+	return
+}
+
+//argon2idKey is a decoded argon2id key
+type argon2idKey struct {
+	version int
+	Argon2id
+	salt []byte
+	hash []byte
+}
+
+var errInvalidArgon2idKey = errors.New("Invalid argon2id key")
+
+//decodeArgon2idKey decodes an encoded argon2id key
+func decodeArgon2idKey(key string) (decoded *argon2idKey, err error) {
+	parts := strings.Split(key, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		err = errInvalidArgon2idKey
+		return
+	}
+	decoded = &argon2idKey{}
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &decoded.version); err != nil {
+		return
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &decoded.Memory, &decoded.Time, &decoded.Threads); err != nil {
+		return
+	}
+	if decoded.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return
+	}
+	if decoded.hash, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return
+	}
+	if decoded.version != argon2.Version || len(decoded.hash) == 0 {
+		err = errInvalidArgon2idKey
+	}
+	return
+}
+
+//Hash creates a random salt and creates a key using this salt
+func (a *Argon2id) Hash(password string) (key string, err error) {
+	salt := make([]byte, argon2idSaltLength)
+	if _, err = rand.Read(salt); err != nil {
+		return
+	}
+	hash := argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, argon2idKeyLength)
+	key = fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, a.Memory, a.Time, a.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash))
+	return
+}
+
+//Check takes the password and the encoded key and checks if the combination matches,
+// the cost parameters are taken from the key
+func (a *Argon2id) Check(password, key string) bool {
+	decoded, err := decodeArgon2idKey(key)
+	if err != nil {
+		return false
+	}
+	hash := argon2.IDKey([]byte(password), decoded.salt, decoded.Time, decoded.Memory, decoded.Threads, uint32(len(decoded.hash)))
+	return subtle.ConstantTimeCompare(hash, decoded.hash) == 1
+}
+
+//Recognizes checks if the encoded key is an argon2id key
+func (a *Argon2id) Recognizes(key string) bool {
+	return strings.HasPrefix(key, argon2idPrefix)
+}
+
+//NeedsRehash checks if the encoded key is created with other cost parameters
+func (a *Argon2id) NeedsRehash(key string) bool {
+	decoded, err := decodeArgon2idKey(key)
+	return err != nil || decoded.Argon2id != *a || len(decoded.hash) != argon2idKeyLength
+}
@@ -0,0 +1,46 @@
+package keyderivation
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+//Bcrypt is the bcrypt key derivation function with a configurable cost
+type Bcrypt struct {
+	Cost int
+}
+
+//NewBcrypt creates a Bcrypt with a cost between bcrypt.MinCost and bcrypt.MaxCost
+func NewBcrypt(cost int) (b *Bcrypt, err error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		err = fmt.Errorf("Invalid bcrypt cost %d, it should be between %d and %d", cost, bcrypt.MinCost, bcrypt.MaxCost)
+		return
+	}
+	b = &Bcrypt{Cost: cost}
+	return
+}
+
+//Hash creates a random salt and creates a key using this salt
+func (b *Bcrypt) Hash(password string) (key string, err error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.Cost)
+	key = string(hash)
+	return
+}
+
+//Check takes the password and the encoded key and checks if the combination matches
+func (b *Bcrypt) Check(password, key string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(key), []byte(password)) == nil
+}
+
+//Recognizes checks if the encoded key is a bcrypt key
+func (b *Bcrypt) Recognizes(key string) bool {
+	return strings.HasPrefix(key, "$2a$") || strings.HasPrefix(key, "$2b$") || strings.HasPrefix(key, "$2y$")
+}
+
+//NeedsRehash checks if the encoded key is created with another cost
+func (b *Bcrypt) NeedsRehash(key string) bool {
+	cost, err := bcrypt.Cost([]byte(key))
+	return err != nil || cost != b.Cost
+}
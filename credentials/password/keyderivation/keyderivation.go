@@ -1,21 +1,55 @@
 package keyderivation
 
-import "github.com/itsyouonline/identityserver/credentials/password/keyderivation/crypt/sha512crypt"
+//KeyDerivationFunction creates and checks password keys, the encoded keys start with a prefix that identifies the function
+type KeyDerivationFunction interface {
+	//Hash creates a random salt and creates a key using this salt
+	Hash(password string) (key string, err error)
+	//Check takes the password and the encoded key and checks if the combination matches
+	Check(password, key string) bool
+	//Recognizes checks if the encoded key is created by this key derivation function
+	Recognizes(key string) bool
+	//NeedsRehash checks if the encoded key is created with other cost parameters than the ones that are configured
+	NeedsRehash(key string) bool
+}
 
-//Hash creates a random 16 character salt and creates a key using this salt
-//Key generation function: SHA512 with 5000 iterations
-// If you want to generate the same key on the commandline:
-// `echo "user:password" | chpasswd -c SHA512 -S | cut -d: -f 2`
-func Hash(password string) (key string, err error) {
-	c := sha512crypt.New()
-	key, err = c.Generate([]byte(password), []byte(""))
+//Current is the key derivation function that is used to create new keys
+var Current KeyDerivationFunction = &Argon2id{Time: 3, Memory: 64 * 1024, Threads: 4}
+
+//known are the key derivation functions that are recognized when a key is checked
+var known = []KeyDerivationFunction{&Argon2id{}, &Bcrypt{}, &SHA512Crypt{}}
+
+//forKey returns the key derivation function that created an encoded key, or nil if it is not recognized
+func forKey(key string) KeyDerivationFunction {
+	if Current.Recognizes(key) {
+		return Current
+	}
+	for _, kdf := range known {
+		if kdf.Recognizes(key) {
+			return kdf
+		}
+	}
+	return nil
+}
 
+//Hash creates a key for the password using the current key derivation function
+func Hash(password string) (key string, err error) {
+	key, err = Current.Hash(password)
 	return
 }
 
-//Check takes the password and the encoded key
-// and checks if the combination matches
+//Check takes the password and the encoded key and checks if the combination matches,
+// the key derivation function is selected by the prefix of the key
 func Check(password, key string) bool {
-	err := sha512crypt.New().Verify(key, []byte(password))
-	return err == nil
+	kdf := forKey(key)
+	if kdf == nil {
+		return false
+	}
+	return kdf.Check(password, key)
+}
+
+//NeedsRehash checks if an encoded key should be replaced by a key from the current key derivation function,
+// this is the case for legacy keys and keys that are created with other cost parameters
+func NeedsRehash(key string) bool {
+	kdf := forKey(key)
+	return kdf != Current || Current.NeedsRehash(key)
 }
@@ -2,6 +2,7 @@ package keyderivation
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -22,3 +23,88 @@ func TestCheck(t *testing.T) {
 		t.Error("Password and salt should match the given key")
 	}
 }
+
+func TestCheckLegacyKey(t *testing.T) {
+	key := "$6$Iu14DOBmK4/JqCTd$E67.yXll739DjVacyQjulJ6bjN1GIwkHfd/Er4HpABMvpq4x0fpH8aOdOmUjVZSmr8tUhkVxDoX685KJSXfgg1"
+	if Check("wrong", key) {
+		t.Error("A wrong password should not match the given key")
+	}
+	if !NeedsRehash(key) {
+		t.Error("A legacy key should be rehashed")
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	kdf := &Argon2id{Time: 1, Memory: 1024, Threads: 1}
+	key, err := kdf.Hash("demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Error("Unexpected argon2id key: ", key)
+	}
+	if !Check("demo", key) {
+		t.Error("Password should match the argon2id key")
+	}
+	if Check("wrong", key) {
+		t.Error("A wrong password should not match the argon2id key")
+	}
+	if kdf.NeedsRehash(key) {
+		t.Error("A key with the configured parameters should not be rehashed")
+	}
+	if (&Argon2id{Time: 2, Memory: 1024, Threads: 1}).NeedsRehash(key) == false {
+		t.Error("A key with other parameters should be rehashed")
+	}
+	if Check("demo", "$argon2id$v=19$m=1024,t=1,p=1$invalid") {
+		t.Error("An invalid key should not match")
+	}
+}
+
+func TestNewArgon2id(t *testing.T) {
+	kdf, err := NewArgon2id(3, 64*1024, 4)
+	if err != nil || *kdf != (Argon2id{Time: 3, Memory: 64 * 1024, Threads: 4}) {
+		t.Error("Valid argon2id parameters should be accepted")
+	}
+	for _, params := range [][3]int{{0, 1024, 1}, {-1, 1024, 1}, {1, 1024, 0}, {1, 1024, 256}, {1, 1024, -1}, {1, 7, 1}, {1, 31, 4}, {1, -1024, 1}} {
+		if _, err = NewArgon2id(params[0], params[1], params[2]); err == nil {
+			t.Error("Invalid argon2id parameters should be rejected: ", params)
+		}
+	}
+}
+
+func TestNewBcrypt(t *testing.T) {
+	if _, err := NewBcrypt(12); err != nil {
+		t.Error("A valid bcrypt cost should be accepted")
+	}
+	for _, cost := range []int{-1, 0, 3, 32} {
+		if _, err := NewBcrypt(cost); err == nil {
+			t.Error("An invalid bcrypt cost should be rejected: ", cost)
+		}
+	}
+}
+
+func TestBcrypt(t *testing.T) {
+	kdf := &Bcrypt{Cost: 4}
+	key, err := kdf.Hash("demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Check("demo", key) {
+		t.Error("Password should match the bcrypt key")
+	}
+	if Check("wrong", key) {
+		t.Error("A wrong password should not match the bcrypt key")
+	}
+	if kdf.NeedsRehash(key) {
+		t.Error("A key with the configured cost should not be rehashed")
+	}
+	if !NeedsRehash(key) {
+		t.Error("A key that is not created by the current key derivation function should be rehashed")
+	}
+}
+
+func TestCheckUnknownKey(t *testing.T) {
+	if Check("demo", "demo") {
+		t.Error("An unrecognized key should not match")
+	}
+}
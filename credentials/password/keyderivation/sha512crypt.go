@@ -0,0 +1,35 @@
+package keyderivation
+
+import (
+	"strings"
+
+	"github.com/itsyouonline/identityserver/credentials/password/keyderivation/crypt/sha512crypt"
+)
+
+//SHA512Crypt is the legacy key derivation function: SHA512 with 5000 iterations.
+// If you want to generate the same key on the commandline:
+// `echo "user:password" | chpasswd -c SHA512 -S | cut -d: -f 2`
+type SHA512Crypt struct {
+}
+
+//Hash creates a random 16 character salt and creates a key using this salt
+func (c *SHA512Crypt) Hash(password string) (key string, err error) {
+	key, err = sha512crypt.New().Generate([]byte(password), []byte(""))
+	return
+}
+
+//Check takes the password and the encoded key and checks if the combination matches
+func (c *SHA512Crypt) Check(password, key string) bool {
+	err := sha512crypt.New().Verify(key, []byte(password))
+	return err == nil
+}
+
+//Recognizes checks if the encoded key is a SHA512 crypt key
+func (c *SHA512Crypt) Recognizes(key string) bool {
+	return strings.HasPrefix(key, "$6$")
+}
+
+//NeedsRehash is always false, SHA512Crypt has no configurable cost parameters
+func (c *SHA512Crypt) NeedsRehash(key string) bool {
+	return false
+}
@@ -0,0 +1,89 @@
+package password
+
+import (
+	"errors"
+	"strings"
+)
+
+//Errors returned when a password does not satisfy the policy, the error strings are the codes the website and the api clients get
+var (
+	ErrPasswordTooShort         = errors.New("password_too_short")
+	ErrPasswordContainsUsername = errors.New("password_contains_username")
+	ErrPasswordContainsEmail    = errors.New("password_contains_email")
+	ErrPasswordReused           = errors.New("password_reused")
+	ErrPasswordBreached         = errors.New("password_breached")
+)
+
+//minimumIdentifierLength is the minimum length of a username or email address part before a password can not contain it,
+// shorter identifiers would refuse too many passwords
+const minimumIdentifierLength = 3
+
+//Policy describes the passwords that are acceptable
+type Policy struct {
+	//MinLength is the minimum number of characters of a password
+	MinLength int
+	//HistorySize is the number of last passwords of a user that can not be reused, the current one included.
+	// Every remembered password costs a key derivation when a password is changed, it is at most MaxHistorySize.
+	HistorySize int
+	//BreachedPasswords is the list of passwords that appeared in data breaches, nil if they are not checked
+	BreachedPasswords *BreachedPasswords
+}
+
+//MaxHistorySize is the maximum number of last passwords that can be remembered,
+// it limits the key derivations a password change costs
+const MaxHistorySize = 10
+
+//CurrentPolicy is the policy new passwords are checked against
+var CurrentPolicy = &Policy{MinLength: 6, HistorySize: 5}
+
+//IsPolicyError checks if an error means a password does not satisfy the policy
+func IsPolicyError(err error) bool {
+	switch err {
+	case ErrPasswordTooShort, ErrPasswordContainsUsername, ErrPasswordContainsEmail, ErrPasswordReused, ErrPasswordBreached:
+		return true
+	}
+	return false
+}
+
+//Check checks if a password of a user is acceptable, the password history is not checked here
+func (p *Policy) Check(username, password string, emailaddresses []string) error {
+	if len([]rune(password)) < p.MinLength {
+		return ErrPasswordTooShort
+	}
+	lowercasePassword := strings.ToLower(password)
+	if containsIdentifier(lowercasePassword, username) {
+		return ErrPasswordContainsUsername
+	}
+	for _, emailaddress := range emailaddresses {
+		localpart := emailaddress
+		if i := strings.LastIndex(emailaddress, "@"); i >= 0 {
+			localpart = emailaddress[:i]
+		}
+		if containsIdentifier(lowercasePassword, emailaddress) || containsIdentifier(lowercasePassword, localpart) {
+			return ErrPasswordContainsEmail
+		}
+	}
+	if p.BreachedPasswords != nil && p.BreachedPasswords.Contains(password) {
+		return ErrPasswordBreached
+	}
+	return nil
+}
+
+//containsIdentifier checks if a lowercase password contains an identifier, identifiers that are too short are ignored
+func containsIdentifier(lowercasePassword, identifier string) bool {
+	identifier = strings.ToLower(strings.TrimSpace(identifier))
+	return len(identifier) >= minimumIdentifierLength && strings.Contains(lowercasePassword, identifier)
+}
+
+//updatedHistory returns the previous keys to keep when the current key is replaced,
+// together with the new key they make up the last historySize passwords
+func updatedHistory(currentKey string, history []string, historySize int) []string {
+	if currentKey == "" || historySize <= 1 {
+		return nil
+	}
+	updated := append([]string{currentKey}, history...)
+	if len(updated) > historySize-1 {
+		updated = updated[:historySize-1]
+	}
+	return updated
+}
@@ -0,0 +1,57 @@
+package password
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/itsyouonline/identityserver/credentials/password/keyderivation"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	assert.Error(t, CurrentPolicy.Check("", "12345", nil))
+	assert.NoError(t, CurrentPolicy.Check("", "123456", nil))
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := &Policy{MinLength: 8}
+	emailaddresses := []string{"Bob.Smith@example.com"}
+	assert.Equal(t, ErrPasswordTooShort, policy.Check("bob", "short", emailaddresses))
+	assert.Equal(t, ErrPasswordTooShort, policy.Check("bob", "ééééééé", emailaddresses))
+	assert.Equal(t, ErrPasswordContainsUsername, policy.Check("bob", "MyNameIsBob!", emailaddresses))
+	assert.Equal(t, ErrPasswordContainsEmail, policy.Check("robert", "bob.smith@example.com1", emailaddresses))
+	assert.Equal(t, ErrPasswordContainsEmail, policy.Check("robert", "12bob.smith34", emailaddresses))
+	//Identifiers that are too short are not checked
+	assert.NoError(t, policy.Check("x", "correct horse battery staple", []string{"", "ab@example.com"}))
+
+	policy.BreachedPasswords, _ = ReadBreachedPasswords(testBreachedPasswordList())
+	assert.Equal(t, ErrPasswordBreached, policy.Check("bob", "password1", emailaddresses))
+	assert.NoError(t, policy.Check("bob", "correct horse battery staple", emailaddresses))
+}
+
+func TestIsPolicyError(t *testing.T) {
+	assert.True(t, IsPolicyError(ErrPasswordTooShort))
+	assert.True(t, IsPolicyError(ErrPasswordReused))
+	assert.False(t, IsPolicyError(nil))
+	assert.False(t, IsPolicyError(errors.New("internal_error")))
+}
+
+func TestUpdatedHistory(t *testing.T) {
+	assert.Nil(t, updatedHistory("", nil, 5))
+	assert.Nil(t, updatedHistory("a", []string{"b"}, 1))
+	assert.Equal(t, []string{"a"}, updatedHistory("a", nil, 5))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, updatedHistory("a", []string{"b", "c", "d", "e"}, 5))
+}
+
+func TestIsReused(t *testing.T) {
+	current, _ := keyderivation.Hash("current")
+	previous, _ := keyderivation.Hash("previous")
+	older, _ := keyderivation.Hash("older")
+	storedPassword := &userPass{Username: "bob", Password: current, History: []string{previous, older}}
+	assert.True(t, isReused("current", storedPassword, 3))
+	assert.True(t, isReused("older", storedPassword, 3))
+	assert.False(t, isReused("older", storedPassword, 2))
+	assert.False(t, isReused("current", storedPassword, 0))
+	assert.False(t, isReused("other", storedPassword, 3))
+}
@@ -0,0 +1,102 @@
+package password
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+const (
+	mongoResetTokenCollectionName = "passwordresettokens"
+)
+
+//ResetTokenExpiration is how long a password reset link can be used
+var ResetTokenExpiration = time.Minute * 30
+
+//ResetToken allows a user that forgot the password to choose a new one, it can only be used once
+type ResetToken struct {
+	Token     string
+	Username  string
+	SMSCode   string //Second factor code that is sent by sms when the user does not use totp
+	CreatedAt time.Time
+}
+
+//NewResetToken creates a reset token for a user
+func NewResetToken(username string) *ResetToken {
+	randombytes := make([]byte, 30) //Multiple of 3 to make sure no padding is added
+	rand.Read(randombytes)
+	return &ResetToken{
+		Token:     base64.URLEncoding.EncodeToString(randombytes),
+		Username:  username,
+		CreatedAt: time.Now(),
+	}
+}
+
+//IsExpiredAt checks if the reset token is expired at a specific time
+func (t *ResetToken) IsExpiredAt(testtime time.Time) bool {
+	return testtime.After(t.CreatedAt.Add(ResetTokenExpiration))
+}
+
+//initResetTokenModels initializes the reset token collection, expired tokens are removed automatically
+func initResetTokenModels() {
+	index := mgo.Index{
+		Key:      []string{"token"},
+		Unique:   true,
+		DropDups: true,
+	}
+	db.EnsureIndex(mongoResetTokenCollectionName, index)
+
+	automaticExpiration := mgo.Index{
+		Key:         []string{"createdat"},
+		ExpireAfter: ResetTokenExpiration,
+		Background:  true,
+	}
+	db.EnsureIndex(mongoResetTokenCollectionName, automaticExpiration)
+}
+
+func (pwm *Manager) getResetTokenCollection() *mgo.Collection {
+	return db.GetCollection(pwm.session, mongoResetTokenCollectionName)
+}
+
+//SaveResetToken stores a reset token, reset tokens that were handed out to the user before can not be used anymore
+func (pwm *Manager) SaveResetToken(t *ResetToken) (err error) {
+	if _, err = pwm.getResetTokenCollection().RemoveAll(bson.M{"username": t.Username}); err != nil {
+		return
+	}
+	err = pwm.getResetTokenCollection().Insert(t)
+	return
+}
+
+//GetResetToken returns a reset token that is not expired or nil if there is none
+func (pwm *Manager) GetResetToken(token string) (t *ResetToken, err error) {
+	t = &ResetToken{}
+	err = pwm.getResetTokenCollection().Find(bson.M{"token": token}).One(t)
+	if err == mgo.ErrNotFound || (err == nil && t.IsExpiredAt(time.Now())) {
+		t = nil
+		err = nil
+	}
+	return
+}
+
+//SetResetTokenSMSCode stores the second factor code that is sent by sms for a reset token
+func (pwm *Manager) SetResetTokenSMSCode(token, smsCode string) (err error) {
+	err = pwm.getResetTokenCollection().Update(bson.M{"token": token}, bson.M{"$set": bson.M{"smscode": smsCode}})
+	return
+}
+
+//RedeemResetToken removes a reset token so it can not be used again,
+// redeemed is false if the token was already used or removed.
+func (pwm *Manager) RedeemResetToken(token string) (redeemed bool, err error) {
+	err = pwm.getResetTokenCollection().Remove(bson.M{"token": token})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	redeemed = err == nil
+	return
+}
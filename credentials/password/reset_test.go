@@ -0,0 +1,18 @@
+package password
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetTokenExpiration(t *testing.T) {
+	resetToken := NewResetToken("bob")
+	assert.Equal(t, "bob", resetToken.Username)
+	assert.Len(t, resetToken.Token, 40)
+	assert.False(t, resetToken.IsExpiredAt(resetToken.CreatedAt))
+	assert.False(t, resetToken.IsExpiredAt(resetToken.CreatedAt.Add(ResetTokenExpiration)))
+	assert.True(t, resetToken.IsExpiredAt(resetToken.CreatedAt.Add(ResetTokenExpiration+time.Second)))
+	assert.NotEqual(t, resetToken.Token, NewResetToken("bob").Token)
+}
@@ -0,0 +1,20 @@
+package session
+
+import "time"
+
+//Session is an interactive session of a user on the itsyou.online website
+type Session struct {
+	SessionID    string    `json:"sessionid"`
+	Username     string    `json:"username"`
+	Values       string    `json:"-"` //Values are the encoded values of the session
+	Device       string    `json:"device"`
+	IP           string    `json:"ip"`
+	UserAgent    string    `json:"useragent"`
+	CreatedAt    time.Time `json:"createdat"`
+	LastActivity time.Time `json:"lastactivity"`
+}
+
+//IsExpiredAt checks if the session is inactive for longer than maxAge at a specific time
+func (s *Session) IsExpiredAt(maxAge time.Duration, testtime time.Time) bool {
+	return testtime.After(s.LastActivity.Add(maxAge))
+}
@@ -0,0 +1,15 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsExpiredAt(t *testing.T) {
+	now := time.Now()
+	s := &Session{LastActivity: now}
+	assert.False(t, s.IsExpiredAt(time.Minute, now.Add(time.Minute)))
+	assert.True(t, s.IsExpiredAt(time.Minute, now.Add(time.Minute+time.Second)))
+}
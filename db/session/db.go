@@ -0,0 +1,142 @@
+package session
+
+import (
+	"net/http"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+const (
+	mongoSessionsCollectionName = "sessions"
+)
+
+//MaxInactivity is how long a session is kept after the last activity of the user
+var MaxInactivity = time.Minute * 10
+
+//InitModels initialize models in mongo, if required.
+func InitModels() {
+	index := mgo.Index{
+		Key:    []string{"sessionid"},
+		Unique: true,
+	}
+	db.EnsureIndex(mongoSessionsCollectionName, index)
+
+	index = mgo.Index{
+		Key: []string{"username"},
+	}
+	db.EnsureIndex(mongoSessionsCollectionName, index)
+
+	automaticExpiration := mgo.Index{
+		Key:         []string{"lastactivity"},
+		ExpireAfter: MaxInactivity,
+		Background:  true,
+	}
+	db.EnsureIndex(mongoSessionsCollectionName, automaticExpiration)
+}
+
+//Manager is used to store sessions
+type Manager struct {
+	session *mgo.Session
+}
+
+//NewManager creates and initializes a new Manager
+func NewManager(r *http.Request) *Manager {
+	session := db.GetDBSession(r)
+	return &Manager{
+		session: session,
+	}
+}
+
+//NewManagerWithSession creates a Manager that uses an existing mongo session
+func NewManagerWithSession(session *mgo.Session) *Manager {
+	return &Manager{
+		session: session,
+	}
+}
+
+func (m *Manager) getSessionCollection() *mgo.Collection {
+	return db.GetCollection(m.session, mongoSessionsCollectionName)
+}
+
+//Save creates or updates a session
+func (m *Manager) Save(s *Session) (err error) {
+	_, err = m.getSessionCollection().Upsert(bson.M{"sessionid": s.SessionID}, bson.M{
+		"$set": bson.M{
+			"username":     s.Username,
+			"values":       s.Values,
+			"device":       s.Device,
+			"ip":           s.IP,
+			"useragent":    s.UserAgent,
+			"lastactivity": s.LastActivity,
+		},
+		"$setOnInsert": bson.M{"createdat": s.CreatedAt},
+	})
+	return
+}
+
+//Get gets a session by its id, if it is not found, nil is returned
+func (m *Manager) Get(sessionID string) (s *Session, err error) {
+	s = &Session{}
+	err = m.getSessionCollection().Find(bson.M{"sessionid": sessionID}).One(s)
+	if err == mgo.ErrNotFound {
+		s = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		s = nil
+	}
+	return
+}
+
+//GetByUser gets the active sessions of a user, the most recently used session first
+func (m *Manager) GetByUser(username string) (sessions []Session, err error) {
+	sessions = make([]Session, 0)
+	err = m.getSessionCollection().Find(bson.M{
+		"username":     username,
+		"lastactivity": bson.M{"$gt": time.Now().Add(-MaxInactivity)},
+	}).Sort("-lastactivity").All(&sessions)
+	return
+}
+
+//Delete removes a session
+func (m *Manager) Delete(sessionID string) (err error) {
+	_, err = m.getSessionCollection().RemoveAll(bson.M{"sessionid": sessionID})
+	return
+}
+
+//Touch records activity in a session that is still active, active is false if the session does not exist or expired
+func (m *Manager) Touch(sessionID string) (active bool, err error) {
+	now := time.Now()
+	err = m.getSessionCollection().Update(bson.M{
+		"sessionid":    sessionID,
+		"lastactivity": bson.M{"$gt": now.Add(-MaxInactivity)},
+	}, bson.M{"$set": bson.M{"lastactivity": now}})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	active = err == nil
+	return
+}
+
+//DeleteForUser removes a session of a specific user, removed is false if the user has no session with this id
+func (m *Manager) DeleteForUser(username, sessionID string) (removed bool, err error) {
+	err = m.getSessionCollection().Remove(bson.M{"sessionid": sessionID, "username": username})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	removed = err == nil
+	return
+}
+
+//DeleteAllForUser removes all sessions of a user
+func (m *Manager) DeleteAllForUser(username string) (err error) {
+	_, err = m.getSessionCollection().RemoveAll(bson.M{"username": username})
+	return
+}
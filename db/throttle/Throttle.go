@@ -0,0 +1,75 @@
+package throttle
+
+import "time"
+
+//FreeAttempts is the number of failed attempts for a username before the backoff starts
+var FreeAttempts = 3
+
+//IPFreeAttempts is the number of failed attempts from an ip address before the backoff starts,
+// it is higher than FreeAttempts since a lot of users can be behind the same ip address
+var IPFreeAttempts = 20
+
+//BaseDelay is the delay after the first failed attempt that is not free, it doubles with every next failure
+var BaseDelay = time.Second
+
+//MaxDelay is the maximum delay between two attempts
+var MaxDelay = time.Minute * 15
+
+//LockoutThreshold is the number of failed attempts after which an account is locked
+var LockoutThreshold = 10
+
+//LockoutDuration is how long an account stays locked
+var LockoutDuration = time.Minute * 30
+
+//FailureExpiration is how long failed attempts are remembered
+var FailureExpiration = time.Hour * 24
+
+//Throttle keeps the failed login attempts for a username or an ip address
+type Throttle struct {
+	Key         string
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+//UserKey returns the throttle key of a username
+func UserKey(username string) string {
+	return "user:" + username
+}
+
+//IPKey returns the throttle key of an ip address
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+//Delay returns how long to wait after a number of failed attempts, the delay doubles with every failure after the free attempts
+func Delay(failures, freeAttempts int) time.Duration {
+	if failures < freeAttempts {
+		return 0
+	}
+	delay := BaseDelay
+	for i := freeAttempts; i < failures && delay < MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > MaxDelay {
+		delay = MaxDelay
+	}
+	return delay
+}
+
+//IsLockedAt checks if the account is locked at a specific time
+func (t *Throttle) IsLockedAt(testtime time.Time) bool {
+	return testtime.Before(t.LockedUntil)
+}
+
+//RetryAfter returns how long to wait at a specific time before a next attempt is allowed
+func (t *Throttle) RetryAfter(freeAttempts int, testtime time.Time) time.Duration {
+	wait := t.LastFailure.Add(Delay(t.Failures, freeAttempts)).Sub(testtime)
+	if t.IsLockedAt(testtime) {
+		wait = t.LockedUntil.Sub(testtime)
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
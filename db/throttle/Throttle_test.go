@@ -0,0 +1,31 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Delay(0, 3))
+	assert.Equal(t, time.Duration(0), Delay(2, 3))
+	assert.Equal(t, time.Second, Delay(3, 3))
+	assert.Equal(t, time.Second*2, Delay(4, 3))
+	assert.Equal(t, time.Second*8, Delay(6, 3))
+	assert.Equal(t, MaxDelay, Delay(100, 3))
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Now()
+	throttle := &Throttle{Failures: 5, LastFailure: now.Add(-time.Second)}
+	assert.Equal(t, time.Second*3, throttle.RetryAfter(3, now))
+	assert.Equal(t, time.Duration(0), throttle.RetryAfter(3, now.Add(time.Second*3)))
+	//Below the free attempts there is no need to wait
+	assert.Equal(t, time.Duration(0), throttle.RetryAfter(10, now))
+
+	throttle = &Throttle{LastFailure: now, LockedUntil: now.Add(LockoutDuration)}
+	assert.True(t, throttle.IsLockedAt(now))
+	assert.Equal(t, LockoutDuration, throttle.RetryAfter(3, now))
+	assert.False(t, throttle.IsLockedAt(now.Add(LockoutDuration)))
+}
@@ -0,0 +1,113 @@
+package throttle
+
+import (
+	"net/http"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+const (
+	mongoThrottlesCollectionName = "loginthrottles"
+)
+
+//InitModels initialize models in mongo, if required.
+func InitModels() {
+	index := mgo.Index{
+		Key:    []string{"key"},
+		Unique: true,
+	}
+	db.EnsureIndex(mongoThrottlesCollectionName, index)
+
+	automaticExpiration := mgo.Index{
+		Key:         []string{"lastfailure"},
+		ExpireAfter: FailureExpiration,
+		Background:  true,
+	}
+	db.EnsureIndex(mongoThrottlesCollectionName, automaticExpiration)
+}
+
+//Manager is used to store the failed login attempts
+type Manager struct {
+	session *mgo.Session
+}
+
+//NewManager creates and initializes a new Manager
+func NewManager(r *http.Request) *Manager {
+	session := db.GetDBSession(r)
+	return &Manager{
+		session: session,
+	}
+}
+
+func (m *Manager) getThrottleCollection() *mgo.Collection {
+	return db.GetCollection(m.session, mongoThrottlesCollectionName)
+}
+
+//Get gets the failed attempts for a key, if there are none, nil is returned
+func (m *Manager) Get(key string) (t *Throttle, err error) {
+	t = &Throttle{}
+	err = m.getThrottleCollection().Find(bson.M{"key": key}).One(t)
+	if err == mgo.ErrNotFound {
+		t = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		t = nil
+	}
+	return
+}
+
+//RegisterFailure records a failed attempt for a key.
+// If lockable is true and the LockoutThreshold is reached, the account is locked and the failures start counting from 0 again.
+// locked is only true for the attempt that caused the lock, even if multiple attempts are processed at the same time.
+func (m *Manager) RegisterFailure(key string, lockable bool) (t *Throttle, locked bool, err error) {
+	now := time.Now()
+	t = &Throttle{}
+	change := mgo.Change{
+		Update: bson.M{
+			"$inc": bson.M{"failures": 1},
+			"$set": bson.M{"lastfailure": now},
+		},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+	if _, err = m.getThrottleCollection().Find(bson.M{"key": key}).Apply(change, t); err != nil {
+		t = nil
+		return
+	}
+	if !lockable || t.Failures < LockoutThreshold {
+		return
+	}
+	lockedUntil := now.Add(LockoutDuration)
+	err = m.getThrottleCollection().Update(bson.M{"key": key, "failures": t.Failures}, bson.M{
+		"$set": bson.M{"failures": 0, "lockeduntil": lockedUntil},
+	})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	t.Failures = 0
+	t.LockedUntil = lockedUntil
+	locked = true
+	return
+}
+
+//Reset forgets the failed attempts for a key
+func (m *Manager) Reset(key string) (err error) {
+	_, err = m.getThrottleCollection().RemoveAll(bson.M{"key": key})
+	return
+}
+
+//Unlock unlocks the account of a user and forgets the failed login attempts
+func (m *Manager) Unlock(username string) (err error) {
+	err = m.Reset(UserKey(username))
+	return
+}
@@ -23,6 +23,10 @@ func (authorization Authorization) FilterAuthorizedScopes(requestedscopes []stri
 	authorizedScopes = make([]string, 0, len(requestedscopes))
 	for _, rawscope := range requestedscopes {
 		scope := strings.TrimSpace(rawscope)
+		//The openid scope only discloses the username, which the client already gets with the access token
+		if scope == "openid" {
+			authorizedScopes = append(authorizedScopes, scope)
+		}
 		if scope == "user:name" && authorization.Name {
 			authorizedScopes = append(authorizedScopes, scope)
 		}
@@ -55,6 +59,59 @@ func (authorization Authorization) FilterAuthorizedScopes(requestedscopes []stri
 	return
 }
 
+//FilterUser returns a copy of the user holding only the information this authorization grants access to.
+// Labelled properties are keyed by the label the client requested instead of the user's own label.
+// If the authorization is nil, only the username is filled in.
+func (authorization *Authorization) FilterUser(u *User) (filtered *User) {
+	filtered = &User{Username: u.Username}
+	if authorization == nil {
+		return
+	}
+	if authorization.Name {
+		filtered.Firstname = u.Firstname
+		filtered.Lastname = u.Lastname
+	}
+	if authorization.Github {
+		filtered.Github = u.Github
+	}
+	if authorization.Facebook {
+		filtered.Facebook = u.Facebook
+	}
+	if authorization.Address != nil {
+		filtered.Address = make(map[string]Address)
+		for requestedLabel, realLabel := range authorization.Address {
+			if value, found := u.Address[realLabel]; found {
+				filtered.Address[requestedLabel] = value
+			}
+		}
+	}
+	if authorization.Email != nil {
+		filtered.Email = make(map[string]string)
+		for requestedLabel, realLabel := range authorization.Email {
+			if value, found := u.Email[realLabel]; found {
+				filtered.Email[requestedLabel] = value
+			}
+		}
+	}
+	if authorization.Phone != nil {
+		filtered.Phone = make(map[string]Phonenumber)
+		for requestedLabel, realLabel := range authorization.Phone {
+			if value, found := u.Phone[realLabel]; found {
+				filtered.Phone[requestedLabel] = value
+			}
+		}
+	}
+	if authorization.Bank != nil {
+		filtered.Bank = make(map[string]BankAccount)
+		for requestedLabel, realLabel := range authorization.Bank {
+			if value, found := u.Bank[realLabel]; found {
+				filtered.Bank[requestedLabel] = value
+			}
+		}
+	}
+	return
+}
+
 func (authorization Authorization) containsOrganization(globalid string) bool {
 	for _, orgid := range authorization.Organizations {
 		if orgid == globalid {
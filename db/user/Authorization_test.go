@@ -43,6 +43,10 @@ func TestScopesAreAuthorized(t *testing.T) {
 		testcase{a: Authorization{Phone: map[string]string{"main": "home"}}, s: "user:phone:home", authorized: false},
 		testcase{a: Authorization{Phone: map[string]string{"main": "home"}}, s: "user:phone:main", authorized: true},
 		testcase{a: Authorization{Phone: map[string]string{"": "home"}}, s: "user:phone", authorized: true},
+
+		testcase{a: Authorization{}, s: "openid", authorized: true},
+		testcase{a: Authorization{}, s: "openid,user:name", authorized: false},
+		testcase{a: Authorization{Name: true}, s: "openid,user:name", authorized: true},
 	}
 	for _, test := range testcases {
 		requestedScopes := strings.Split(test.s, ",")
@@ -50,3 +54,32 @@ func TestScopesAreAuthorized(t *testing.T) {
 		assert.Equal(t, test.authorized, len(requestedScopes) == len(authorizedScopes), test.s)
 	}
 }
+
+func TestFilterUser(t *testing.T) {
+	u := &User{
+		Username:  "bob",
+		Firstname: "Bob",
+		Lastname:  "Builder",
+		Email:     map[string]string{"home": "bob@home.com", "work": "bob@work.com"},
+		Phone:     map[string]Phonenumber{"mobile": "+3212345678"},
+		Github:    GithubAccount{Name: "bobgithub"},
+	}
+
+	var authorization *Authorization
+	filtered := authorization.FilterUser(u)
+	assert.Equal(t, "bob", filtered.Username)
+	assert.Empty(t, filtered.Firstname)
+	assert.Nil(t, filtered.Email)
+
+	authorization = &Authorization{
+		Name:  true,
+		Email: map[string]string{"main": "work", "other": "unknownlabel"},
+	}
+	filtered = authorization.FilterUser(u)
+	assert.Equal(t, "bob", filtered.Username)
+	assert.Equal(t, "Bob", filtered.Firstname)
+	assert.Equal(t, "Builder", filtered.Lastname)
+	assert.Equal(t, map[string]string{"main": "bob@work.com"}, filtered.Email)
+	assert.Nil(t, filtered.Phone)
+	assert.Empty(t, filtered.Github.Name)
+}
@@ -26,4 +26,12 @@ func ValidateUsername(username string) (valid bool) {
 	regex, _ := regexp.Compile(`^[a-zA-Z0-9\s-_]+$`)
 	matches := regex.FindAllString(username, 2)
 	return len(matches) == 1
-}
\ No newline at end of file
+}
+
+//GetEmailAddresses returns all email addresses of a user
+func (u *User) GetEmailAddresses() (emailaddresses []string) {
+	for _, emailaddress := range u.Email {
+		emailaddresses = append(emailaddresses, emailaddress)
+	}
+	return
+}
@@ -211,10 +211,11 @@ func (m *Manager) GetAuthorizationsByUser(username string) (authorizations []Aut
 func (m *Manager) GetAuthorization(username, organization string) (authorization *Authorization, err error) {
 	authorization = &Authorization{}
 	err = m.getAuthorizationCollection().Find(bson.M{"username": username, "grantedto": organization}).One(authorization)
+	if err != nil {
+		authorization = nil
+	}
 	if err == mgo.ErrNotFound {
 		err = nil
-	} else if err != nil {
-		authorization = nil
 	}
 	return
 }
@@ -243,19 +244,3 @@ func (m *Manager) UpdateName(username string, firstname string, lastname string)
 	_, err = m.getUserCollection().UpdateAll(bson.M{"username": username}, bson.M{"$set": values})
 	return
 }
-
-func (m *Manager) FindByVerifiedEmailOrUsername(usernameOrEmail string) (user User, err error) {
-	// todo: filter by verified emails only
-	qry := bson.M{
-		"$or": []bson.M{
-			bson.M{
-				"username":usernameOrEmail,
-			},
-			bson.M{
-				"email.main": usernameOrEmail,
-			},
-		},
-	}
-	err = m.getUserCollection().Find(qry).One(&user)
-	return
-}
\ No newline at end of file
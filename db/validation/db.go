@@ -3,6 +3,7 @@ package validation
 import (
 	"fmt"
 	"net/http"
+	"sort"
 
 	"gopkg.in/mgo.v2"
 
@@ -21,6 +22,11 @@ const (
 	mongoValidatedPhonenumbers                      = "validatedphonenumbers"
 )
 
+const (
+	mongoOngoingEmailAddressValidationCollectionName = "ongoingemailaddressvalidations"
+	mongoValidatedEmailAddresses                     = "validatedemailaddresses"
+)
+
 //InitModels initialize models in mongo, if required.
 func InitModels() {
 	index := mgo.Index{
@@ -46,6 +52,31 @@ func InitModels() {
 
 	db.EnsureIndex(mongoValidatedPhonenumbers, index)
 
+	index = mgo.Index{
+		Key:      []string{"key"},
+		Unique:   true,
+		DropDups: false,
+	}
+	db.EnsureIndex(mongoOngoingEmailAddressValidationCollectionName, index)
+
+	automaticExpiration = mgo.Index{
+		Key:         []string{"createdat"},
+		ExpireAfter: time.Hour * 24,
+		Background:  true,
+	}
+	db.EnsureIndex(mongoOngoingEmailAddressValidationCollectionName, automaticExpiration)
+
+	index = mgo.Index{
+		Key:      []string{"username", "emailaddress"},
+		Unique:   true,
+		DropDups: true,
+	}
+	db.EnsureIndex(mongoValidatedEmailAddresses, index)
+
+	index = mgo.Index{
+		Key: []string{"emailaddress"},
+	}
+	db.EnsureIndex(mongoValidatedEmailAddresses, index)
 }
 
 //Manager is used to store users
@@ -123,6 +154,127 @@ func (manager *Manager) GetByUsernameValidatedPhonenumbers(username string) (val
 }
 
 
+func (manager *Manager) NewEmailAddressValidationInformation(username string, emailaddress string) (info *EmailAddressValidationInformation, err error) {
+	info = &EmailAddressValidationInformation{CreatedAt: time.Now(), Username: username, EmailAddress: emailaddress}
+	info.Key, err = generateRandomString()
+	if err != nil {
+		return
+	}
+	info.Secret, err = generateRandomString()
+	return
+}
+
+func (manager *Manager) SaveEmailAddressValidationInformation(info *EmailAddressValidationInformation) (err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoOngoingEmailAddressValidationCollectionName)
+	err = mgoCollection.Insert(info)
+	return
+}
+
+func (manager *Manager) RemoveEmailAddressValidationInformation(key string) (err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoOngoingEmailAddressValidationCollectionName)
+	_, err = mgoCollection.RemoveAll(bson.M{"key": key})
+	return
+}
+
+func (manager *Manager) UpdateEmailAddressValidationInformation(key string, confirmed bool) (err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoOngoingEmailAddressValidationCollectionName)
+	err = mgoCollection.Update(bson.M{"key": key}, bson.M{"$set": bson.M{"confirmed": confirmed}})
+	return
+}
+
+func (manager *Manager) GetByKeyEmailAddressValidationInformation(key string) (info *EmailAddressValidationInformation, err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoOngoingEmailAddressValidationCollectionName)
+	err = mgoCollection.Find(bson.M{"key": key}).One(&info)
+	if err == mgo.ErrNotFound {
+		info = nil
+		err = nil
+	}
+	return
+}
+
+func (manager *Manager) NewValidatedEmailAddress(username string, emailaddress string) (validatedemailaddress *ValidatedEmailAddress) {
+	validatedemailaddress = &ValidatedEmailAddress{CreatedAt: time.Now(), Username: username, EmailAddress: emailaddress}
+	return
+}
+
+//SaveValidatedEmailAddress stores a validated email address, validating an address again is not an error
+func (manager *Manager) SaveValidatedEmailAddress(validated *ValidatedEmailAddress) (err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoValidatedEmailAddresses)
+	_, err = mgoCollection.Upsert(bson.M{"username": validated.Username, "emailaddress": validated.EmailAddress}, validated)
+	return
+}
+
+func (manager *Manager) GetByUsernameValidatedEmailAddresses(username string) (validatedemailaddresses []ValidatedEmailAddress, err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoValidatedEmailAddresses)
+	err = mgoCollection.Find(bson.M{"username": username}).All(&validatedemailaddresses)
+	return
+}
+
+//GetByEmailAddressValidatedEmailAddresses returns the validations of an email address, the oldest validation first
+func (manager *Manager) GetByEmailAddressValidatedEmailAddresses(emailaddress string) (validatedemailaddresses []ValidatedEmailAddress, err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoValidatedEmailAddresses)
+	err = mgoCollection.Find(bson.M{"emailaddress": emailaddress}).Sort("createdat").All(&validatedemailaddresses)
+	return
+}
+
+//RemoveValidatedEmailAddress forgets that a user validated an email address
+func (manager *Manager) RemoveValidatedEmailAddress(username string, emailaddress string) (err error) {
+	mgoCollection := db.GetCollection(manager.session, mongoValidatedEmailAddresses)
+	_, err = mgoCollection.RemoveAll(bson.M{"username": username, "emailaddress": emailaddress})
+	return
+}
+
+//GetValidatedEmailAddresses returns the email addresses of a user that are validated by label
+func (manager *Manager) GetValidatedEmailAddresses(u *user.User) (validated map[string]string, err error) {
+	validatedemailaddresses, err := manager.GetByUsernameValidatedEmailAddresses(u.Username)
+	if err != nil {
+		return
+	}
+	validated = filterValidatedEmailAddresses(u.Email, validatedemailaddresses)
+	return
+}
+
+//filterValidatedEmailAddresses returns the labelled email addresses that are validated
+func filterValidatedEmailAddresses(emailaddresses map[string]string, validatedemailaddresses []ValidatedEmailAddress) (validated map[string]string) {
+	validated = make(map[string]string)
+	for label, emailaddress := range emailaddresses {
+		for _, validatedemailaddress := range validatedemailaddresses {
+			if emailaddress == validatedemailaddress.EmailAddress {
+				validated[label] = emailaddress
+				break
+			}
+		}
+	}
+	return
+}
+
+//GetPreferredValidatedEmailAddress returns the main email address of a user if it is validated, or another validated email address.
+// An empty string is returned if the user has no validated email address.
+func (manager *Manager) GetPreferredValidatedEmailAddress(u *user.User) (emailaddress string, err error) {
+	validated, err := manager.GetValidatedEmailAddresses(u)
+	if err != nil {
+		return
+	}
+	emailaddress = preferredEmailAddress(validated)
+	return
+}
+
+//preferredEmailAddress returns the email address labelled main, or the one with the first label in alphabetical order
+func preferredEmailAddress(emailaddresses map[string]string) string {
+	if emailaddress, found := emailaddresses["main"]; found {
+		return emailaddress
+	}
+	labels := make([]string, 0, len(emailaddresses))
+	for label := range emailaddresses {
+		labels = append(labels, label)
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+	sort.Strings(labels)
+	return emailaddresses[labels[0]]
+}
+
 func generateRandomString() (randomString string, err error) {
 	b := make([]byte, 32)
 	_, err = rand.Read(b)
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterValidatedEmailAddresses(t *testing.T) {
+	emailaddresses := map[string]string{"main": "bob@example.com", "work": "bob@work.com", "old": "bob@old.com"}
+	validated := filterValidatedEmailAddresses(emailaddresses, []ValidatedEmailAddress{
+		{Username: "bob", EmailAddress: "bob@work.com"},
+		{Username: "bob", EmailAddress: "bob@removed.com"},
+	})
+	assert.Equal(t, map[string]string{"work": "bob@work.com"}, validated)
+	assert.Empty(t, filterValidatedEmailAddresses(emailaddresses, nil))
+}
+
+func TestPreferredEmailAddress(t *testing.T) {
+	assert.Equal(t, "bob@example.com", preferredEmailAddress(map[string]string{"work": "bob@work.com", "main": "bob@example.com"}))
+	assert.Equal(t, "bob@home.com", preferredEmailAddress(map[string]string{"work": "bob@work.com", "home": "bob@home.com"}))
+	assert.Equal(t, "", preferredEmailAddress(map[string]string{}))
+}
+
+func TestEmailAddressValidationInformationIsFor(t *testing.T) {
+	info := &EmailAddressValidationInformation{Key: "key", Username: "bob", EmailAddress: "bob@example.com"}
+	assert.True(t, info.IsFor("bob", "bob@example.com"))
+	assert.False(t, info.IsFor("eve", "bob@example.com"))
+	assert.False(t, info.IsFor("bob", "eve@example.com"))
+}
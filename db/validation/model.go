@@ -19,3 +19,25 @@ type PhonenumberValidationInformation struct {
 	CreatedAt   time.Time
 }
 
+//ValidatedEmailAddress is a record of an email address for a user and when it is validated
+type ValidatedEmailAddress struct {
+	Username     string
+	EmailAddress string
+	CreatedAt    time.Time
+}
+
+//EmailAddressValidationInformation is a pending validation of an email address,
+// the secret is only sent to the email address
+type EmailAddressValidationInformation struct {
+	Key          string
+	Secret       string
+	Username     string
+	EmailAddress string
+	Confirmed    bool
+	CreatedAt    time.Time
+}
+
+//IsFor checks if the validation was requested for this email address of this user
+func (info *EmailAddressValidationInformation) IsFor(username string, emailaddress string) bool {
+	return info.Username == username && info.EmailAddress == emailaddress
+}
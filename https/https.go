@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"crypto/tls"
+	"crypto/x509"
 	"io/ioutil"
 	"net/http"
 
@@ -19,7 +20,7 @@ func PrepareHTTP(bindAddress string, handler http.Handler) *http.Server {
 	return s
 }
 
-func PrepareHTTPS(s *http.Server, cert string, key string, skipDev bool) error {
+func PrepareHTTPS(s *http.Server, cert string, key string, clientCA string, skipDev bool) error {
 	// Checking for TLS default keys
 	var certBytes, keyBytes []byte
 
@@ -73,5 +74,20 @@ func PrepareHTTPS(s *http.Server, cert string, key string, skipDev bool) error {
 		Certificates: []tls.Certificate{certifs},
 	}
 
+	// Client certificates are only requested to authenticate oauth clients (tls_client_auth)
+	if clientCA != "" {
+		log.Info("Loading TLS client CA certificates: ", clientCA)
+		caBytes, err := ioutil.ReadFile(clientCA)
+		if err != nil {
+			log.Panic("Cannot read the client CA certificates")
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caBytes) {
+			log.Panic("Cannot parse the client CA certificates")
+		}
+		s.TLSConfig.ClientCAs = clientCAs
+		s.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
 	return nil
 }
@@ -3,10 +3,18 @@ package organization
 import "github.com/itsyouonline/identityserver/oauthservice"
 
 type APIKey struct {
-	CallbackURL                string `json:"callbackURL,omitempty" validate:"min=5,max=250,nonzero"`
-	ClientCredentialsGrantType bool   `json:"clientCredentialsGrantType,omitempty" validate:"nonzero"`
-	Label                      string `json:"label" validate:"min=2,max=50"`
-	Secret                     string `json:"secret,omitempty" validate:"max=250,nonzero"`
+	CallbackURL                string                     `json:"callbackURL,omitempty" validate:"min=5,max=250,nonzero"`
+	ClientCredentialsGrantType bool                       `json:"clientCredentialsGrantType,omitempty" validate:"nonzero"`
+	Label                      string                     `json:"label" validate:"min=2,max=50"`
+	Public                     bool                       `json:"public,omitempty"`
+	RedirectURIs               []oauthservice.RedirectURI `json:"redirectURIs,omitempty"`
+	Secret                     string                     `json:"secret,omitempty" validate:"max=250,nonzero"`
+	TokenEndpointAuthMethod    string                     `json:"tokenEndpointAuthMethod,omitempty"`
+	PublicKey                  string                     `json:"publicKey,omitempty"`
+	TLSClientAuthSubjectDN     string                     `json:"tlsClientAuthSubjectDN,omitempty"`
+	BackChannelLogoutURI       string                     `json:"backChannelLogoutURI,omitempty"`
+	FrontChannelLogoutURI      string                     `json:"frontChannelLogoutURI,omitempty"`
+	PostLogoutRedirectURIs     []string                   `json:"postLogoutRedirectURIs,omitempty"`
 }
 
 //FromOAuthClient creates an APIKey instance from an oauthservice.Oauth2Client
@@ -14,8 +22,55 @@ func FromOAuthClient(client *oauthservice.Oauth2Client) APIKey {
 	apiKey := APIKey{
 		CallbackURL:                client.CallbackURL,
 		ClientCredentialsGrantType: client.ClientCredentialsGrantType,
-		Label:  client.Label,
-		Secret: client.Secret,
+		Label:                      client.Label,
+		Public:                     client.Public,
+		RedirectURIs:               client.RedirectURIs,
+		Secret:                     client.Secret,
+		TokenEndpointAuthMethod:    client.TokenEndpointAuthMethod,
+		PublicKey:                  client.PublicKey,
+		TLSClientAuthSubjectDN:     client.TLSClientAuthSubjectDN,
+		BackChannelLogoutURI:       client.BackChannelLogoutURI,
+		FrontChannelLogoutURI:      client.FrontChannelLogoutURI,
+		PostLogoutRedirectURIs:     client.PostLogoutRedirectURIs,
 	}
 	return apiKey
 }
+
+//applyToOAuthClient copies the properties an organization can set on an api key to an oauth client
+func (apiKey APIKey) applyToOAuthClient(client *oauthservice.Oauth2Client) {
+	client.Label = apiKey.Label
+	client.CallbackURL = apiKey.CallbackURL
+	client.ClientCredentialsGrantType = apiKey.ClientCredentialsGrantType
+	client.Public = apiKey.Public
+	client.RedirectURIs = apiKey.RedirectURIs
+	client.TokenEndpointAuthMethod = apiKey.TokenEndpointAuthMethod
+	client.PublicKey = apiKey.PublicKey
+	client.TLSClientAuthSubjectDN = apiKey.TLSClientAuthSubjectDN
+	client.BackChannelLogoutURI = apiKey.BackChannelLogoutURI
+	client.FrontChannelLogoutURI = apiKey.FrontChannelLogoutURI
+	client.PostLogoutRedirectURIs = apiKey.PostLogoutRedirectURIs
+}
+
+//hasValidRedirectURIs checks if the callback url, the redirect uris and the logout uris can be registered for an oauth client
+func (apiKey APIKey) hasValidRedirectURIs() bool {
+	if apiKey.CallbackURL != "" {
+		if err := (oauthservice.RedirectURI{URI: apiKey.CallbackURL}).Validate(); err != nil {
+			return false
+		}
+	}
+	for _, redirectURI := range apiKey.RedirectURIs {
+		if err := redirectURI.Validate(); err != nil {
+			return false
+		}
+	}
+	return oauthservice.ValidateLogoutURIs(apiKey.BackChannelLogoutURI, apiKey.FrontChannelLogoutURI, apiKey.PostLogoutRedirectURIs) == nil
+}
+
+//hasValidClientAuthentication checks if the client authentication method is configured correctly,
+// public clients can not authenticate so they can not use a client authentication method
+func (apiKey APIKey) hasValidClientAuthentication() bool {
+	if apiKey.Public && apiKey.TokenEndpointAuthMethod != "" {
+		return false
+	}
+	return oauthservice.ValidateClientAuthentication(apiKey.TokenEndpointAuthMethod, apiKey.PublicKey, apiKey.TLSClientAuthSubjectDN) == nil
+}
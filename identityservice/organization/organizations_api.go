@@ -10,10 +10,12 @@ import (
 
 	"sort"
 
+	"github.com/itsyouonline/identityserver/communication"
 	"github.com/itsyouonline/identityserver/db"
 	"github.com/itsyouonline/identityserver/identityservice/invitations"
 	"github.com/itsyouonline/identityserver/db/user"
 	"github.com/itsyouonline/identityserver/db/organization"
+	validationdb "github.com/itsyouonline/identityserver/db/validation"
 	"github.com/itsyouonline/identityserver/oauthservice"
 )
 
@@ -21,6 +23,7 @@ const itsyouonlineGlobalID = "itsyouonline"
 
 // OrganizationsAPI is the implementation for /organizations root endpoint
 type OrganizationsAPI struct {
+	EmailService communication.EmailService
 }
 
 // byGlobalID implements sort.Interface for []Organization based on
@@ -287,6 +290,7 @@ func (api OrganizationsAPI) globalidmembersPost(w http.ResponseWriter, r *http.R
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
+	api.sendInvitationEmail(r, orgReq)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -365,6 +369,7 @@ func (api OrganizationsAPI) globalidownersPost(w http.ResponseWriter, r *http.Re
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
+	api.sendInvitationEmail(r, orgReq)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -511,11 +516,25 @@ func (api OrganizationsAPI) CreateNewAPIKey(w http.ResponseWriter, r *http.Reque
 		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
 	}
+	if !apiKey.hasValidRedirectURIs() {
+		log.Debug("Invalid redirect uri")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if !apiKey.hasValidClientAuthentication() {
+		log.Debug("Invalid client authentication")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
 
 	log.Debug("Creating apikey:", apiKey)
 	c := oauthservice.NewOauth2Client(organization, apiKey.Label, apiKey.CallbackURL, apiKey.ClientCredentialsGrantType)
+	apiKey.applyToOAuthClient(c)
 
 	mgr := oauthservice.NewManager(r)
+	if !canShareClientID(w, mgr, c, "") {
+		return
+	}
 	err := mgr.CreateClient(c)
 	if db.IsDup(err) {
 		log.Debug("Duplicate label")
@@ -554,9 +573,25 @@ func (api OrganizationsAPI) UpdateAPIKey(w http.ResponseWriter, r *http.Request)
 		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
 	}
+	if !apiKey.hasValidRedirectURIs() {
+		log.Debug("Invalid redirect uri")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if !apiKey.hasValidClientAuthentication() {
+		log.Debug("Invalid client authentication")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	c := &oauthservice.Oauth2Client{ClientID: organization}
+	apiKey.applyToOAuthClient(c)
 
 	mgr := oauthservice.NewManager(r)
-	err := mgr.UpdateClient(organization, oldlabel, apiKey.Label, apiKey.CallbackURL, apiKey.ClientCredentialsGrantType)
+	if !canShareClientID(w, mgr, c, oldlabel) {
+		return
+	}
+	err := mgr.UpdateClient(organization, oldlabel, c)
 
 	if err != nil && db.IsDup(err) {
 		log.Debug("Duplicate label")
@@ -573,6 +608,23 @@ func (api OrganizationsAPI) UpdateAPIKey(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusCreated)
 }
 
+//canShareClientID checks that a public api key is not mixed with other api keys of the organization, they all share the organization as client_id.
+// If the api key can not be saved, a 409 Conflict is written to the response.
+func canShareClientID(w http.ResponseWriter, mgr *oauthservice.Manager, c *oauthservice.Oauth2Client, oldlabel string) bool {
+	shared, err := oauthservice.ClientIDSharedWithPublicClient(mgr, c, oldlabel)
+	if err != nil {
+		log.Error("Error getting the api keys of the organization: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return false
+	}
+	if shared {
+		log.Debug("A public api key can not be combined with other api keys of the organization")
+		http.Error(w, http.StatusText(http.StatusConflict), http.StatusConflict)
+		return false
+	}
+	return true
+}
+
 // DeleteAPIKey is the handler for DELETE /organizations/{globalid}/apikeys/{label}
 // Removes an API key
 func (api OrganizationsAPI) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
@@ -683,3 +735,33 @@ func (api OrganizationsAPI) DeleteDns(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+//sendInvitationEmail lets an invited user know on a validated email address that there is an invitation to accept or reject
+func (api OrganizationsAPI) sendInvitationEmail(r *http.Request, invitation *invitations.JoinOrganizationInvitation) {
+	if api.EmailService == nil {
+		return
+	}
+	u, err := user.NewManager(r).GetByName(invitation.User)
+	if err != nil {
+		log.Error("Error getting the invited user: ", err)
+		return
+	}
+	email, err := validationdb.NewManager(r).GetPreferredValidatedEmailAddress(u)
+	if err != nil {
+		log.Error("Error getting the validated email addresses: ", err)
+		return
+	}
+	if email == "" {
+		return
+	}
+	data := struct {
+		Organization string
+		Role         string
+		Link         string
+	}{
+		Organization: invitation.Organization,
+		Role:         invitation.Role,
+		Link:         oauthservice.PublicURL + "/",
+	}
+	go communication.SendTemplate(api.EmailService, []string{email}, communication.InvitationEmail, data)
+}
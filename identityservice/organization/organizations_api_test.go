@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/itsyouonline/identityserver/oauthservice"
 )
 
 func TestAPIKeyLabelValidation(t *testing.T) {
@@ -23,3 +25,25 @@ func TestAPIKeyLabelValidation(t *testing.T) {
 		assert.Equal(t, test.valid, isValidAPIKeyLabel(test.label), test.label)
 	}
 }
+
+func TestAPIKeyApplyToOAuthClient(t *testing.T) {
+	apiKey := APIKey{
+		Label:                   "web",
+		CallbackURL:             "https://app.example.com/callback",
+		RedirectURIs:            []oauthservice.RedirectURI{{URI: "https://app.example.com/other"}},
+		TokenEndpointAuthMethod: oauthservice.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  "CN=web",
+		BackChannelLogoutURI:    "https://app.example.com/logout",
+	}
+	client := &oauthservice.Oauth2Client{ClientID: "org1", Label: "old", Secret: "secret"}
+	apiKey.applyToOAuthClient(client)
+	assert.Equal(t, "org1", client.ClientID)
+	assert.Equal(t, "secret", client.Secret)
+	assert.Equal(t, "web", client.Label)
+	assert.Equal(t, apiKey.CallbackURL, client.CallbackURL)
+	assert.Equal(t, apiKey.RedirectURIs, client.RedirectURIs)
+	assert.Equal(t, apiKey.TokenEndpointAuthMethod, client.TokenEndpointAuthMethod)
+	assert.Equal(t, apiKey.TLSClientAuthSubjectDN, client.TLSClientAuthSubjectDN)
+	assert.Equal(t, apiKey.BackChannelLogoutURI, client.BackChannelLogoutURI)
+	assert.False(t, client.Public)
+}
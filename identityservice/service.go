@@ -27,21 +27,24 @@ import (
 //Service is the identityserver http service
 type Service struct {
 	smsService                   communication.SMSService
+	emailService                 communication.EmailService
 	phonenumberValidationService *validation.IYOPhonenumberValidationService
+	emailValidationService       *validation.IYOEmailValidationService
 }
 
 //NewService creates and initializes a Service
-func NewService(smsService communication.SMSService) (service *Service) {
-	service = &Service{smsService: smsService}
+func NewService(smsService communication.SMSService, emailService communication.EmailService) (service *Service) {
+	service = &Service{smsService: smsService, emailService: emailService}
 	p := &validation.IYOPhonenumberValidationService{SMSService: smsService}
 	service.phonenumberValidationService = p
+	service.emailValidationService = &validation.IYOEmailValidationService{EmailService: emailService}
 	return
 }
 
 //AddRoutes registers the http routes with the router.
 func (service *Service) AddRoutes(router *mux.Router) {
 	// User API
-	user.UsersInterfaceRoutes(router, user.UsersAPI{SmsService: service.smsService, PhonenumberValidationService: service.phonenumberValidationService})
+	user.UsersInterfaceRoutes(router, user.UsersAPI{SmsService: service.smsService, PhonenumberValidationService: service.phonenumberValidationService, EmailValidationService: service.emailValidationService})
 	userdb.InitModels()
 
 	// Company API
@@ -49,7 +52,7 @@ func (service *Service) AddRoutes(router *mux.Router) {
 	companydb.InitModels()
 
 	// Organization API
-	organization.OrganizationsInterfaceRoutes(router, organization.OrganizationsAPI{})
+	organization.OrganizationsInterfaceRoutes(router, organization.OrganizationsAPI{EmailService: service.emailService})
 	userorganization.UsersusernameorganizationsInterfaceRoutes(router, userorganization.UsersusernameorganizationsAPI{})
 	organizationdb.InitModels()
 
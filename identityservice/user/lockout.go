@@ -0,0 +1,10 @@
+package user
+
+import "time"
+
+//Lockout shows the failed login attempts of a user and if the account is locked
+type Lockout struct {
+	Locked         bool       `json:"locked"`
+	LockedUntil    *time.Time `json:"lockeduntil,omitempty"`
+	FailedAttempts int        `json:"failedattempts"`
+}
@@ -8,9 +8,8 @@ import (
 	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 	"github.com/dgrijalva/jwt-go"
+	"github.com/itsyouonline/identityserver/db/organization"
 	"github.com/itsyouonline/identityserver/oauthservice"
-	"fmt"
-	"crypto/ecdsa"
 )
 
 // Oauth2oauth_2_0Middleware is oauth2 middleware for oauth_2_0
@@ -19,7 +18,15 @@ type Oauth2oauth_2_0Middleware struct {
 	Field       string
 	Scopes      []string
 }
-var JWTPublicKey ecdsa.PublicKey
+
+//JWTKeyRing holds the keys to verify the JWTs issued by itsyou.online
+var JWTKeyRing *oauthservice.KeyRing
+
+//itsyouonlineAdminScope is available to the administrators of itsyou.online, the owners of the itsyouonline organization.
+// The itsyouonline organization can not be created through the api.
+const itsyouonlineAdminScope = "itsyouonline:admin"
+
+const itsyouonlineAdminOrganization = "itsyouonline"
 
 // newOauth2oauth_2_0Middlewarecreate new Oauth2oauth_2_0Middleware struct
 func newOauth2oauth_2_0Middleware(scopes []string) *Oauth2oauth_2_0Middleware {
@@ -65,14 +72,8 @@ func (om *Oauth2oauth_2_0Middleware) Handler(next http.Handler) http.Handler {
 		//Get the actual token out of the header (accept 'token ABCD' as well as just 'ABCD' and ignore some possible whitespace)
 		if strings.HasPrefix(accessToken, "bearer") {
 			jwtstring := strings.TrimSpace(strings.TrimPrefix(accessToken, "bearer"))
-			token, err := jwt.Parse(jwtstring, func(token *jwt.Token) (interface{}, error) {
-				// Don't forget to validate the alg is what you expect:
-
-				if token.Method != jwt.SigningMethodES384 {
-				    return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
-				}
-				return &JWTPublicKey, nil
-			})
+			//The keyring validates the alg and picks the verification key by kid
+			token, err := jwt.Parse(jwtstring, JWTKeyRing.Keyfunc)
 			if err != nil || !token.Valid {
 				log.Error(err)
 				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
@@ -116,10 +117,23 @@ func (om *Oauth2oauth_2_0Middleware) Handler(next http.Handler) http.Handler {
 		if strings.HasPrefix(atscopestring, "user:") {
 			scopes = append(scopes, "user:info")
 		}
+		//Only look up the administrators when the scope is needed
+		if clientId == "itsyouonline" && atscopestring == "admin" && len(om.Scopes) > 0 && om.CheckScopes([]string{itsyouonlineAdminScope}) {
+			isAdmin, err := organization.NewManager(r).IsOwner(itsyouonlineAdminOrganization, username)
+			if err != nil {
+				log.Error(err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			if isAdmin {
+				scopes = append(scopes, itsyouonlineAdminScope)
+			}
+		}
 
 		log.Debug("Available scopes: ", scopes)
 
 		context.Set(r, "client_id", clientId)
+		context.Set(r, "authenticateduser", username)
 		context.Set(r, "availablescopes", atscopestring)
 
 		// check scopes
@@ -12,16 +12,21 @@ import (
 	"github.com/itsyouonline/identityserver/credentials/password"
 	"github.com/itsyouonline/identityserver/db/user"
 	validationdb "github.com/itsyouonline/identityserver/db/validation"
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
+	"github.com/itsyouonline/identityserver/db/throttle"
 	"github.com/itsyouonline/identityserver/db/user/apikey"
 	"github.com/itsyouonline/identityserver/communication"
 	"github.com/itsyouonline/identityserver/validation"
+	"github.com/itsyouonline/identityserver/oauthservice"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type UsersAPI struct {
 	SmsService                   communication.SMSService
 	PhonenumberValidationService *validation.IYOPhonenumberValidationService
+	EmailValidationService       *validation.IYOEmailValidationService
 }
 
 // It is handler for POST /users
@@ -203,10 +208,119 @@ func (api UsersAPI) DeleteEmailAddress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	emailaddress := u.Email[label]
+	delete(u.Email, label)
+	stillUsed := false
+	for _, otheremailaddress := range u.Email {
+		stillUsed = stillUsed || otheremailaddress == emailaddress
+	}
+	if !stillUsed {
+		if err = validationdb.NewManager(r).RemoveValidatedEmailAddress(username, emailaddress); err != nil {
+			log.Error("Error removing the validation of a deleted email address: ", err)
+		}
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 
 }
 
+// usernameemailaddressesGet is the handler for GET /users/{username}/emailaddresses
+func (api UsersAPI) usernameemailaddressesGet(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+	validated := strings.Contains(r.URL.RawQuery, "validated")
+	userMgr := user.NewManager(r)
+
+	userobj, err := userMgr.GetByName(username)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	emailaddresses := userobj.Email
+	if validated {
+		emailaddresses, err = validationdb.NewManager(r).GetValidatedEmailAddresses(userobj)
+		if err != nil {
+			log.Error(err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(emailaddresses)
+}
+
+// ValidateEmailAddress is the handler for POST /users/{username}/emailaddresses/{label}/validate
+// Sends an email with a link to confirm the email address
+func (api UsersAPI) ValidateEmailAddress(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+	label := mux.Vars(r)["label"]
+	userMgr := user.NewManager(r)
+
+	userobj, err := userMgr.GetByName(username)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	emailaddress, ok := userobj.Email[label]
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	validationKey, err := api.EmailValidationService.RequestValidation(r, username, emailaddress, oauthservice.PublicURL+"/emailvalidation")
+	if err != nil {
+		log.Error("Error requesting the validation of an email address: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	response := struct {
+		ValidationKey string `json:"validationkey"`
+	}{
+		validationKey,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetEmailAddressValidation is the handler for GET /users/{username}/emailaddresses/{label}/validate
+// Checks if the email address is confirmed with the link in the email of a validation
+func (api UsersAPI) GetEmailAddressValidation(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+	label := mux.Vars(r)["label"]
+	userMgr := user.NewManager(r)
+
+	userobj, err := userMgr.GetByName(username)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	emailaddress, ok := userobj.Email[label]
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	confirmed, err := api.EmailValidationService.IsConfirmed(r, r.URL.Query().Get("validationkey"), username, emailaddress)
+	if err == validation.ErrInvalidOrExpiredKey {
+		writeErrorResponse(w, 422, err.Error())
+		return
+	}
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	response := struct {
+		Confirmed bool `json:"confirmed"`
+	}{
+		confirmed,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
 // DeleteGithubAccount is the handler for DELETE /users/{username}/github
 // Delete the associated Github account.
 func (api UsersAPI) DeleteGithubAccount(w http.ResponseWriter, r *http.Request) {
@@ -250,8 +364,8 @@ func (api UsersAPI) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	userMgr := user.NewManager(r)
-	exists, err := userMgr.Exists(username)
-	if ! exists || err != nil  {
+	userobj, err := userMgr.GetByName(username)
+	if err != nil {
 		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		return
 	}
@@ -266,11 +380,16 @@ func (api UsersAPI) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 		writeErrorResponse(w, 422, "incorrect_password")
 		return
 	}
-	err = passwordMgr.Save(username, body.Newpassword)
-	if err != nil {
+	err = passwordMgr.Save(username, body.Newpassword, userobj.GetEmailAddresses())
+	if password.IsPolicyError(err) {
 		writeErrorResponse(w, 422, err.Error())
 		return
 	}
+	if err != nil {
+		log.Error("Error saving the new password: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -298,58 +417,23 @@ func (api UsersAPI) GetUserInformation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	filteredUser := authorization.FilterUser(userobj)
 	respBody := &Userview{
-		Username: userobj.Username,
-	}
-
-	if authorization.Name {
-		respBody.Firstname = userobj.Firstname
-		respBody.Lastname = userobj.Lastname
-	}
-	if authorization.Github {
-		respBody.Github = userobj.Github.Name
-	}
-	if authorization.Facebook {
-		respBody.Facebook = userobj.Facebook.Name
-	}
-	if authorization.Address != nil {
-		respBody.Address = make(map[string]user.Address)
-
-		for requestedLabel, realLabel := range authorization.Address {
-			if value, found := userobj.Address[realLabel]; found {
-				respBody.Address[requestedLabel] = value
-			}
-		}
-	}
-
-	if authorization.Email != nil {
-		respBody.Email = make(map[string]string)
-
-		for requestedLabel, realLabel := range authorization.Email {
-			if value, found := userobj.Email[realLabel]; found {
-				respBody.Email[requestedLabel] = value
-			}
-		}
-	}
-
-	if authorization.Phone != nil {
-		respBody.Phone = make(map[string]user.Phonenumber)
-
-		for requestedLabel, realLabel := range authorization.Phone {
-			if value, found := userobj.Phone[realLabel]; found {
-				respBody.Phone[requestedLabel] = value
-			}
-		}
-	}
-
-	if authorization.Bank != nil {
-		respBody.Bank = make(map[string]user.BankAccount)
-
-		for requestedLabel, realLabel := range authorization.Bank {
-			if value, found := userobj.Bank[realLabel]; found {
-				respBody.Bank[requestedLabel] = value
-			}
-		}
+		Username:  filteredUser.Username,
+		Firstname: filteredUser.Firstname,
+		Lastname:  filteredUser.Lastname,
+		Github:    filteredUser.Github.Name,
+		Facebook:  filteredUser.Facebook.Name,
+		Address:   filteredUser.Address,
+		Email:     filteredUser.Email,
+		Phone:     filteredUser.Phone,
+		Bank:      filteredUser.Bank,
+	}
+	respBody.ValidatedEmail, err = validationdb.NewManager(r).GetValidatedEmailAddresses(filteredUser)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -1112,6 +1196,110 @@ func (api UsersAPI) DeleteAuthorization(w http.ResponseWriter, r *http.Request)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
+
+	//Without an authorization, the tokens the organization already holds should not be usable anymore either
+	err = oauthservice.NewManager(r).RevokeClientTokensForUser(username, grantedTo)
+	if err != nil {
+		log.Error("Error revoking the tokens of ", grantedTo, " for ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSessions is the handler for GET /users/{username}/sessions
+// Get the active sessions of the user on the itsyou.online website.
+func (api UsersAPI) GetSessions(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+
+	sessions, err := sessiondb.NewManager(r).GetByUser(username)
+	if err != nil {
+		log.Error("Error getting the sessions of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-type", "application/json")
+	json.NewEncoder(w).Encode(sessions)
+}
+
+// DeleteSession is the handler for DELETE /users/{username}/sessions/{sessionid}
+// Log out a single session, the tokens the itsyou.online website got during that session are revoked as well.
+func (api UsersAPI) DeleteSession(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+	sessionID := mux.Vars(r)["sessionid"]
+
+	removed, err := sessiondb.NewManager(r).DeleteForUser(username, sessionID)
+	if err != nil {
+		log.Error("Error removing a session of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !removed {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err = oauthservice.NewManager(r).RevokeSessionTokens(sessionID); err != nil {
+		log.Error("Error revoking the tokens of a session of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLockout is the handler for GET /users/{username}/lockout
+// Get the failed login attempts and the lock of the account.
+func (api UsersAPI) GetLockout(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+
+	t, err := throttle.NewManager(r).Get(throttle.UserKey(username))
+	if err != nil {
+		log.Error("Error getting the failed login attempts of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	response := Lockout{}
+	if t != nil {
+		response.Locked = t.IsLockedAt(time.Now())
+		response.FailedAttempts = t.Failures
+		if response.Locked {
+			response.LockedUntil = &t.LockedUntil
+		}
+	}
+
+	w.Header().Set("Content-type", "application/json")
+	json.NewEncoder(w).Encode(&response)
+}
+
+// UnlockAccount is the handler for DELETE /users/{username}/lockout
+// Unlock the account and forget the failed login attempts.
+func (api UsersAPI) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+
+	if err := throttle.NewManager(r).Unlock(username); err != nil {
+		log.Error("Error unlocking the account of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	log.Info("The account of ", username, " is unlocked by ", context.Get(r, "authenticateduser"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteAllSessions is the handler for DELETE /users/{username}/sessions
+// Log out everywhere, next to the sessions the tokens the itsyou.online website uses are revoked as well.
+func (api UsersAPI) DeleteAllSessions(w http.ResponseWriter, r *http.Request) {
+	username := mux.Vars(r)["username"]
+
+	if err := sessiondb.NewManager(r).DeleteAllForUser(username); err != nil {
+		log.Error("Error removing the sessions of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if err := oauthservice.NewManager(r).RevokeClientTokensForUser(username, "itsyouonline"); err != nil {
+		log.Error("Error revoking the itsyouonline tokens of ", username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -60,6 +60,14 @@ type UsersInterface interface { // Post is the handler for POST /users
 	// DeleteEmailAddress is the handler for DELETE /users/{username}/emailaddresses/{label}
 	// Removes an email address
 	DeleteEmailAddress(http.ResponseWriter, *http.Request)
+	// usernameemailaddressesGet is the handler for GET /users/{username}/emailaddresses
+	usernameemailaddressesGet(http.ResponseWriter, *http.Request)
+	// ValidateEmailAddress is the handler for POST /users/{username}/emailaddresses/{label}/validate
+	// Sends an email with a link to confirm the email address
+	ValidateEmailAddress(http.ResponseWriter, *http.Request)
+	// GetEmailAddressValidation is the handler for GET /users/{username}/emailaddresses/{label}/validate
+	// Checks if the email address is confirmed with the link in the email of a validation
+	GetEmailAddressValidation(http.ResponseWriter, *http.Request)
 	// DeleteGithubAccount is the handler for DELETE /users/{username}/github
 	// Unlink Github Account
 	DeleteGithubAccount(http.ResponseWriter, *http.Request)
@@ -102,6 +110,21 @@ type UsersInterface interface { // Post is the handler for POST /users
 	// Remove the authorization for an organization, the granted organization will no longer
 	// have access the user's information.
 	DeleteAuthorization(http.ResponseWriter, *http.Request)
+	// GetSessions is the handler for GET /users/{username}/sessions
+	// Get the active sessions of the user on the itsyou.online website.
+	GetSessions(http.ResponseWriter, *http.Request)
+	// DeleteAllSessions is the handler for DELETE /users/{username}/sessions
+	// Log out everywhere.
+	DeleteAllSessions(http.ResponseWriter, *http.Request)
+	// DeleteSession is the handler for DELETE /users/{username}/sessions/{sessionid}
+	// Log out a single session, the tokens the itsyou.online website got during that session are revoked.
+	DeleteSession(http.ResponseWriter, *http.Request)
+	// GetLockout is the handler for GET /users/{username}/lockout
+	// Get the failed login attempts and the lock of the account.
+	GetLockout(http.ResponseWriter, *http.Request)
+	// UnlockAccount is the handler for DELETE /users/{username}/lockout
+	// Unlock the account and forget the failed login attempts.
+	UnlockAccount(http.ResponseWriter, *http.Request)
 	// Add API Key
 	AddAPIKey(http.ResponseWriter, *http.Request)
 	GetAPIKey(http.ResponseWriter, *http.Request)
@@ -134,9 +157,12 @@ func UsersInterfaceRoutes(r *mux.Router, i UsersInterface) {
 	r.Handle("/users/{username}/apikeys/{label}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.UpdateAPIKey))).Methods("PUT")
 	r.Handle("/users/{username}/apikeys/{label}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteAPIKey))).Methods("DELETE")
 	r.Handle("/users/{username}/facebook", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteFacebookAccount))).Methods("DELETE")
+	r.Handle("/users/{username}/emailaddresses", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.usernameemailaddressesGet))).Methods("GET")
 	r.Handle("/users/{username}/emailaddresses", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.RegisterNewEmailAddress))).Methods("POST")
 	r.Handle("/users/{username}/emailaddresses/{label}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.UpdateEmailAddress))).Methods("PUT")
 	r.Handle("/users/{username}/emailaddresses/{label}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteEmailAddress))).Methods("DELETE")
+	r.Handle("/users/{username}/emailaddresses/{label}/validate", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.ValidateEmailAddress))).Methods("POST")
+	r.Handle("/users/{username}/emailaddresses/{label}/validate", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.GetEmailAddressValidation))).Methods("GET")
 	r.Handle("/users/{username}/github", alice.New(newOauth2oauth_2_0Middleware([]string{}).Handler).Then(http.HandlerFunc(i.DeleteGithubAccount))).Methods("DELETE")
 	r.Handle("/users/{username}/info", alice.New(newOauth2oauth_2_0Middleware([]string{"user:info", "user:admin"}).Handler).Then(http.HandlerFunc(i.GetUserInformation))).Methods("GET")
 	r.Handle("/users/{username}/addresses", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.usernameaddressesGet))).Methods("GET")
@@ -152,4 +178,9 @@ func UsersInterfaceRoutes(r *mux.Router, i UsersInterface) {
 	r.Handle("/users/{username}/authorizations/{grantedTo}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.GetAuthorization))).Methods("GET")
 	r.Handle("/users/{username}/authorizations/{grantedTo}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.UpdateAuthorization))).Methods("PUT")
 	r.Handle("/users/{username}/authorizations/{grantedTo}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteAuthorization))).Methods("DELETE")
+	r.Handle("/users/{username}/sessions", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.GetSessions))).Methods("GET")
+	r.Handle("/users/{username}/sessions", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteAllSessions))).Methods("DELETE")
+	r.Handle("/users/{username}/sessions/{sessionid}", alice.New(newOauth2oauth_2_0Middleware([]string{"user:admin"}).Handler).Then(http.HandlerFunc(i.DeleteSession))).Methods("DELETE")
+	r.Handle("/users/{username}/lockout", alice.New(newOauth2oauth_2_0Middleware([]string{"itsyouonline:admin"}).Handler).Then(http.HandlerFunc(i.GetLockout))).Methods("GET")
+	r.Handle("/users/{username}/lockout", alice.New(newOauth2oauth_2_0Middleware([]string{"itsyouonline:admin"}).Handler).Then(http.HandlerFunc(i.UnlockAccount))).Methods("DELETE")
 }
@@ -1,14 +1,19 @@
 package main
 
 import (
+	"crypto/ecdsa"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"strings"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/codegangsta/cli"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/itsyouonline/identityserver/communication"
+	"github.com/itsyouonline/identityserver/credentials/password"
+	"github.com/itsyouonline/identityserver/credentials/password/keyderivation"
 	"github.com/itsyouonline/identityserver/db"
 	"github.com/itsyouonline/identityserver/globalconfig"
 	"github.com/itsyouonline/identityserver/https"
@@ -28,9 +33,15 @@ func main() {
 	log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
 
 	var debugLogging, ignoreDevcert bool
-	var bindAddress, dbConnectionString string
-	var tlsCert, tlsKey string
+	var bindAddress, dbConnectionString, publicURL string
+	var tlsCert, tlsKey, tlsClientCA string
 	var twilioAccountSID, twilioAuthToken, twilioMessagingServiceSID string
+	var smtpServer, smtpUser, smtpPassword, emailFrom, emailDirectory string
+	var smtpPort int
+	var passwordKDF string
+	var argon2Time, argon2Memory, argon2Threads, bcryptCost int
+	var passwordMinLength, passwordHistory int
+	var breachedPasswordsFile string
 
 	app.Flags = []cli.Flag{
 		cli.BoolFlag{
@@ -44,6 +55,12 @@ func main() {
 			Value:       ":8443",
 			Destination: &bindAddress,
 		},
+		cli.StringFlag{
+			Name:        "public-url",
+			Usage:       "Url users and clients reach this instance on, it is the OpenID Connect issuer and the base of the links sent to users",
+			Value:       oauthservice.PublicURL,
+			Destination: &publicURL,
+		},
 		cli.StringFlag{
 			Name:        "connectionstring, c",
 			Usage:       "Mongodb connection string",
@@ -62,6 +79,17 @@ func main() {
 			Value:       "",
 			Destination: &tlsKey,
 		},
+		cli.StringFlag{
+			Name:        "client-ca",
+			Usage:       "Path to the CA certificates that issue TLS client certificates for oauth clients using tls_client_auth",
+			Value:       "",
+			Destination: &tlsClientCA,
+		},
+		cli.BoolFlag{
+			Name:        "legacy-callback-url-prefix",
+			Usage:       "Deprecated, will be removed in the next release. Keep matching the callback url of api keys created before redirect uris were matched exactly as a prefix, saving an api key switches it to exact matching",
+			Destination: &oauthservice.LegacyCallbackURLPrefixMatching,
+		},
 		cli.BoolFlag{
 			Name:        "ignore-devcert, i",
 			Usage:       "Ignore default devcert even if exists",
@@ -82,6 +110,85 @@ func main() {
 			Usage:       "Twilio MessagingServiceSID",
 			Destination: &twilioMessagingServiceSID,
 		},
+		cli.StringFlag{
+			Name:        "smtp-server",
+			Usage:       "SMTP server to send emails with",
+			Destination: &smtpServer,
+		},
+		cli.IntFlag{
+			Name:        "smtp-port",
+			Usage:       "SMTP server port",
+			Value:       587,
+			Destination: &smtpPort,
+		},
+		cli.StringFlag{
+			Name:        "smtp-user",
+			Usage:       "SMTP username",
+			Destination: &smtpUser,
+		},
+		cli.StringFlag{
+			Name:        "smtp-password",
+			Usage:       "SMTP password",
+			Destination: &smtpPassword,
+		},
+		cli.StringFlag{
+			Name:        "email-from",
+			Usage:       "Sender address of the emails",
+			Value:       "itsyou.online <noreply@itsyou.online>",
+			Destination: &emailFrom,
+		},
+		cli.StringFlag{
+			Name:        "email-dir",
+			Usage:       "Directory the development email implementation writes .eml files to instead of logging the emails",
+			Destination: &emailDirectory,
+		},
+		cli.StringFlag{
+			Name:        "password-kdf",
+			Usage:       "Key derivation function for new password hashes (argon2id or bcrypt), existing hashes are migrated on login",
+			Value:       "argon2id",
+			Destination: &passwordKDF,
+		},
+		cli.IntFlag{
+			Name:        "argon2-time",
+			Usage:       "Number of passes over the memory for argon2id",
+			Value:       3,
+			Destination: &argon2Time,
+		},
+		cli.IntFlag{
+			Name:        "argon2-memory",
+			Usage:       "Memory in KiB used by argon2id",
+			Value:       64 * 1024,
+			Destination: &argon2Memory,
+		},
+		cli.IntFlag{
+			Name:        "argon2-threads",
+			Usage:       "Number of threads used by argon2id",
+			Value:       4,
+			Destination: &argon2Threads,
+		},
+		cli.IntFlag{
+			Name:        "bcrypt-cost",
+			Usage:       "Cost of bcrypt",
+			Value:       12,
+			Destination: &bcryptCost,
+		},
+		cli.IntFlag{
+			Name:        "password-min-length",
+			Usage:       "Minimum number of characters of a password",
+			Value:       6,
+			Destination: &passwordMinLength,
+		},
+		cli.IntFlag{
+			Name:        "password-history",
+			Usage:       "Number of last passwords of a user that can not be reused, 0 to allow reusing passwords. Every remembered password costs a key derivation when a password is changed, at most 10 are remembered",
+			Value:       5,
+			Destination: &passwordHistory,
+		},
+		cli.StringFlag{
+			Name:        "breached-passwords",
+			Usage:       "Path to a list of SHA1 hashes of breached passwords (haveibeenpwned format) that can not be used. The list is kept in memory, about 120 bytes per hash, lists of up to 10 million hashes (1.2 GB) are supported",
+			Destination: &breachedPasswordsFile,
+		},
 	}
 
 	app.Before = func(c *cli.Context) error {
@@ -90,6 +197,29 @@ func main() {
 			log.Debug("Debug logging enabled")
 			log.Debug(app.Name, "-", app.Version)
 		}
+		if u, err := url.Parse(publicURL); err != nil || u.Scheme != "https" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			log.Fatal("The public url should be an https url without a path: ", publicURL)
+		}
+		oauthservice.PublicURL = strings.TrimSuffix(publicURL, "/")
+		if oauthservice.LegacyCallbackURLPrefixMatching {
+			log.Warn("Matching the callback url of legacy api keys as a prefix, this option will be removed in the next release")
+		}
+
+		var err error
+		switch passwordKDF {
+		case "argon2id":
+			keyderivation.Current, err = keyderivation.NewArgon2id(argon2Time, argon2Memory, argon2Threads)
+		case "bcrypt":
+			keyderivation.Current, err = keyderivation.NewBcrypt(bcryptCost)
+		default:
+			log.Fatal("Unknown password key derivation function: ", passwordKDF)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if passwordHistory < 0 || passwordHistory > password.MaxHistorySize {
+			log.Fatal("The password history should be between 0 and ", password.MaxHistorySize, ": ", passwordHistory)
+		}
 		return nil
 	}
 
@@ -98,6 +228,17 @@ func main() {
 		go db.Connect(dbConnectionString)
 		defer db.Close()
 
+		password.CurrentPolicy.MinLength = passwordMinLength
+		password.CurrentPolicy.HistorySize = passwordHistory
+		if breachedPasswordsFile != "" {
+			breachedPasswords, err := password.LoadBreachedPasswords(breachedPasswordsFile)
+			if err != nil {
+				log.Fatal("Error loading the breached passwords: ", err)
+			}
+			log.Info("Loaded ", breachedPasswords.Len(), " breached password hashes")
+			password.CurrentPolicy.BreachedPasswords = breachedPasswords
+		}
+
 		cookieSecret := identityservice.GetCookieSecret()
 		var smsService communication.SMSService
 		if twilioAccountSID != "" {
@@ -113,38 +254,40 @@ func main() {
 			smsService = &communication.DevSMSService{}
 		}
 
-		sc := siteservice.NewService(cookieSecret, smsService)
-		is := identityservice.NewService(smsService)
-
-		config := globalconfig.NewManager()
-
-		var jwtKey []byte
-		var err error
-		exists, err := config.Exists("jwtkey")
-		if err == nil && exists {
-			var jwtKeyConfig *globalconfig.GlobalConfig
-			jwtKeyConfig, err = config.GetByKey("jwtkey")
-			jwtKey = []byte(jwtKeyConfig.Value)
-		} else {
-			if err == nil {
-				if _, err := os.Stat("devcert/jwt_key.pem"); err == nil {
-					log.Warning("===============================================================================")
-					log.Warning("This instance uses a development JWT signing key, don't do this in production !")
-					log.Warning("===============================================================================")
-
-					jwtKey, err = ioutil.ReadFile("devcert/jwt_key.pem")
-				}
+		var emailService communication.EmailService
+		if smtpServer != "" {
+			emailService = &communication.SMTPEmailService{
+				Host:     smtpServer,
+				Port:     smtpPort,
+				Username: smtpUser,
+				Password: smtpPassword,
+				From:     emailFrom,
 			}
+		} else {
+			log.Warn("============================================================================")
+			log.Warn("No SMTP server provided, falling back to development implementation")
+			log.Warn("============================================================================")
+			emailService = &communication.DevEmailService{Directory: emailDirectory, From: emailFrom}
 		}
+
+		sc := siteservice.NewService(cookieSecret, smsService, emailService)
+		is := identityservice.NewService(smsService, emailService)
+
+		keyRing, err := oauthservice.LoadKeyRing()
 		if err != nil {
-			log.Fatal("Unable to load a valid key for signing JWT's: ", err)
+			log.Fatal("Unable to load the JWT signing keys: ", err)
 		}
-		ecdsaKey, err := jwt.ParseECPrivateKeyFromPEM(jwtKey)
-		if err != nil {
-			log.Fatal("Unable to load a valid key for signing JWT's: ", err)
+		if keyRing.Empty() {
+			ecdsaKey, err := loadBootstrapJWTKey()
+			if err != nil {
+				log.Fatal("Unable to load a valid key for signing JWT's: ", err)
+			}
+			if err = keyRing.Bootstrap(ecdsaKey); err != nil {
+				log.Fatal("Unable to store the key for signing JWT's: ", err)
+			}
 		}
-		user.JWTPublicKey = ecdsaKey.PublicKey
-		oauthsc, err := oauthservice.NewService(sc, is, ecdsaKey)
+		user.JWTKeyRing = keyRing
+		oauthsc, err := oauthservice.NewService(sc, is, keyRing)
 		if err != nil {
 			log.Fatal("Unable to create the oauthservice: ", err)
 		}
@@ -152,12 +295,57 @@ func main() {
 		r := routes.GetRouter(sc, is, oauthsc)
 
 		server := https.PrepareHTTP(bindAddress, r)
-		https.PrepareHTTPS(server, tlsCert, tlsKey, ignoreDevcert)
+		https.PrepareHTTPS(server, tlsCert, tlsKey, tlsClientCA, ignoreDevcert)
 
 		// Go make magic over HTTPS
 		log.Info("Listening (https) on ", bindAddress)
 		log.Fatal(server.ListenAndServeTLS("", ""))
 	}
 
+	app.Commands = []cli.Command{
+		{
+			Name:  "rotate-jwt-key",
+			Usage: "Generate a new JWT signing key, the current key is retired but remains valid for verification",
+			Action: func(c *cli.Context) {
+				db.Connect(dbConnectionString)
+				defer db.Close()
+
+				kid, err := oauthservice.RotateSigningKey()
+				if err != nil {
+					log.Fatal("Unable to rotate the JWT signing key: ", err)
+				}
+				log.Info("New JWT signing key is active, kid: ", kid)
+			},
+		},
+	}
+
 	app.Run(os.Args)
 }
+
+//loadBootstrapJWTKey loads the initial JWT signing key from the jwtkey globalconfig or the devcert
+func loadBootstrapJWTKey() (ecdsaKey *ecdsa.PrivateKey, err error) {
+	config := globalconfig.NewManager()
+
+	var jwtKey []byte
+	exists, err := config.Exists("jwtkey")
+	if err == nil && exists {
+		var jwtKeyConfig *globalconfig.GlobalConfig
+		jwtKeyConfig, err = config.GetByKey("jwtkey")
+		jwtKey = []byte(jwtKeyConfig.Value)
+	} else {
+		if err == nil {
+			if _, err := os.Stat("devcert/jwt_key.pem"); err == nil {
+				log.Warning("===============================================================================")
+				log.Warning("This instance uses a development JWT signing key, don't do this in production !")
+				log.Warning("===============================================================================")
+
+				jwtKey, err = ioutil.ReadFile("devcert/jwt_key.pem")
+			}
+		}
+	}
+	if err != nil {
+		return
+	}
+	ecdsaKey, err = jwt.ParseECPrivateKeyFromPEM(jwtKey)
+	return
+}
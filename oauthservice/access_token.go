@@ -23,6 +23,9 @@ type AccessToken struct {
 	GlobalID    string //The organization that granted the token (in case of a client credentials flow)
 	Scope       string
 	ClientID    string //The client_id of the organization that was granted the token
+	ClientLabel string //The label of the api key the token was issued to, all api keys of an organization share the client_id
+	FamilyID    string //The refresh token family this token was issued with, empty if no refresh token was issued
+	SessionID   string //The interactive session of the itsyou.online website this token was issued to, empty if it is not bound to a session
 	CreatedAt   time.Time
 }
 
@@ -62,48 +65,77 @@ func (service *Service) AccessTokenHandler(w http.ResponseWriter, r *http.Reques
 	err := r.ParseForm()
 	if err != nil {
 		log.Debug("ERROR parsing form: ", err)
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		writeOAuthError(w, errInvalidRequest("Unable to parse the request"))
 		return
 	}
 
 	code := r.FormValue("code")
 	grantType := r.FormValue("grant_type")
-	clientSecret := r.FormValue("client_secret")
-	clientID := r.FormValue("client_id")
+	clientID, clientSecret := clientCredentialsFromRequest(r)
+	if clientID == "" {
+		//The client_id parameter is optional when the client authenticates with a client assertion
+		clientID = clientIDFromAssertion(r.FormValue("client_assertion"))
+	}
+
+	//Public clients do not have a secret, they can only use the authorization code flow with PKCE, the device flow and refresh the tokens they get
+	secretRequired := grantType != "" && grantType != AuthorizationCodeGrantType && grantType != RefreshTokenGrantType && grantType != DeviceCodeGrantType
 
-	if clientSecret == "" || clientID == "" || (grantType == "" && code == "") {
+	if clientID == "" || (grantType == "" && code == "") {
 		log.Debug("Required parameter missing in the request")
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		writeOAuthError(w, errInvalidRequest("Missing client_id, grant_type or code"))
+		return
+	}
+	if secretRequired && !hasClientAuthentication(r, clientSecret) {
+		writeOAuthError(w, errInvalidClient("Client authentication is required for this grant_type"))
 		return
 	}
 
 	var at *AccessToken
-	httpStatusCode := http.StatusOK
+	var rt *RefreshToken
+	var idToken string
+	var exchanged *tokenExchangeResponse
+	var oauthErr *OAuthError
 
-	if grantType != "" {
-		if grantType == ClientCredentialsGrantCodeType {
-			at, httpStatusCode = clientCredentialsTokenHandler(clientID, clientSecret, r)
-		} else {
-			httpStatusCode = http.StatusBadRequest
-		}
-	} else {
+	switch grantType {
+	case "", AuthorizationCodeGrantType:
 		redirectURI := r.FormValue("redirect_uri")
-		at, httpStatusCode = convertCodeToAccessTokenHandler(code, clientID, clientSecret, redirectURI, r)
+		at, rt, idToken, oauthErr = service.convertCodeToAccessTokenHandler(code, clientID, clientSecret, redirectURI, r)
+	case ClientCredentialsGrantCodeType:
+		at, oauthErr = clientCredentialsTokenHandler(clientID, clientSecret, r)
+	case RefreshTokenGrantType:
+		at, rt, oauthErr = refreshTokenHandler(r.FormValue("refresh_token"), clientID, clientSecret, r)
+	case DeviceCodeGrantType:
+		at, rt, oauthErr = deviceCodeTokenHandler(r.FormValue("device_code"), clientID, clientSecret, r)
+	case TokenExchangeGrantType:
+		exchanged, oauthErr = service.tokenExchangeHandler(clientID, clientSecret, r)
+	default:
+		oauthErr = errUnsupportedGrantType("")
 	}
 
-	if httpStatusCode != http.StatusOK {
-		http.Error(w, http.StatusText(httpStatusCode), httpStatusCode)
+	if oauthErr != nil {
+		writeOAuthError(w, oauthErr)
+		return
+	}
+	if exchanged != nil {
+		w.Header().Set("Content-type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		json.NewEncoder(w).Encode(exchanged)
 		return
 	}
 
 	response := struct {
-		AccessToken string      `json:"access_token"`
-		TokenType   string      `json:"token_type"`
-		Scope       string      `json:"scope"`
-		Info        interface{} `json:"info"`
+		AccessToken  string      `json:"access_token"`
+		TokenType    string      `json:"token_type"`
+		ExpiresIn    int64       `json:"expires_in"`
+		RefreshToken string      `json:"refresh_token,omitempty"`
+		IDToken      string      `json:"id_token,omitempty"`
+		Scope        string      `json:"scope"`
+		Info         interface{} `json:"info"`
 	}{
 		AccessToken: at.AccessToken,
 		TokenType:   at.Type,
+		ExpiresIn:   int64(AccessTokenExpiration.Seconds()),
+		IDToken:     idToken,
 		Scope:       at.Scope,
 		Info: struct {
 			Username string `json:"username"`
@@ -111,30 +143,38 @@ func (service *Service) AccessTokenHandler(w http.ResponseWriter, r *http.Reques
 			Username: at.Username,
 		},
 	}
+	if rt != nil {
+		response.RefreshToken = rt.RefreshToken
+	}
 
-	json.NewEncoder(w).Encode(&response)
 	w.Header().Set("Content-type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(&response)
 }
 
-func clientCredentialsTokenHandler(clientID string, secret string, r *http.Request) (at *AccessToken, httpStatusCode int) {
-	httpStatusCode = http.StatusOK
+func clientCredentialsTokenHandler(clientID string, secret string, r *http.Request) (at *AccessToken, oauthErr *OAuthError) {
 	var scopes string
 	username := ""
+	label := ""
 
 	mgr := NewManager(r)
-	client, err := mgr.getClientByCredentials(clientID, secret)
+	client, err := authenticateConfidentialClient(mgr, r, clientID, secret)
 	if err != nil {
 		log.Error("Error getting the oauth client: ", err)
-		httpStatusCode = http.StatusInternalServerError
+		oauthErr = errServerError()
 		return
 	}
 	if client == nil || !client.ClientCredentialsGrantType {
+		if secret == "" {
+			oauthErr = errInvalidClient("")
+			return
+		}
 		log.Info("Checking user api")
 		apikeyMgr := apikey.NewManager(r)
 		apikey, err := apikeyMgr.GetByApplicationAndSecret(clientID, secret)
 		if err != nil || apikey.ApiKey != secret {
 			log.Error("Error getting the user api key: ", err)
-			httpStatusCode = http.StatusBadRequest
+			oauthErr = errInvalidClient("")
 			return
 		}
 		log.Info("apikey", apikey)
@@ -143,26 +183,44 @@ func clientCredentialsTokenHandler(clientID string, secret string, r *http.Reque
 		username = apikey.Username
 	} else {
 		scopes = "organization:owner"
+		label = client.Label
 	}
 
 	at = newAccessToken(username, clientID, clientID, scopes)
+	at.ClientLabel = label
 	mgr.saveAccessToken(at)
 	return
 }
 
-func convertCodeToAccessTokenHandler(code string, clientID string, secret string, redirectURI string, r *http.Request) (at *AccessToken, httpStatusCode int) {
-	httpStatusCode = http.StatusOK
-
+func (service *Service) convertCodeToAccessTokenHandler(code string, clientID string, secret string, redirectURI string, r *http.Request) (at *AccessToken, rt *RefreshToken, idToken string, oauthErr *OAuthError) {
 	mgr := NewManager(r)
-	ar, err := mgr.Get(code)
+	//The authorization request is only marked as redeemed after the client is authenticated and the request is verified,
+	// otherwise anyone that got hold of a code could burn it or revoke the tokens issued for it
+	ar, err := mgr.getAuthorizationRequest(clientID, code)
 	if err != nil {
 		log.Error("ERROR getting the original authorization request:", err)
-		httpStatusCode = http.StatusInternalServerError
+		oauthErr = errServerError()
 		return
 	}
 	if ar == nil {
-		log.Debug("No original authorization request found with this authorization code")
-		httpStatusCode = http.StatusBadRequest
+		log.Debug("No original authorization request found with this authorization code for this client")
+		oauthErr = errInvalidGrant("Invalid authorization code")
+		return
+	}
+
+	var client *Oauth2Client
+	if hasClientAuthentication(r, secret) {
+		client, err = authenticateConfidentialClient(mgr, r, clientID, secret)
+	} else if ar.CodeChallenge != "" {
+		client, err = getPublicClient(mgr, clientID, redirectURI)
+	}
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if client == nil {
+		oauthErr = errInvalidClient("")
 		return
 	}
 
@@ -170,45 +228,89 @@ func convertCodeToAccessTokenHandler(code string, clientID string, secret string
 
 	if ar.ClientID != clientID || ar.State != state || ar.RedirectURL != redirectURI {
 		log.Info("Bad client or hacking attempt, state, client_id or redirect_uri is different from the original authorization request")
-		httpStatusCode = http.StatusBadRequest
+		oauthErr = errInvalidGrant("The state, client_id or redirect_uri does not match the authorization request")
 		return
 	}
 
 	if ar.IsExpiredAt(time.Now()) {
 		log.Info("Token request for an expired authorizationrequest")
-		httpStatusCode = http.StatusBadRequest
+		oauthErr = errInvalidGrant("Expired authorization code")
 		return
 	}
 
-	client, err := mgr.getClientByCredentials(clientID, secret)
-	if err != nil {
-		log.Error("Error getting the oauth client: ", err)
-		httpStatusCode = http.StatusInternalServerError
+	if ar.CodeChallenge != "" && !verifyCodeVerifier(ar.CodeChallenge, ar.CodeChallengeMethod, r.FormValue("code_verifier")) {
+		log.Info("The code_verifier does not match the code_challenge of the original authorization request")
+		oauthErr = errInvalidGrant("Invalid code_verifier")
 		return
 	}
-	if client == nil {
-		httpStatusCode = http.StatusBadRequest
+
+	if !client.MatchesRedirectURI(redirectURI) {
+		log.Debug("redirect_uri is not registered for the client")
+		oauthErr = errInvalidGrant("The redirect_uri is not registered for the client")
 		return
 	}
 
-	if !strings.HasPrefix(redirectURI, client.CallbackURL) {
-		log.Debug("return_uri does not match the callback uri")
-		httpStatusCode = http.StatusBadRequest
+	//All clients of an organization share the client_id, the restrictions of the authenticated client apply
+	if !client.AllowsGrantType(AuthorizationCodeGrantType) {
+		oauthErr = errUnauthorizedClient("The client is not registered for the authorization_code grant type")
+		return
+	}
+	if !scopesAllowedForClient(client.Scope, splitScopes(ar.Scope)) {
+		log.Debug("The authenticated client is not registered for the scopes of the authorization request")
+		oauthErr = errInvalidGrant("The client is not registered for the scopes of the authorization code")
 		return
 	}
 
+	ar, err = mgr.redeemAuthorizationCode(clientID, code)
+	if err != nil {
+		log.Error("Error redeeming the authorization code: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if ar == nil {
+		oauthErr = errInvalidGrant("Invalid authorization code")
+		return
+	}
+	if ar.Redeemed {
+		log.Warn("Replay of an authorization code detected, revoking the tokens issued to client ", clientID, " for user ", ar.Username)
+		if err = mgr.revokeTokenFamily(ar.FamilyID); err != nil {
+			log.Error("Error revoking the tokens issued for the authorization code: ", err)
+			oauthErr = errServerError()
+			return
+		}
+		oauthErr = errInvalidGrant("The authorization code was already used")
+		return
+	}
+
+	rt = newRefreshToken(ar.Username, "", ar.ClientID, ar.Scope, ar.FamilyID)
+	rt.ClientLabel = client.Label
+	if err = mgr.saveRefreshToken(rt); err != nil {
+		log.Error("Error saving the refresh token: ", err)
+		oauthErr = errServerError()
+		return
+	}
 	at = newAccessToken(ar.Username, "", ar.ClientID, ar.Scope)
+	at.ClientLabel = client.Label
+	at.FamilyID = rt.FamilyID
 	mgr.saveAccessToken(at)
+
+	if scopeStringContains(ar.Scope, OpenIDScope) {
+		idToken, err = service.createIDToken(at, ar.Nonce, ar.AuthTime, ar.SessionID)
+		if err != nil {
+			log.Error("Error creating the id token: ", err)
+			oauthErr = errServerError()
+		}
+	}
 	return
 }
 
-func (service *Service) createItsYouOnlineAdminToken(username string, r *http.Request) (token string, err error) {
-	at := newAccessToken(username, "", "itsyouonline", "admin")
+//createItsYouOnlineAdminToken creates the token the itsyou.online website uses to access the api on behalf of the user,
+// the token is bound to the interactive session of the user and is revoked when the session ends
+func (service *Service) createItsYouOnlineAdminToken(username, sessionID string, r *http.Request) (at *AccessToken, err error) {
+	at = newAccessToken(username, "", "itsyouonline", "admin")
+	at.SessionID = sessionID
 
 	mgr := NewManager(r)
 	err = mgr.saveAccessToken(at)
-	if err == nil {
-		token = at.AccessToken
-	}
 	return
 }
@@ -18,7 +18,18 @@ type authorizationRequest struct {
 	ClientID          string
 	State             string
 	Scope             string
-	CreatedAt         time.Time
+	//CodeChallenge and CodeChallengeMethod are the PKCE parameters (RFC 7636), the code_verifier presented
+	// when redeeming the authorization code must match the CodeChallenge
+	CodeChallenge       string
+	CodeChallengeMethod string
+	//Nonce, AuthTime and SessionID are put in the OpenID Connect id_token
+	Nonce     string
+	AuthTime  time.Time
+	SessionID string
+	//FamilyID is the token family of the tokens issued for this authorization code, they are revoked when the code is replayed
+	FamilyID  string
+	Redeemed  bool
+	CreatedAt time.Time
 }
 
 func (ar *authorizationRequest) IsExpiredAt(testtime time.Time) bool {
@@ -30,6 +41,7 @@ func newAuthorizationRequest(username, clientID, state, scope, redirectURI strin
 	randombytes := make([]byte, 21) //Multiple of 3 to make sure no padding is added
 	rand.Read(randombytes)
 	ar.AuthorizationCode = base64.URLEncoding.EncodeToString(randombytes)
+	ar.FamilyID = newRandomToken()
 	ar.CreatedAt = time.Now()
 	ar.Username = username
 	ar.ClientID = clientID
@@ -42,10 +54,6 @@ func newAuthorizationRequest(username, clientID, state, scope, redirectURI strin
 
 func validateRedirectURI(mgr ClientManager, redirectURI string, clientID string) (valid bool, err error) {
 	log.Debug("Validating redirect URI for ", clientID)
-	if clientID == "itsyouonline" {
-		valid = true
-		return
-	}
 	u, err := url.Parse(redirectURI)
 	if err != nil {
 		err = nil
@@ -55,6 +63,7 @@ func validateRedirectURI(mgr ClientManager, redirectURI string, clientID string)
 	valid = true
 	//A redirect to itsyou.online can not do harm but it is not normal either
 	valid = valid && (u.Scheme != "")
+	valid = valid && (u.Fragment == "")
 	lowercaseHost := strings.ToLower(u.Host)
 	valid = valid && (lowercaseHost != "")
 	valid = valid && (!strings.HasSuffix(lowercaseHost, "itsyou.online"))
@@ -64,9 +73,9 @@ func validateRedirectURI(mgr ClientManager, redirectURI string, clientID string)
 		return
 	}
 
-	//For now, just check if the redirectURI is registered in 'a' apikey
+	//Check if the redirectURI is registered in 'a' apikey
 	//The redirect_uri is saved in the authorization request and during
-	// the access_token request when the secret is available, check again against the known value
+	// the access_token request when the secret is available, check again against the registered redirect uris of that apikey
 	clients, err := mgr.AllByClientID(clientID)
 	if err != nil {
 		valid = false
@@ -75,8 +84,7 @@ func validateRedirectURI(mgr ClientManager, redirectURI string, clientID string)
 
 	match := false
 	for _, client := range clients {
-		log.Debug("Possible redirect_uri: ", client.Label, "\n ", client.CallbackURL)
-		match = match || strings.HasPrefix(redirectURI, client.CallbackURL)
+		match = match || client.MatchesRedirectURI(redirectURI)
 	}
 	valid = valid && match
 
@@ -96,13 +104,25 @@ func redirectToScopeRequestPage(w http.ResponseWriter, r *http.Request, possible
 	if possibleScopes != nil {
 		possibleScopesString = strings.Join(possibleScopes, ",")
 	}
-	queryvalues := r.URL.Query()
+	//The parameters of a consent submission are posted, not in the url
+	queryvalues := url.Values{}
+	for key, values := range r.Form {
+		queryvalues[key] = values
+	}
+	queryvalues.Del("csrf_token")
 	queryvalues.Set("scope", possibleScopesString)
 	queryvalues.Add("endpoint", r.URL.EscapedPath())
 	//TODO: redirect according the the received http method
 	http.Redirect(w, r, "/authorize?"+queryvalues.Encode(), http.StatusFound)
 }
 
+//isConsentSubmission checks if the user confirmed the requested scopes on the authorize page.
+// The page posts the csrf token of the session so other sites can not grant scopes on behalf of the user,
+// a token in the query string is ignored so it does not end up in logs and referrers.
+func (service *Service) isConsentSubmission(r *http.Request) bool {
+	return isRedirectFromPage(r, "/authorize") && service.sessionService.ValidCSRFToken(r, r.PostForm.Get("csrf_token"))
+}
+
 func (service *Service) filterAuthorizedScopes(r *http.Request, username string, clientID string, requestedScopes []string) (authorizedScopes []string, err error) {
 	log.Debug("Validating authorizations for requested scopes: ", requestedScopes)
 	authorizedScopes, err = service.identityService.FilterAuthorizedScopes(r, username, clientID, requestedScopes)
@@ -126,7 +146,37 @@ func (service *Service) AuthorizeHandler(w http.ResponseWriter, request *http.Re
 	err := request.ParseForm()
 	if err != nil {
 		log.Debug("ERROR parsing form", err)
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		showAuthorizeError(w, errInvalidRequest("Unable to parse the request"))
+		return
+	}
+
+	//Validate client and redirect_uri, as long as they are not validated, errors can not be redirected to the client
+	redirectURI, err := url.QueryUnescape(request.Form.Get("redirect_uri"))
+	if err != nil {
+		log.Debug("Unparsable redirect_uri")
+		showAuthorizeError(w, errInvalidRequest("Invalid redirect_uri"))
+		return
+	}
+	clientID := request.Form.Get("client_id")
+	if clientID == "" {
+		showAuthorizeError(w, errInvalidRequest("Missing client_id"))
+		return
+	}
+	//The itsyou.online website gets its token from the session token endpoint, never through an authorization flow
+	if clientID == "itsyouonline" {
+		log.Warn("HACK attempt, someone tried to get a token as the 'itsyouonline' client")
+		showAuthorizeError(w, errUnauthorizedClient(""))
+		return
+	}
+	mgr := NewManager(request)
+	valid, err := validateRedirectURI(mgr, redirectURI, clientID)
+	if err != nil {
+		log.Error(err)
+		showAuthorizeError(w, errServerError())
+		return
+	}
+	if !valid {
+		showAuthorizeError(w, errInvalidRequest("Invalid client_id or redirect_uri"))
 		return
 	}
 
@@ -134,14 +184,14 @@ func (service *Service) AuthorizeHandler(w http.ResponseWriter, request *http.Re
 	requestedResponseType := request.Form.Get("response_type")
 	if requestedResponseType != AuthorizationGrantCodeType && requestedResponseType != ImplicitGrantCodeType {
 		log.Debug("Invalid authorization grant type requested")
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errUnsupportedResponseType(""))
 		return
 	}
 
 	//Check if the user is already authenticated, if not, redirect to the login page before returning here
 	username, err := service.GetAuthenticatedUser(request)
 	if err != nil {
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
 		return
 	}
 	if username == "" {
@@ -149,43 +199,45 @@ func (service *Service) AuthorizeHandler(w http.ResponseWriter, request *http.Re
 		return
 	}
 
-	//Validate client and redirect_uri
-	redirectURI, err := url.QueryUnescape(request.Form.Get("redirect_uri"))
-	if err != nil {
-		log.Debug("Unparsable redirect_uri")
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-		return
+	if requestedResponseType == AuthorizationGrantCodeType && request.Form.Get("code_challenge") != "" {
+		codeChallengeMethod := request.Form.Get("code_challenge_method")
+		if codeChallengeMethod == "" {
+			codeChallengeMethod = CodeChallengeMethodPlain
+		}
+		if !validCodeChallenge(request.Form.Get("code_challenge"), codeChallengeMethod) {
+			log.Debug("Invalid code_challenge or unsupported code_challenge_method")
+			redirectAuthorizeError(w, request, clientID, redirectURI, errInvalidRequest("Invalid code_challenge or unsupported code_challenge_method"))
+			return
+		}
 	}
-	clientID := request.Form.Get("client_id")
-	mgr := NewManager(request)
-	valid, err := validateRedirectURI(mgr, redirectURI, clientID)
+
+	grantType := AuthorizationCodeGrantType
+	if requestedResponseType == ImplicitGrantCodeType {
+		grantType = ImplicitGrantType
+	}
+	requestedScopes := splitScopes(request.Form.Get("scope"))
+	allowed, err := scopesAllowedForRedirectURI(mgr, clientID, redirectURI, grantType, requestedScopes)
 	if err != nil {
 		log.Error(err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
 		return
 	}
-	if !valid {
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+	if !allowed {
+		redirectAuthorizeError(w, request, clientID, redirectURI, errInvalidScope("The client is not registered for the response_type or the requested scopes"))
 		return
 	}
-
-	requestedScopes := strings.Split(request.Form.Get("scope"), ",")
 	possibleScopes, err := service.filterPossibleScopes(request, username, clientID, requestedScopes)
 	if err != nil {
 		log.Error(err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
 		return
 	}
 
-	var authorizedScopes []string
-
-	if clientID != "itsyouonline" {
-		authorizedScopes, err = service.filterAuthorizedScopes(request, username, clientID, possibleScopes)
-		if err != nil {
-			log.Error(err)
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
+	authorizedScopes, err := service.filterAuthorizedScopes(request, username, clientID, possibleScopes)
+	if err != nil {
+		log.Error(err)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
+		return
 	}
 
 	var authorizedScopeString string
@@ -194,62 +246,79 @@ func (service *Service) AuthorizeHandler(w http.ResponseWriter, request *http.Re
 	if authorizedScopes != nil {
 		authorizedScopeString = strings.Join(authorizedScopes, ",")
 		validAuthorization = len(possibleScopes) == len(authorizedScopes)
-		//Check if we are redirected from the authorize page, it might be that not all authorizations were given,
+		//Check if the user submitted the authorize page, it might be that not all authorizations were given,
 		// authorize the login but only with the authorized scopes
-		referrer := request.Header.Get("Referer")
-		if referrer != "" && !validAuthorization { //If we already have a valid authorization, no need to check if we come from the authorize page
-			if referrerURL, err := url.Parse(referrer); err == nil {
-				validAuthorization = referrerURL.Host == request.Host && referrerURL.Path == "/authorize"
-			} else {
-				log.Debug("Error parsing referrer: ", err)
-			}
-		}
+		//If we already have a valid authorization, no need to check if we come from the authorize page
+		validAuthorization = validAuthorization || service.isConsentSubmission(request)
 	}
 
 	//If no valid authorization, ask the user for authorizations
-	// No need when logging in to itsyou.online itself.
-	if !validAuthorization && clientID != "itsyouonline" {
-		token, err := service.createItsYouOnlineAdminToken(username, request)
-		if err != nil {
-			log.Error(err)
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
-		service.sessionService.SetAPIAccessToken(w, token)
+	if !validAuthorization {
 		redirectToScopeRequestPage(w, request, possibleScopes)
 		return
 	}
 
+	sessionID, err := service.sessionService.GetSessionID(request)
+	if err != nil {
+		log.Error(err)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
+		return
+	}
+
+	var grantRedirectURI string
 	switch requestedResponseType {
 	case AuthorizationGrantCodeType:
-		if clientID == "itsyouonline" {
-			log.Warn("HACK attempt, someone tried to get a token as the 'itsyouonline' client")
-			//TODO: log the entire request and everything we know
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
+		var authTime time.Time
+		authTime, err = service.sessionService.GetAuthenticationTime(request)
+		if err != nil {
+			break
 		}
-		redirectURI, err = handleAuthorizationGrantCodeType(request, username, clientID, redirectURI, authorizedScopeString)
+		grantRedirectURI, err = handleAuthorizationGrantCodeType(request, username, clientID, redirectURI, authorizedScopeString, authTime, sessionID)
 	case ImplicitGrantCodeType:
-		redirectURI, err = handleImplicitGrantCodeType(request, username, clientID, redirectURI)
+		grantRedirectURI, err = handleImplicitGrantCodeType(request, username, clientID, redirectURI)
+	}
+	if err == nil {
+		err = trackSessionClient(request, sessionID, username, clientID)
 	}
 
 	if err != nil {
 		log.Error(err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		redirectAuthorizeError(w, request, clientID, redirectURI, errServerError())
 		return
 	}
 
-	http.Redirect(w, request, redirectURI, http.StatusFound)
+	http.Redirect(w, request, grantRedirectURI, http.StatusFound)
 
 }
 
-func handleAuthorizationGrantCodeType(r *http.Request, username, clientID, redirectURI, scopes string) (correctedRedirectURI string, err error) {
+//showAuthorizeError shows an error to the user when the client or redirect_uri is invalid, the user is not redirected to the client in this case
+func showAuthorizeError(w http.ResponseWriter, e *OAuthError) {
+	http.Error(w, e.Error(), e.StatusCode)
+}
+
+//redirectAuthorizeError redirects the user back to the validated redirect_uri with the error
+func redirectAuthorizeError(w http.ResponseWriter, r *http.Request, clientID, redirectURI string, e *OAuthError) {
+	fragment := r.Form.Get("response_type") == ImplicitGrantCodeType
+	http.Redirect(w, r, authorizeErrorRedirectURI(redirectURI, r.Form.Get("state"), e, fragment), http.StatusFound)
+}
+
+func handleAuthorizationGrantCodeType(r *http.Request, username, clientID, redirectURI, scopes string, authTime time.Time, sessionID string) (correctedRedirectURI string, err error) {
 	correctedRedirectURI = redirectURI
 	log.Debug("Handling authorization grant code type for user ", username, ", ", clientID, " is asking for ", scopes)
 	clientState := r.Form.Get("state")
 	//TODO: validate state (length and stuff)
 
 	ar := newAuthorizationRequest(username, clientID, clientState, scopes, redirectURI)
+	ar.CodeChallenge = r.Form.Get("code_challenge")
+	if ar.CodeChallenge != "" {
+		ar.CodeChallengeMethod = r.Form.Get("code_challenge_method")
+		if ar.CodeChallengeMethod == "" {
+			ar.CodeChallengeMethod = CodeChallengeMethodPlain
+		}
+	}
+	ar.Nonce = r.Form.Get("nonce")
+	ar.AuthTime = authTime
+	ar.SessionID = sessionID
 	mgr := NewManager(r)
 	err = mgr.saveAuthorizationRequest(ar)
 	if err != nil {
@@ -275,11 +344,6 @@ func handleAuthorizationGrantCodeType(r *http.Request, username, clientID, redir
 func handleImplicitGrantCodeType(r *http.Request, username, clientID, redirectURI string) (correctedRedirectURI string, err error) {
 
 	scopes := ""
-	if clientID == "itsyouonline" {
-		scopes = "admin"
-		//hardcoded override the redirect_uri to prevent spoofing
-		redirectURI = "/"
-	}
 	//TODO: scope mapping for other clients
 
 	mgr := NewManager(r)
@@ -25,6 +25,9 @@ func TestNewAuthorizationRequest(t *testing.T) {
 	assert.Equal(t, "client1", ar.ClientID)
 	assert.Equal(t, "scope3", ar.Scope)
 	assert.Equal(t, "https://localhost", ar.RedirectURL)
+	assert.NotEmpty(t, ar.FamilyID)
+	assert.NotEqual(t, ar.AuthorizationCode, ar.FamilyID)
+	assert.False(t, ar.Redeemed)
 }
 
 type testClientManager struct {
@@ -43,7 +46,10 @@ func TestValidateRedirectURI(t *testing.T) {
 		valid       bool
 	}
 	mgr := &testClientManager{
-		clients: []*Oauth2Client{&Oauth2Client{CallbackURL: "http://www.url.com/callback"}},
+		clients: []*Oauth2Client{
+			&Oauth2Client{CallbackURL: "http://www.url.com/callback"},
+			&Oauth2Client{RedirectURIs: []RedirectURI{RedirectURI{URI: "http://127.0.0.1/native", AnyPort: true}}},
+		},
 	}
 	testcases := []testcase{
 		testcase{redirectURI: "", valid: false},
@@ -51,7 +57,12 @@ func TestValidateRedirectURI(t *testing.T) {
 		testcase{redirectURI: "https://itsyou.online", valid: false},
 		testcase{redirectURI: "https://test.itsyou.online", valid: false},
 		testcase{redirectURI: "https://test.itsyou.online:443", valid: false},
-		testcase{redirectURI: "http://www.url.com/callback/subpath", valid: true},
+		testcase{redirectURI: "http://www.url.com/callback", valid: true},
+		testcase{redirectURI: "http://www.url.com/callback/subpath", valid: false},
+		testcase{redirectURI: "http://www.url.com/callback#fragment", valid: false},
+		testcase{redirectURI: "http://www.url.com/callback.evil.io", valid: false},
+		testcase{redirectURI: "http://127.0.0.1:51234/native", valid: true},
+		testcase{redirectURI: "http://127.0.0.1:51234/other", valid: false},
 	}
 	for i, test := range testcases {
 		valid, err := validateRedirectURI(mgr, test.redirectURI, "clientID")
@@ -3,17 +3,36 @@ package oauthservice
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 //Oauth2Client is an oauth2 client
 type Oauth2Client struct {
-	ClientID                   string
-	Label                      string //Label is a just a tag to identity the secret for this ClientID
-	Secret                     string
-	CallbackURL                string
-	ClientCredentialsGrantType bool //ClientCredentialsGrantType indicates if this client can be used in an oauth2 client credentials grant flow
+	ClientID                    string
+	Label                       string //Label is a just a tag to identity the secret for this ClientID
+	Secret                      string
+	CallbackURL                 string        //CallbackURL is the main redirect uri of the client
+	RedirectURIs                []RedirectURI //RedirectURIs are additional redirect uris the client can use
+	ClientCredentialsGrantType  bool          //ClientCredentialsGrantType indicates if this client can be used in an oauth2 client credentials grant flow
+	Public                      bool          //Public indicates the client can not keep its secret confidential (mobile and single page apps), it redeems authorization codes using PKCE instead of the secret
+	Scope                       string        //Scope limits the scopes the client can request, empty means no limit
+	GrantTypes                  []string      //GrantTypes limits the grant types the client can use, empty means no limit
+	RegistrationAccessTokenHash string        //RegistrationAccessTokenHash is set for dynamically registered clients, the registration access token allows them to manage their registration
+	TokenEndpointAuthMethod     string        //TokenEndpointAuthMethod is private_key_jwt or tls_client_auth if the client does not authenticate with the secret
+	PublicKey                   string        //PublicKey is the PEM encoded key or JWK the client signs its client assertions with (private_key_jwt)
+	TLSClientAuthSubjectDN      string        //TLSClientAuthSubjectDN is the subject of the tls client certificate of the client (tls_client_auth)
+	BackChannelLogoutURI        string        //BackChannelLogoutURI is where a logout token is posted to when a user that logged in to the client logs out of itsyou.online
+	FrontChannelLogoutURI       string        //FrontChannelLogoutURI is loaded in an iframe in the browser of the user when a user that logged in to the client logs out of itsyou.online
+	PostLogoutRedirectURIs      []string      //PostLogoutRedirectURIs are the uris the client can redirect the user to after logging out at the end session endpoint
+	LegacyCallbackURLPrefix     bool          //LegacyCallbackURLPrefix is set for clients registered before redirect uris were matched exactly, their callback url is matched as a prefix if LegacyCallbackURLPrefixMatching is enabled
 }
 
+//LegacyCallbackURLPrefixMatching keeps matching the callback url of clients that were registered before redirect uris were matched exactly as a prefix.
+// It is off by default, it only gives existing integrations time to register their exact redirect uris and will be removed in the next release.
+var LegacyCallbackURLPrefixMatching = false
+
 //NewOauth2Client creates a new NewOauth2Client with a random secret
 func NewOauth2Client(clientID, label, callbackURL string, clientCredentialsGrantType bool) *Oauth2Client {
 	c := &Oauth2Client{
@@ -28,3 +47,49 @@ func NewOauth2Client(clientID, label, callbackURL string, clientCredentialsGrant
 	c.Secret = base64.URLEncoding.EncodeToString(randombytes)
 	return c
 }
+
+//MatchesRedirectURI checks if a redirect uri is registered for this client, either as the callback url or as one of the redirect uris
+func (c *Oauth2Client) MatchesRedirectURI(redirectURI string) bool {
+	if (RedirectURI{URI: c.CallbackURL}).Matches(redirectURI) {
+		return true
+	}
+	for _, registered := range c.RedirectURIs {
+		if registered.Matches(redirectURI) {
+			return true
+		}
+	}
+	if LegacyCallbackURLPrefixMatching && c.LegacyCallbackURLPrefix && c.CallbackURL != "" && strings.HasPrefix(redirectURI, c.CallbackURL) {
+		log.Warnf("Redirect uri %s only matches the callback url of client %s (%s) as a prefix, this client should register its exact redirect uris", redirectURI, c.ClientID, c.Label)
+		return true
+	}
+	return false
+}
+
+//AllowsGrantType checks if the client is allowed to use a grant type
+func (c *Oauth2Client) AllowsGrantType(grantType string) bool {
+	if len(c.GrantTypes) == 0 {
+		return true
+	}
+	for _, allowed := range c.GrantTypes {
+		if allowed == grantType {
+			return true
+		}
+	}
+	return false
+}
+
+//IsIssuedClient checks if the client is the one a grant was issued to, all labels of an organization share the same client_id.
+// Grants that were issued before the label was recorded have an empty issuedLabel and are accepted for any label.
+func (c *Oauth2Client) IsIssuedClient(issuedLabel string) bool {
+	return issuedLabel == "" || c.Label == issuedLabel
+}
+
+//MatchesPostLogoutRedirectURI checks if a post logout redirect uri is registered for this client
+func (c *Oauth2Client) MatchesPostLogoutRedirectURI(postLogoutRedirectURI string) bool {
+	for _, registered := range c.PostLogoutRedirectURIs {
+		if postLogoutRedirectURI != "" && postLogoutRedirectURI == registered {
+			return true
+		}
+	}
+	return false
+}
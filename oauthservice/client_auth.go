@@ -0,0 +1,409 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+//Client authentication methods that do not use the client secret
+const (
+	TokenEndpointAuthMethodPrivateKeyJWT = "private_key_jwt"
+	TokenEndpointAuthMethodTLSClientAuth = "tls_client_auth"
+)
+
+//ClientAssertionTypeJWTBearer is the client_assertion_type of a client authenticating with a signed JWT (RFC 7523 section 2.2)
+const ClientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+//ClientAssertionMaxLifetime is the longest a client assertion can be valid, the jti of an assertion is remembered until it expires
+var ClientAssertionMaxLifetime = time.Hour
+
+//ErrInvalidPublicKey is returned when the public key of a client can not be used to verify client assertions
+var ErrInvalidPublicKey = errors.New("Invalid public key")
+
+//ErrInvalidClientAuthentication is returned when the client authentication method of a client is not configured correctly
+var ErrInvalidClientAuthentication = errors.New("Invalid client authentication")
+
+//errInvalidClientAssertion is returned when the claims of a client assertion are not valid
+var errInvalidClientAssertion = errors.New("Invalid client assertion")
+
+//oidEd25519 is the algorithm identifier of Ed25519 public keys (RFC 8410)
+var oidEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+//clientAssertion is a used client assertion, it is stored until it expires to prevent replays
+type clientAssertion struct {
+	ClientID  string
+	JTI       string
+	ExpiresAt time.Time
+}
+
+//ValidateClientAuthentication checks if the client authentication method can be configured with the given public key or certificate subject
+func ValidateClientAuthentication(method, publicKey, tlsClientAuthSubjectDN string) error {
+	switch method {
+	case "":
+		return nil
+	case TokenEndpointAuthMethodPrivateKeyJWT:
+		_, err := ParseClientPublicKey(publicKey)
+		return err
+	case TokenEndpointAuthMethodTLSClientAuth:
+		if strings.TrimSpace(tlsClientAuthSubjectDN) == "" {
+			return ErrInvalidClientAuthentication
+		}
+		return nil
+	default:
+		return ErrInvalidClientAuthentication
+	}
+}
+
+//ParseClientPublicKey parses the public key a client registered to sign its client assertions.
+// The key is a PEM encoded public key or a JWK, RSA, ECDSA and Ed25519 keys are supported.
+func ParseClientPublicKey(key string) (publicKey interface{}, err error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{") {
+		jwk := &JSONWebKey{}
+		if err = json.Unmarshal([]byte(key), jwk); err != nil {
+			err = ErrInvalidPublicKey
+			return
+		}
+		publicKey, err = jwk.PublicKey()
+	} else {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil || block.Type != "PUBLIC KEY" {
+			err = ErrInvalidPublicKey
+			return
+		}
+		publicKey, err = parsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		err = ErrInvalidPublicKey
+		return
+	}
+	switch k := publicKey.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < 2048 {
+			err = ErrInvalidPublicKey
+		}
+	case *ecdsa.PublicKey:
+		if _, supported := ecdsaSigningMethods[k.Curve.Params().BitSize]; !supported {
+			err = ErrInvalidPublicKey
+		}
+	case ed25519.PublicKey:
+	default:
+		err = ErrInvalidPublicKey
+	}
+	if err != nil {
+		publicKey = nil
+	}
+	return
+}
+
+//parsePKIXPublicKey parses a DER encoded public key, Ed25519 keys are parsed here since crypto/x509 does not know them
+func parsePKIXPublicKey(der []byte) (publicKey interface{}, err error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if rest, err := asn1.Unmarshal(der, &spki); err == nil && len(rest) == 0 && spki.Algorithm.Algorithm.Equal(oidEd25519) {
+		if len(spki.PublicKey.Bytes) != ed25519.PublicKeySize {
+			return nil, ErrInvalidPublicKey
+		}
+		return ed25519.PublicKey(spki.PublicKey.Bytes), nil
+	}
+	return x509.ParsePKIXPublicKey(der)
+}
+
+//ecdsaSigningMethods maps the size of the supported curves to the signing method that uses them
+var ecdsaSigningMethods = map[int]*jwt.SigningMethodECDSA{
+	256: jwt.SigningMethodES256,
+	384: jwt.SigningMethodES384,
+	521: jwt.SigningMethodES512,
+}
+
+//PublicKey returns the public key a JWK describes
+func (jwk *JSONWebKey) PublicKey() (publicKey interface{}, err error) {
+	switch jwk.Kty {
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrUnsupportedCurve
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(jwk.X)
+		y, errY := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if errX != nil || errY != nil {
+			return nil, ErrInvalidPublicKey
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, ErrInvalidPublicKey
+		}
+		return key, nil
+	case "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrInvalidPublicKey
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if jwk.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrInvalidPublicKey
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrInvalidPublicKey
+	}
+}
+
+//clientAssertionKeyfunc returns a jwt.Keyfunc that only accepts the signing methods that belong to the type of the public key
+func clientAssertionKeyfunc(publicKey interface{}) jwt.Keyfunc {
+	return func(token *jwt.Token) (key interface{}, err error) {
+		switch k := publicKey.(type) {
+		case *rsa.PublicKey:
+			switch token.Method.(type) {
+			case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+				return k, nil
+			}
+		case *ecdsa.PublicKey:
+			if token.Method == ecdsaSigningMethods[k.Curve.Params().BitSize] {
+				return k, nil
+			}
+		case ed25519.PublicKey:
+			if token.Method == signingMethodEdDSA {
+				return k, nil
+			}
+		}
+		return nil, jwt.ErrInvalidKey
+	}
+}
+
+//validateClientAssertionClaims validates the claims of a client assertion as described in RFC 7523 section 3.
+// The jti and the expiration time are returned so the assertion can be remembered to prevent replays.
+func validateClientAssertionClaims(claims map[string]interface{}, clientID string, audiences []string, now time.Time) (jti string, expiresAt time.Time, err error) {
+	iss, _ := claims["iss"].(string)
+	sub, _ := claims["sub"].(string)
+	if iss != clientID || sub != clientID {
+		err = errInvalidClientAssertion
+		return
+	}
+
+	audienceValid := false
+	var aud []interface{}
+	switch a := claims["aud"].(type) {
+	case string:
+		aud = []interface{}{a}
+	case []interface{}:
+		aud = a
+	}
+	for _, value := range aud {
+		for _, audience := range audiences {
+			if value == audience {
+				audienceValid = true
+			}
+		}
+	}
+	if !audienceValid {
+		err = errInvalidClientAssertion
+		return
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		err = errInvalidClientAssertion
+		return
+	}
+	expiresAt = time.Unix(int64(exp), 0)
+	if !expiresAt.After(now) || expiresAt.After(now.Add(ClientAssertionMaxLifetime)) {
+		err = errInvalidClientAssertion
+		return
+	}
+
+	jti, _ = claims["jti"].(string)
+	if jti == "" {
+		err = errInvalidClientAssertion
+	}
+	return
+}
+
+//clientAssertionAudiences are the audiences a client assertion sent to an endpoint can have: the issuer or the url of the endpoint
+func clientAssertionAudiences(r *http.Request) []string {
+	iss := issuer()
+	audiences := []string{iss, iss + r.URL.Path}
+	if r.URL.Path != "/v1/oauth/access_token" {
+		audiences = append(audiences, iss+"/v1/oauth/access_token")
+	}
+	return audiences
+}
+
+//clientIDFromAssertion returns the issuer of a client assertion without verifying it,
+// it is used to identify the client when the client_id parameter is omitted
+func clientIDFromAssertion(assertion string) string {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	claimBytes, err := jwt.DecodeSegment(parts[1])
+	if err != nil {
+		return ""
+	}
+	claims := struct {
+		Iss string `json:"iss"`
+	}{}
+	json.Unmarshal(claimBytes, &claims)
+	return claims.Iss
+}
+
+//hasClientAuthentication checks if a request contains credentials for any of the supported client authentication methods
+func hasClientAuthentication(r *http.Request, secret string) bool {
+	return secret != "" || r.FormValue("client_assertion_type") != "" || (r.TLS != nil && len(r.TLS.PeerCertificates) > 0)
+}
+
+//authenticateConfidentialClient authenticates a client using the client assertion, the secret or the tls client certificate in the request.
+// It returns nil if the credentials are invalid or if the client is not registered to use this authentication method.
+func authenticateConfidentialClient(mgr *Manager, r *http.Request, clientID, secret string) (client *Oauth2Client, err error) {
+	if r.FormValue("client_assertion_type") != "" {
+		return authenticateClientWithAssertion(mgr, r, clientID)
+	}
+	if secret != "" {
+		client, err = mgr.getClientByCredentials(clientID, secret)
+		if client != nil && client.TokenEndpointAuthMethod != "" {
+			log.Debug("The secret is used by a client that registered ", client.TokenEndpointAuthMethod)
+			client = nil
+		}
+		return
+	}
+	return authenticateClientWithCertificate(mgr, r, clientID)
+}
+
+//authenticateClientWithAssertion returns the client that signed the client assertion in the request (private_key_jwt)
+func authenticateClientWithAssertion(mgr *Manager, r *http.Request, clientID string) (client *Oauth2Client, err error) {
+	assertion := r.FormValue("client_assertion")
+	if r.FormValue("client_assertion_type") != ClientAssertionTypeJWTBearer || assertion == "" {
+		return
+	}
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, candidate := range clients {
+		if candidate.TokenEndpointAuthMethod != TokenEndpointAuthMethodPrivateKeyJWT {
+			continue
+		}
+		publicKey, keyErr := ParseClientPublicKey(candidate.PublicKey)
+		if keyErr != nil {
+			log.Warn("Invalid public key registered for client ", clientID, " ", candidate.Label)
+			continue
+		}
+		token, parseErr := jwt.Parse(assertion, clientAssertionKeyfunc(publicKey))
+		if parseErr != nil || !token.Valid {
+			continue
+		}
+		jti, expiresAt, claimsErr := validateClientAssertionClaims(token.Claims, clientID, clientAssertionAudiences(r), time.Now())
+		if claimsErr != nil {
+			log.Debug("Invalid claims in the client assertion of client ", clientID)
+			return
+		}
+		err = mgr.saveClientAssertion(&clientAssertion{ClientID: clientID, JTI: jti, ExpiresAt: expiresAt})
+		if err == db.ErrDuplicate {
+			log.Warn("Replay of a client assertion detected for client ", clientID)
+			err = nil
+			return
+		}
+		if err != nil {
+			return
+		}
+		client = candidate
+		return
+	}
+	return
+}
+
+//authenticateClientWithCertificate returns the client that registered the subject of the verified tls client certificate (tls_client_auth)
+func authenticateClientWithCertificate(mgr *Manager, r *http.Request, clientID string) (client *Oauth2Client, err error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return
+	}
+	subjectDN := distinguishedName(r.TLS.VerifiedChains[0][0].Subject)
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, candidate := range clients {
+		if candidate.TokenEndpointAuthMethod == TokenEndpointAuthMethodTLSClientAuth && candidate.TLSClientAuthSubjectDN == subjectDN {
+			client = candidate
+			return
+		}
+	}
+	return
+}
+
+//attributeTypeNames are the short names of the attribute types in a distinguished name as defined in RFC 4514 section 3
+var attributeTypeNames = map[string]string{
+	"2.5.4.3":                    "CN",
+	"2.5.4.6":                    "C",
+	"2.5.4.7":                    "L",
+	"2.5.4.8":                    "ST",
+	"2.5.4.9":                    "STREET",
+	"2.5.4.10":                   "O",
+	"2.5.4.11":                   "OU",
+	"0.9.2342.19200300.100.1.1":  "UID",
+	"0.9.2342.19200300.100.1.25": "DC",
+}
+
+//distinguishedName formats the subject of a certificate as a string as described in RFC 4514, for example "CN=client,O=Example,C=BE"
+func distinguishedName(name pkix.Name) string {
+	rdns := make([]string, 0, len(name.Names))
+	//RFC 4514 starts with the last relative distinguished name of the sequence
+	for i := len(name.Names) - 1; i >= 0; i-- {
+		attribute := name.Names[i]
+		attributeType, known := attributeTypeNames[attribute.Type.String()]
+		if !known {
+			attributeType = attribute.Type.String()
+		}
+		value, isString := attribute.Value.(string)
+		if !isString {
+			der, _ := asn1.Marshal(attribute.Value)
+			rdns = append(rdns, attributeType+"=#"+hex.EncodeToString(der))
+			continue
+		}
+		rdns = append(rdns, attributeType+"="+escapeDNValue(value))
+	}
+	return strings.Join(rdns, ",")
+}
+
+//escapeDNValue escapes the special characters of an attribute value in a distinguished name
+func escapeDNValue(value string) string {
+	escaped := make([]rune, 0, len(value))
+	for i, c := range value {
+		if strings.ContainsRune(",+\"\\<>;=", c) || (i == 0 && (c == '#' || c == ' ')) || (i == len(value)-1 && c == ' ') {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, c)
+	}
+	return string(escaped)
+}
@@ -0,0 +1,181 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func pemPublicKey(t *testing.T, publicKey interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestParseClientPublicKey(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	publicKey, err := ParseClientPublicKey(pemPublicKey(t, &ecKey.PublicKey))
+	assert.NoError(t, err)
+	assert.Equal(t, &ecKey.PublicKey, publicKey)
+
+	jwk, _ := NewECJSONWebKey(&ecKey.PublicKey)
+	publicKey, err = ParseClientPublicKey(fmt.Sprintf(`{"kty":"EC","crv":"P-256","x":"%s","y":"%s"}`, jwk.X, jwk.Y))
+	assert.NoError(t, err)
+	assert.Equal(t, ecKey.PublicKey.X, publicKey.(*ecdsa.PublicKey).X)
+
+	edPublicKey, _, _ := ed25519.GenerateKey(rand.Reader)
+	der, _ := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidEd25519},
+		PublicKey: asn1.BitString{Bytes: edPublicKey, BitLength: 8 * len(edPublicKey)},
+	})
+	publicKey, err = ParseClientPublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	assert.NoError(t, err)
+	assert.Equal(t, edPublicKey, publicKey)
+
+	publicKey, err = ParseClientPublicKey(`{"kty":"OKP","crv":"Ed25519","x":"` + base64.RawURLEncoding.EncodeToString(edPublicKey) + `"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, edPublicKey, publicKey)
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	publicKey, err = ParseClientPublicKey(pemPublicKey(t, &rsaKey.PublicKey))
+	assert.NoError(t, err)
+	assert.Equal(t, &rsaKey.PublicKey, publicKey)
+
+	n := base64.RawURLEncoding.EncodeToString(rsaKey.PublicKey.N.Bytes())
+	publicKey, err = ParseClientPublicKey(`{"kty":"RSA","n":"` + n + `","e":"AQAB"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &rsaKey.PublicKey, publicKey)
+
+	weakKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	invalidKeys := []string{
+		"",
+		"not a key",
+		pemPublicKey(t, &weakKey.PublicKey),
+		`{"kty":"EC","crv":"P-256","x":"AAAA","y":"AAAA"}`,
+		`{"kty":"oct","k":"c2VjcmV0"}`,
+	}
+	for i, key := range invalidKeys {
+		_, err = ParseClientPublicKey(key)
+		assert.Error(t, err, i)
+	}
+}
+
+func TestClientAssertionKeyfunc(t *testing.T) {
+	claims := map[string]interface{}{"iss": "org1", "exp": time.Now().Add(time.Minute).Unix()}
+	sign := func(method jwt.SigningMethod, key interface{}) string {
+		token := jwt.New(method)
+		token.Claims = claims
+		tokenString, err := token.SignedString(key)
+		assert.NoError(t, err)
+		return tokenString
+	}
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPublicKey, edPrivateKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	token, err := jwt.Parse(sign(jwt.SigningMethodES256, ecKey), clientAssertionKeyfunc(&ecKey.PublicKey))
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+
+	token, err = jwt.Parse(sign(signingMethodEdDSA, edPrivateKey), clientAssertionKeyfunc(edPublicKey))
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+
+	//The signing method must belong to the type of the registered key
+	_, err = jwt.Parse(sign(jwt.SigningMethodES256, ecKey), clientAssertionKeyfunc(edPublicKey))
+	assert.Error(t, err)
+	_, err = jwt.Parse(sign(jwt.SigningMethodHS256, []byte("secret")), clientAssertionKeyfunc(&ecKey.PublicKey))
+	assert.Error(t, err)
+
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, err = jwt.Parse(sign(jwt.SigningMethodES256, otherKey), clientAssertionKeyfunc(&ecKey.PublicKey))
+	assert.Error(t, err)
+}
+
+func TestValidateClientAssertionClaims(t *testing.T) {
+	now := time.Now()
+	audiences := []string{"https://itsyou.online", "https://itsyou.online/v1/oauth/access_token"}
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": "org1",
+			"sub": "org1",
+			"aud": "https://itsyou.online/v1/oauth/access_token",
+			"exp": float64(now.Add(time.Minute).Unix()),
+			"jti": "abc",
+		}
+	}
+
+	jti, expiresAt, err := validateClientAssertionClaims(validClaims(), "org1", audiences, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", jti)
+	assert.Equal(t, now.Add(time.Minute).Unix(), expiresAt.Unix())
+
+	claims := validClaims()
+	claims["aud"] = []interface{}{"https://example.com", "https://itsyou.online"}
+	_, _, err = validateClientAssertionClaims(claims, "org1", audiences, now)
+	assert.NoError(t, err)
+
+	invalid := []func(map[string]interface{}){
+		func(c map[string]interface{}) { c["iss"] = "org2" },
+		func(c map[string]interface{}) { c["sub"] = "org2" },
+		func(c map[string]interface{}) { c["aud"] = "https://example.com" },
+		func(c map[string]interface{}) { delete(c, "aud") },
+		func(c map[string]interface{}) { delete(c, "exp") },
+		func(c map[string]interface{}) { c["exp"] = float64(now.Add(-time.Minute).Unix()) },
+		func(c map[string]interface{}) {
+			c["exp"] = float64(now.Add(ClientAssertionMaxLifetime + time.Minute).Unix())
+		},
+		func(c map[string]interface{}) { delete(c, "jti") },
+	}
+	for i, modify := range invalid {
+		claims = validClaims()
+		modify(claims)
+		_, _, err = validateClientAssertionClaims(claims, "org1", audiences, now)
+		assert.Error(t, err, i)
+	}
+}
+
+func TestClientIDFromAssertion(t *testing.T) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	token.Claims["iss"] = "org1"
+	assertion, _ := token.SignedString([]byte("secret"))
+	assert.Equal(t, "org1", clientIDFromAssertion(assertion))
+	assert.Equal(t, "", clientIDFromAssertion(""))
+	assert.Equal(t, "", clientIDFromAssertion("a.b"))
+}
+
+func TestValidateClientAuthentication(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, ValidateClientAuthentication("", "", ""))
+	assert.NoError(t, ValidateClientAuthentication(TokenEndpointAuthMethodPrivateKeyJWT, pemPublicKey(t, &ecKey.PublicKey), ""))
+	assert.NoError(t, ValidateClientAuthentication(TokenEndpointAuthMethodTLSClientAuth, "", "CN=client"))
+	assert.Error(t, ValidateClientAuthentication(TokenEndpointAuthMethodPrivateKeyJWT, "", ""))
+	assert.Error(t, ValidateClientAuthentication(TokenEndpointAuthMethodTLSClientAuth, "", " "))
+	assert.Error(t, ValidateClientAuthentication("client_secret_jwt", "", ""))
+}
+
+func TestDistinguishedName(t *testing.T) {
+	name := pkix.Name{}
+	name.FillFromRDNSequence(&pkix.RDNSequence{
+		[]pkix.AttributeTypeAndValue{{Type: asn1.ObjectIdentifier{2, 5, 4, 6}, Value: "BE"}},
+		[]pkix.AttributeTypeAndValue{{Type: asn1.ObjectIdentifier{2, 5, 4, 10}, Value: "Example, Inc."}},
+		[]pkix.AttributeTypeAndValue{{Type: asn1.ObjectIdentifier{2, 5, 4, 3}, Value: "client"}},
+	})
+	assert.Equal(t, `CN=client,O=Example\, Inc.,C=BE`, distinguishedName(name))
+}
@@ -17,3 +17,21 @@ func TestNewOauth2Client(t *testing.T) {
 	c2 := NewOauth2Client("clientid", "", "", true)
 	assert.NotEqual(t, c.Secret, c2.Secret)
 }
+
+func TestAllowsGrantType(t *testing.T) {
+	c := &Oauth2Client{}
+	assert.True(t, c.AllowsGrantType(AuthorizationCodeGrantType))
+	assert.True(t, c.AllowsGrantType(DeviceCodeGrantType))
+
+	c.GrantTypes = []string{AuthorizationCodeGrantType, RefreshTokenGrantType}
+	assert.True(t, c.AllowsGrantType(RefreshTokenGrantType))
+	assert.False(t, c.AllowsGrantType(DeviceCodeGrantType))
+	assert.False(t, c.AllowsGrantType(ImplicitGrantType))
+}
+
+func TestIsIssuedClient(t *testing.T) {
+	c := &Oauth2Client{ClientID: "org1", Label: "web"}
+	assert.True(t, c.IsIssuedClient("web"))
+	assert.True(t, c.IsIssuedClient(""))
+	assert.False(t, c.IsIssuedClient("spa"))
+}
@@ -4,16 +4,22 @@ import (
 	"net/http"
 	"time"
 
+	log "github.com/Sirupsen/logrus"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	"github.com/itsyouonline/identityserver/db"
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
 )
 
 const (
-	requestsCollectionName = "oauth_authorizationrequests"
-	tokensCollectionName   = "oauth_accesstokens"
-	clientsCollectionName  = "oauth_clients"
+	requestsCollectionName       = "oauth_authorizationrequests"
+	tokensCollectionName         = "oauth_accesstokens"
+	refreshTokensCollectionName  = "oauth_refreshtokens"
+	clientsCollectionName        = "oauth_clients"
+	deviceCollectionName         = "oauth_deviceauthorizations"
+	assertionsCollectionName     = "oauth_clientassertions"
+	sessionClientsCollectionName = "oauth_sessionclients"
 )
 
 //InitModels initialize models in mongo, if required.
@@ -50,12 +56,97 @@ func InitModels() {
 	}
 	db.EnsureIndex(tokensCollectionName, automaticExpiration)
 
+	index = mgo.Index{
+		Key: []string{"sessionid"},
+	}
+	db.EnsureIndex(tokensCollectionName, index)
+
+	index = mgo.Index{
+		Key:    []string{"refreshtoken"},
+		Unique: true,
+	}
+	db.EnsureIndex(refreshTokensCollectionName, index)
+
+	index = mgo.Index{
+		Key: []string{"familyid"},
+	}
+	db.EnsureIndex(refreshTokensCollectionName, index)
+
+	automaticExpiration = mgo.Index{
+		Key:         []string{"createdat"},
+		ExpireAfter: RefreshTokenExpiration,
+		Background:  true,
+	}
+	db.EnsureIndex(refreshTokensCollectionName, automaticExpiration)
+
 	index = mgo.Index{
 		Key:    []string{"clientid", "label"},
 		Unique: true,
 	}
 	db.EnsureIndex(clientsCollectionName, index)
 
+	index = mgo.Index{
+		Key:    []string{"devicecode"},
+		Unique: true,
+	}
+	db.EnsureIndex(deviceCollectionName, index)
+
+	index = mgo.Index{
+		Key:    []string{"usercode"},
+		Unique: true,
+	}
+	db.EnsureIndex(deviceCollectionName, index)
+
+	automaticExpiration = mgo.Index{
+		Key:         []string{"createdat"},
+		ExpireAfter: DeviceCodeExpiration,
+		Background:  true,
+	}
+	db.EnsureIndex(deviceCollectionName, automaticExpiration)
+
+	index = mgo.Index{
+		Key:    []string{"clientid", "jti"},
+		Unique: true,
+	}
+	db.EnsureIndex(assertionsCollectionName, index)
+
+	automaticExpiration = mgo.Index{
+		Key:         []string{"expiresat"},
+		ExpireAfter: time.Second,
+		Background:  true,
+	}
+	db.EnsureIndex(assertionsCollectionName, automaticExpiration)
+
+	index = mgo.Index{
+		Key:    []string{"sessionid", "clientid"},
+		Unique: true,
+	}
+	db.EnsureIndex(sessionClientsCollectionName, index)
+
+	automaticExpiration = mgo.Index{
+		Key:         []string{"createdat"},
+		ExpireAfter: SessionClientExpiration,
+		Background:  true,
+	}
+	db.EnsureIndex(sessionClientsCollectionName, automaticExpiration)
+
+	markLegacyCallbackURLs()
+}
+
+//markLegacyCallbackURLs flags the clients that were registered before redirect uris were matched exactly,
+// if LegacyCallbackURLPrefixMatching is enabled their callback url keeps being matched as a prefix until the client is updated
+func markLegacyCallbackURLs() {
+	session := db.GetSession()
+	defer session.Close()
+
+	info, err := db.GetCollection(session, clientsCollectionName).UpdateAll(bson.M{"legacycallbackurlprefix": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"legacycallbackurlprefix": true}})
+	if err != nil {
+		log.Error("Failed to mark the clients with a legacy callback url: ", err)
+		return
+	}
+	if info.Updated > 0 {
+		log.Infof("Marked %d clients that were registered before redirect uris were matched exactly", info.Updated)
+	}
 }
 
 //Manager is used to store
@@ -87,13 +178,41 @@ func (m *Manager) getAccessTokenCollection() *mgo.Collection {
 	return db.GetCollection(m.session, tokensCollectionName)
 }
 
-// Get an authorizationRequest by it's authorizationcode.
-func (m *Manager) Get(authorizationcode string) (*authorizationRequest, error) {
-	var ar authorizationRequest
-
-	err := m.getAuthorizationRequestCollection().Find(bson.M{"authorizationcode": authorizationcode}).One(&ar)
+//getAuthorizationRequest gets the authorizationRequest of an authorization code issued to a client without changing it.
+// If it is not found, nil is returned.
+func (m *Manager) getAuthorizationRequest(clientID, authorizationcode string) (ar *authorizationRequest, err error) {
+	ar = &authorizationRequest{}
+	err = m.getAuthorizationRequestCollection().Find(bson.M{"authorizationcode": authorizationcode, "clientid": clientID}).One(ar)
+	if err == mgo.ErrNotFound {
+		ar = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		ar = nil
+	}
+	return
+}
 
-	return &ar, err
+//redeemAuthorizationCode atomically marks the authorizationRequest of an authorization code issued to a client as redeemed
+// and returns it the way it was before. If the returned request was already redeemed, the code is being replayed.
+// If it is not found, nil is returned.
+func (m *Manager) redeemAuthorizationCode(clientID, authorizationcode string) (ar *authorizationRequest, err error) {
+	ar = &authorizationRequest{}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"redeemed": true}},
+		ReturnNew: false,
+	}
+	_, err = m.getAuthorizationRequestCollection().Find(bson.M{"authorizationcode": authorizationcode, "clientid": clientID}).Apply(change, ar)
+	if err == mgo.ErrNotFound {
+		ar = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		ar = nil
+	}
+	return
 }
 
 // saveAuthorizationRequest stores an authorizationRequest, only adding new authorizationRequests is allowed, updating is not
@@ -132,8 +251,267 @@ func (m *Manager) GetAccessToken(token string) (at *AccessToken, err error) {
 	if at.IsExpired() {
 		at = nil
 		err = nil
+		return
+	}
+	//A token that is bound to an interactive session can not outlive the session,
+	// using it counts as activity so the website keeps the session alive while it uses the api
+	if at.SessionID != "" {
+		var active bool
+		active, err = sessiondb.NewManagerWithSession(m.session).Touch(at.SessionID)
+		if err != nil || !active {
+			at = nil
+		}
+	}
+	return
+}
+
+//getRefreshTokenCollection returns the mongo collection for the refreshTokens
+func (m *Manager) getRefreshTokenCollection() *mgo.Collection {
+	return db.GetCollection(m.session, refreshTokensCollectionName)
+}
+
+// saveRefreshToken stores a refreshToken
+func (m *Manager) saveRefreshToken(rt *RefreshToken) (err error) {
+	err = m.getRefreshTokenCollection().Insert(rt)
+	return
+}
+
+//getRefreshToken gets a refresh token issued to a client without changing it, if it is not found, nil is returned
+func (m *Manager) getRefreshToken(clientID, token string) (rt *RefreshToken, err error) {
+	rt = &RefreshToken{}
+	err = m.getRefreshTokenCollection().Find(bson.M{"refreshtoken": token, "clientid": clientID}).One(rt)
+	if err == mgo.ErrNotFound {
+		rt = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		rt = nil
+	}
+	return
+}
+
+//rotateRefreshToken atomically marks a refresh token issued to a client as rotated and returns it the way it was before.
+// If the returned token was already rotated, the token is being replayed.
+// If the token is not found, nil is returned.
+func (m *Manager) rotateRefreshToken(clientID, token string) (rt *RefreshToken, err error) {
+	rt = &RefreshToken{}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"rotated": true}},
+		ReturnNew: false,
+	}
+	_, err = m.getRefreshTokenCollection().Find(bson.M{"refreshtoken": token, "clientid": clientID}).Apply(change, rt)
+	if err == mgo.ErrNotFound {
+		rt = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		rt = nil
+	}
+	return
+}
+
+//revokeTokenFamily removes all refresh tokens and access tokens that belong to a refresh token family
+func (m *Manager) revokeTokenFamily(familyID string) (err error) {
+	if _, err = m.getRefreshTokenCollection().RemoveAll(bson.M{"familyid": familyID}); err != nil {
+		return
+	}
+	_, err = m.getAccessTokenCollection().RemoveAll(bson.M{"familyid": familyID})
+	return
+}
+
+//isTokenFamilyActive checks if a refresh token family was not revoked and still has a refresh token that can be used
+func (m *Manager) isTokenFamilyActive(familyID string) (active bool, err error) {
+	count, err := m.getRefreshTokenCollection().Find(bson.M{
+		"familyid":  familyID,
+		"rotated":   false,
+		"createdat": bson.M{"$gt": time.Now().Add(-RefreshTokenExpiration)},
+	}).Count()
+	active = count > 0
+	return
+}
+
+//removeAccessToken removes an access token that was issued to a specific client
+func (m *Manager) removeAccessToken(clientID, token string) (removed bool, err error) {
+	err = m.getAccessTokenCollection().Remove(bson.M{"accesstoken": token, "clientid": clientID})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	removed = err == nil
+	return
+}
+
+//revokeRefreshToken revokes a refresh token that was issued to a specific client together with its entire token family
+func (m *Manager) revokeRefreshToken(clientID, token string) (removed bool, err error) {
+	rt := &RefreshToken{}
+	err = m.getRefreshTokenCollection().Find(bson.M{"refreshtoken": token, "clientid": clientID}).One(rt)
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	err = m.revokeTokenFamily(rt.FamilyID)
+	removed = err == nil
+	return
+}
+
+//RevokeClientTokensForUser removes all access tokens and refresh tokens a client holds for a user
+func (m *Manager) RevokeClientTokensForUser(username, clientID string) (err error) {
+	if _, err = m.getRefreshTokenCollection().RemoveAll(bson.M{"username": username, "clientid": clientID}); err != nil {
+		return
+	}
+	_, err = m.getAccessTokenCollection().RemoveAll(bson.M{"username": username, "clientid": clientID})
+	return
+}
+
+//RevokeSessionTokens removes the access tokens that were issued to the itsyou.online website during an interactive session
+func (m *Manager) RevokeSessionTokens(sessionID string) (err error) {
+	if sessionID == "" {
+		return
+	}
+	_, err = m.getAccessTokenCollection().RemoveAll(bson.M{"sessionid": sessionID})
+	return
+}
+
+//getDeviceAuthorizationCollection returns the mongo collection for the device authorization requests
+func (m *Manager) getDeviceAuthorizationCollection() *mgo.Collection {
+	return db.GetCollection(m.session, deviceCollectionName)
+}
+
+//saveDeviceAuthorization stores a new device authorization request
+func (m *Manager) saveDeviceAuthorization(da *deviceAuthorization) (err error) {
+	err = m.getDeviceAuthorizationCollection().Insert(da)
+	return
+}
+
+//getDeviceAuthorizationByUserCode gets a device authorization request by the code the user entered
+// If it is not found, nil is returned
+func (m *Manager) getDeviceAuthorizationByUserCode(userCode string) (da *deviceAuthorization, err error) {
+	da = &deviceAuthorization{}
+	err = m.getDeviceAuthorizationCollection().Find(bson.M{"usercode": userCode}).One(da)
+	if err == mgo.ErrNotFound {
+		da = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		da = nil
+	}
+	return
+}
+
+//approveDeviceAuthorization marks a pending device authorization request as approved by a user for the authorized scopes,
+// it returns false if the request is no longer pending
+func (m *Manager) approveDeviceAuthorization(userCode, username, scope string) (approved bool, err error) {
+	return m.completeDeviceAuthorization(userCode, bson.M{"status": deviceAuthorizationApproved, "username": username, "scope": scope})
+}
+
+//denyDeviceAuthorization marks a pending device authorization request as denied, it returns false if the request is no longer pending
+func (m *Manager) denyDeviceAuthorization(userCode string) (denied bool, err error) {
+	return m.completeDeviceAuthorization(userCode, bson.M{"status": deviceAuthorizationDenied})
+}
+
+func (m *Manager) completeDeviceAuthorization(userCode string, update bson.M) (updated bool, err error) {
+	err = m.getDeviceAuthorizationCollection().Update(
+		bson.M{"usercode": userCode, "status": deviceAuthorizationPending},
+		bson.M{"$set": update})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
+	}
+	updated = err == nil
+	return
+}
+
+//getDeviceAuthorization gets the device authorization request of a device code issued to a client without changing it.
+// If it is not found, nil is returned.
+func (m *Manager) getDeviceAuthorization(clientID, deviceCode string) (da *deviceAuthorization, err error) {
+	da = &deviceAuthorization{}
+	err = m.getDeviceAuthorizationCollection().Find(bson.M{"devicecode": deviceCode, "clientid": clientID}).One(da)
+	if err == mgo.ErrNotFound {
+		da = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		da = nil
+	}
+	return
+}
+
+//pollDeviceAuthorization atomically records a polling attempt of a client and returns the device authorization request the way it was before.
+// If it is not found, nil is returned.
+func (m *Manager) pollDeviceAuthorization(clientID, deviceCode string) (da *deviceAuthorization, err error) {
+	da = &deviceAuthorization{}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"lastpolledat": time.Now()}},
+		ReturnNew: false,
+	}
+	_, err = m.getDeviceAuthorizationCollection().Find(bson.M{"devicecode": deviceCode, "clientid": clientID}).Apply(change, da)
+	if err == mgo.ErrNotFound {
+		da = nil
+		err = nil
+		return
+	}
+	if err != nil {
+		da = nil
+	}
+	return
+}
+
+//slowDownDeviceAuthorization sets the increased polling interval of a device that polled too fast
+func (m *Manager) slowDownDeviceAuthorization(deviceCode string, interval time.Duration) (err error) {
+	err = m.getDeviceAuthorizationCollection().Update(bson.M{"devicecode": deviceCode}, bson.M{"$set": bson.M{"interval": interval}})
+	if err == mgo.ErrNotFound {
+		err = nil
+	}
+	return
+}
+
+//redeemDeviceAuthorization marks an approved device authorization request as redeemed, it returns false if it was not approved or already redeemed
+func (m *Manager) redeemDeviceAuthorization(deviceCode string) (redeemed bool, err error) {
+	err = m.getDeviceAuthorizationCollection().Update(
+		bson.M{"devicecode": deviceCode, "status": deviceAuthorizationApproved},
+		bson.M{"$set": bson.M{"status": deviceAuthorizationRedeemed}})
+	if err == mgo.ErrNotFound {
+		err = nil
+		return
 	}
+	redeemed = err == nil
+	return
+}
+
+//saveClientAssertion remembers a used client assertion until it expires, db.ErrDuplicate is returned if it was already used
+func (m *Manager) saveClientAssertion(assertion *clientAssertion) (err error) {
+	err = db.GetCollection(m.session, assertionsCollectionName).Insert(assertion)
+	if err != nil && mgo.IsDup(err) {
+		err = db.ErrDuplicate
+	}
+	return
+}
+
+//getSessionClientsCollection returns the mongo collection of the clients that were authorized during an interactive session
+func (m *Manager) getSessionClientsCollection() *mgo.Collection {
+	return db.GetCollection(m.session, sessionClientsCollectionName)
+}
 
+//saveSessionClient remembers that a client was authorized during an interactive session
+func (m *Manager) saveSessionClient(sc *sessionClient) (err error) {
+	_, err = m.getSessionClientsCollection().Upsert(bson.M{"sessionid": sc.SessionID, "clientid": sc.ClientID}, sc)
+	return
+}
+
+//removeSessionClients removes and returns the clients that were authorized during an interactive session
+func (m *Manager) removeSessionClients(sessionID string) (sessionClients []*sessionClient, err error) {
+	sessionClients = make([]*sessionClient, 0)
+	if err = m.getSessionClientsCollection().Find(bson.M{"sessionid": sessionID}).All(&sessionClients); err != nil {
+		return
+	}
+	_, err = m.getSessionClientsCollection().RemoveAll(bson.M{"sessionid": sessionID})
 	return
 }
 
@@ -164,11 +542,49 @@ func (m *Manager) CreateClient(client *Oauth2Client) (err error) {
 	return
 }
 
-//UpdateClient updates the label, callbackurl and clientCredentialsGrantType properties of a client
-func (m *Manager) UpdateClient(clientID, oldLabel, newLabel string, callbackURL string, clientcredentialsGrantType bool) (err error) {
+//UpdateClient updates the label, callbackurl, redirecturis, clientCredentialsGrantType, public, client authentication and logout properties of a client
+// Updating a client also switches a legacy callback url to exact matching
+func (m *Manager) UpdateClient(clientID, oldLabel string, client *Oauth2Client) (err error) {
+
+	_, err = m.getClientsCollection().UpdateAll(bson.M{"clientid": clientID, "label": oldLabel}, bson.M{"$set": bson.M{
+		"label":                      client.Label,
+		"callbackurl":                client.CallbackURL,
+		"redirecturis":               client.RedirectURIs,
+		"clientcredentialsgranttype": client.ClientCredentialsGrantType,
+		"public":                     client.Public,
+		"tokenendpointauthmethod":    client.TokenEndpointAuthMethod,
+		"publickey":                  client.PublicKey,
+		"tlsclientauthsubjectdn":     client.TLSClientAuthSubjectDN,
+		"backchannellogouturi":       client.BackChannelLogoutURI,
+		"frontchannellogouturi":      client.FrontChannelLogoutURI,
+		"postlogoutredirecturis":     client.PostLogoutRedirectURIs,
+		"legacycallbackurlprefix":    false,
+	}})
 
-	_, err = m.getClientsCollection().UpdateAll(bson.M{"clientid": clientID, "label": oldLabel}, bson.M{"$set": bson.M{"label": newLabel, "callbackurl": callbackURL, "clientcredentialsgranttype": clientcredentialsGrantType}})
+	if err != nil && mgo.IsDup(err) {
+		err = db.ErrDuplicate
+	}
+	return
+}
 
+//updateRegisteredClient updates all properties of a dynamically registered client
+func (m *Manager) updateRegisteredClient(oldLabel string, client *Oauth2Client) (err error) {
+	err = m.getClientsCollection().Update(bson.M{"clientid": client.ClientID, "label": oldLabel}, bson.M{"$set": bson.M{
+		"label":                      client.Label,
+		"callbackurl":                client.CallbackURL,
+		"redirecturis":               client.RedirectURIs,
+		"clientcredentialsgranttype": client.ClientCredentialsGrantType,
+		"public":                     client.Public,
+		"scope":                      client.Scope,
+		"granttypes":                 client.GrantTypes,
+		"tokenendpointauthmethod":    client.TokenEndpointAuthMethod,
+		"publickey":                  client.PublicKey,
+		"tlsclientauthsubjectdn":     client.TLSClientAuthSubjectDN,
+		"backchannellogouturi":       client.BackChannelLogoutURI,
+		"frontchannellogouturi":      client.FrontChannelLogoutURI,
+		"postlogoutredirecturis":     client.PostLogoutRedirectURIs,
+		"legacycallbackurlprefix":    false,
+	}})
 	if err != nil && mgo.IsDup(err) {
 		err = db.ErrDuplicate
 	}
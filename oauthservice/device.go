@@ -0,0 +1,392 @@
+package oauthservice
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//DeviceCodeGrantType is the requested grant_type when a device polls for an access token (RFC 8628)
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+const (
+	//DeviceCodeExpiration is the time a user has to approve a device authorization request
+	DeviceCodeExpiration = time.Minute * 10
+	//DevicePollingInterval is the minimum time a device needs to wait between polling requests
+	DevicePollingInterval = time.Second * 5
+	//DeviceSlowDownIncrement is added to the polling interval of a device every time it is told to slow down (RFC 8628 section 3.5)
+	DeviceSlowDownIncrement = time.Second * 5
+
+	//userCodeCharacters are the characters used in user codes, no vowels to avoid forming words and no ambiguous characters
+	userCodeCharacters = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength     = 8
+)
+
+const (
+	deviceAuthorizationPending  = "pending"
+	deviceAuthorizationApproved = "approved"
+	deviceAuthorizationDenied   = "denied"
+	deviceAuthorizationRedeemed = "redeemed"
+)
+
+//Errors returned to a polling device as defined in RFC 8628 section 3.5
+const (
+	deviceErrorAuthorizationPending = "authorization_pending"
+	deviceErrorSlowDown             = "slow_down"
+	deviceErrorExpiredToken         = "expired_token"
+)
+
+//deviceAuthorization is a pending device authorization request
+type deviceAuthorization struct {
+	DeviceCode   string
+	UserCode     string
+	ClientID     string
+	ClientLabel  string //The label of the client that started the request, the device code can only be used without client authentication if that client is public
+	Scope        string
+	Username     string
+	Status       string
+	Interval     time.Duration //Interval is the minimum time the device needs to wait between polling requests
+	LastPolledAt time.Time
+	CreatedAt    time.Time
+}
+
+//IsExpiredAt checks if the device authorization request is expired at a specific time
+func (da *deviceAuthorization) IsExpiredAt(testtime time.Time) bool {
+	return testtime.After(da.CreatedAt.Add(DeviceCodeExpiration))
+}
+
+//pollingInterval returns the minimum time the device needs to wait between polling requests
+func (da *deviceAuthorization) pollingInterval() time.Duration {
+	if da.Interval < DevicePollingInterval {
+		return DevicePollingInterval
+	}
+	return da.Interval
+}
+
+func newDeviceAuthorization(clientID, clientLabel, scope string) *deviceAuthorization {
+	return &deviceAuthorization{
+		DeviceCode:  newRandomToken(),
+		UserCode:    newUserCode(),
+		ClientID:    clientID,
+		ClientLabel: clientLabel,
+		Scope:       scope,
+		Status:      deviceAuthorizationPending,
+		Interval:    DevicePollingInterval,
+		CreatedAt:   time.Now(),
+	}
+}
+
+//newUserCode generates a random user code
+func newUserCode() string {
+	code := make([]byte, 0, userCodeLength)
+	randombyte := make([]byte, 1)
+	for len(code) < userCodeLength {
+		rand.Read(randombyte)
+		//Reject the values that would cause a bias towards the first characters
+		if int(randombyte[0]) >= 256-256%len(userCodeCharacters) {
+			continue
+		}
+		code = append(code, userCodeCharacters[int(randombyte[0])%len(userCodeCharacters)])
+	}
+	return string(code)
+}
+
+//normalizeUserCode removes the formatting of a user code as it is entered by a user
+func normalizeUserCode(userCode string) string {
+	userCode = strings.ToUpper(userCode)
+	userCode = strings.Replace(userCode, "-", "", -1)
+	userCode = strings.Replace(userCode, " ", "", -1)
+	return userCode
+}
+
+//formatUserCode formats a user code in groups of 4 characters to make it easier to type
+func formatUserCode(userCode string) string {
+	if len(userCode) <= 4 {
+		return userCode
+	}
+	return userCode[:4] + "-" + userCode[4:]
+}
+
+//authenticateDeviceClient checks the client credentials if they are given, devices without credentials must be public clients.
+// When a device code is polled, deviceCode is given and only the client that started the request can poll it,
+// all clients of an organization share the client_id so the label is checked as well.
+func authenticateDeviceClient(mgr *Manager, r *http.Request, clientID, secret, deviceCode string) (client *Oauth2Client, err error) {
+	if deviceCode == "" {
+		if hasClientAuthentication(r, secret) {
+			client, err = authenticateConfidentialClient(mgr, r, clientID, secret)
+		} else {
+			client, err = getPublicClient(mgr, clientID, "")
+		}
+		return
+	}
+	da, err := mgr.getDeviceAuthorization(clientID, deviceCode)
+	if err != nil || da == nil {
+		return
+	}
+	if hasClientAuthentication(r, secret) {
+		client, err = authenticateConfidentialClient(mgr, r, clientID, secret)
+		if client != nil && !client.IsIssuedClient(da.ClientLabel) {
+			client = nil
+		}
+		return
+	}
+	client, err = getIssuedPublicClient(mgr, clientID, da.ClientLabel)
+	return
+}
+
+//DeviceCodeHandler is the handler of the /v1/oauth/device/code endpoint, it starts a device authorization grant flow
+func (service *Service) DeviceCodeHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("ERROR parsing form: ", err)
+		writeOAuthError(w, errInvalidRequest("Unable to parse the request"))
+		return
+	}
+
+	clientID, clientSecret := clientCredentialsFromRequest(r)
+	if clientID == "" || clientID == "itsyouonline" {
+		writeOAuthError(w, errInvalidRequest("Missing client_id"))
+		return
+	}
+
+	mgr := NewManager(r)
+	client, err := authenticateDeviceClient(mgr, r, clientID, clientSecret, "")
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+	if client == nil {
+		writeOAuthError(w, errInvalidClient(""))
+		return
+	}
+
+	if !client.AllowsGrantType(DeviceCodeGrantType) {
+		writeOAuthError(w, errUnauthorizedClient("The client is not registered for the device_code grant type"))
+		return
+	}
+	requestedScopes := splitScopes(r.FormValue("scope"))
+	if !scopesAllowedForClient(client.Scope, requestedScopes) {
+		writeOAuthError(w, errInvalidScope("The client is not registered for the requested scopes"))
+		return
+	}
+
+	da := newDeviceAuthorization(clientID, client.Label, strings.Join(requestedScopes, ","))
+	if err = mgr.saveDeviceAuthorization(da); err != nil {
+		log.Error("Error saving the device authorization: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+
+	verificationURI := issuer() + "/device"
+	response := struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete"`
+		ExpiresIn               int64  `json:"expires_in"`
+		Interval                int64  `json:"interval"`
+	}{
+		DeviceCode:              da.DeviceCode,
+		UserCode:                formatUserCode(da.UserCode),
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?" + url.Values{"user_code": {formatUserCode(da.UserCode)}}.Encode(),
+		ExpiresIn:               int64(DeviceCodeExpiration.Seconds()),
+		Interval:                int64(DevicePollingInterval.Seconds()),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(&response)
+}
+
+//isRedirectFromPage checks if the request is the result of a user action on one of our own pages
+func isRedirectFromPage(r *http.Request, paths ...string) bool {
+	referrerURL, err := url.Parse(r.Header.Get("Referer"))
+	if err != nil || referrerURL.Host != r.Host {
+		return false
+	}
+	for _, path := range paths {
+		if referrerURL.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+//DeviceVerificationHandler is the handler of the /v1/oauth/device/verify endpoint.
+// The device page submits the user code the logged in user entered, the requested scopes are confirmed through the authorize page.
+func (service *Service) DeviceVerificationHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("ERROR parsing form: ", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	username, err := service.GetAuthenticatedUser(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if username == "" {
+		redirecToLoginPage(w, r)
+		return
+	}
+
+	userCode := normalizeUserCode(r.Form.Get("user_code"))
+	//Only accept approvals the user made on the device or authorize page, not links that were sent to the user
+	if !isRedirectFromPage(r, "/device", "/authorize") || !service.sessionService.ValidCSRFToken(r, r.PostForm.Get("csrf_token")) {
+		http.Redirect(w, r, "/device?"+url.Values{"user_code": {formatUserCode(userCode)}}.Encode(), http.StatusFound)
+		return
+	}
+
+	mgr := NewManager(r)
+	da, err := mgr.getDeviceAuthorizationByUserCode(userCode)
+	if err != nil {
+		log.Error("Error getting the device authorization: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if da == nil || da.IsExpiredAt(time.Now()) || da.Status != deviceAuthorizationPending {
+		http.Redirect(w, r, "/device?status=invalid", http.StatusFound)
+		return
+	}
+
+	if r.Form.Get("action") == "deny" {
+		denied, err := mgr.denyDeviceAuthorization(userCode)
+		if err != nil {
+			log.Error("Error denying the device authorization: ", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if !denied {
+			http.Redirect(w, r, "/device?status=invalid", http.StatusFound)
+			return
+		}
+		http.Redirect(w, r, "/device?status=denied", http.StatusFound)
+		return
+	}
+
+	possibleScopes, err := service.filterPossibleScopes(r, username, da.ClientID, splitScopes(da.Scope))
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	authorizedScopes, err := service.filterAuthorizedScopes(r, username, da.ClientID, possibleScopes)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	validAuthorization := authorizedScopes != nil && (len(possibleScopes) == len(authorizedScopes) || service.isConsentSubmission(r))
+	if !validAuthorization {
+		queryvalues := make(url.Values)
+		queryvalues.Set("client_id", da.ClientID)
+		queryvalues.Set("user_code", formatUserCode(userCode))
+		queryvalues.Set("scope", strings.Join(possibleScopes, ","))
+		queryvalues.Set("endpoint", r.URL.EscapedPath())
+		http.Redirect(w, r, "/authorize?"+queryvalues.Encode(), http.StatusFound)
+		return
+	}
+
+	approved, err := mgr.approveDeviceAuthorization(userCode, username, strings.Join(authorizedScopes, ","))
+	if err != nil {
+		log.Error("Error approving the device authorization: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !approved {
+		http.Redirect(w, r, "/device?status=invalid", http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, "/device?status=approved", http.StatusFound)
+}
+
+func deviceCodeTokenHandler(deviceCode string, clientID string, secret string, r *http.Request) (at *AccessToken, rt *RefreshToken, oauthErr *OAuthError) {
+
+	mgr := NewManager(r)
+	client, err := authenticateDeviceClient(mgr, r, clientID, secret, deviceCode)
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if client == nil {
+		oauthErr = errInvalidClient("")
+		return
+	}
+
+	da, err := mgr.pollDeviceAuthorization(clientID, deviceCode)
+	if err != nil {
+		log.Error("Error getting the device authorization: ", err)
+		oauthErr = errServerError()
+		return
+	}
+
+	if deviceError := deviceAuthorizationError(da, time.Now()); deviceError != "" {
+		if deviceError == deviceErrorSlowDown {
+			if err = mgr.slowDownDeviceAuthorization(deviceCode, da.pollingInterval()+DeviceSlowDownIncrement); err != nil {
+				log.Error("Error increasing the polling interval of the device authorization: ", err)
+			}
+		}
+		oauthErr = newOAuthError(deviceError, http.StatusBadRequest, "")
+		return
+	}
+
+	redeemed, err := mgr.redeemDeviceAuthorization(deviceCode)
+	if err != nil {
+		log.Error("Error redeeming the device authorization: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if !redeemed {
+		oauthErr = errInvalidGrant("The device code was already used")
+		return
+	}
+
+	rt = newRefreshToken(da.Username, "", da.ClientID, da.Scope, "")
+	rt.ClientLabel = da.ClientLabel
+	if err = mgr.saveRefreshToken(rt); err != nil {
+		log.Error("Error saving the refresh token: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	at = newAccessToken(da.Username, "", da.ClientID, da.Scope)
+	at.ClientLabel = da.ClientLabel
+	at.FamilyID = rt.FamilyID
+	if err = mgr.saveAccessToken(at); err != nil {
+		log.Error("Error saving the access token: ", err)
+		oauthErr = errServerError()
+	}
+	return
+}
+
+//deviceAuthorizationError returns the error for a polling device based on the state of the authorization request before this poll.
+// An empty string is returned if the authorization request is approved.
+func deviceAuthorizationError(da *deviceAuthorization, now time.Time) string {
+	if da == nil {
+		return ErrorCodeInvalidGrant
+	}
+	if da.IsExpiredAt(now) {
+		return deviceErrorExpiredToken
+	}
+	if !da.LastPolledAt.IsZero() && now.Sub(da.LastPolledAt) < da.pollingInterval() {
+		return deviceErrorSlowDown
+	}
+	switch da.Status {
+	case deviceAuthorizationPending:
+		return deviceErrorAuthorizationPending
+	case deviceAuthorizationDenied:
+		return ErrorCodeAccessDenied
+	case deviceAuthorizationApproved:
+		return ""
+	}
+	return ErrorCodeInvalidGrant
+}
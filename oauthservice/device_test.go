@@ -0,0 +1,70 @@
+package oauthservice
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserCode(t *testing.T) {
+	userCode := newUserCode()
+	assert.Len(t, userCode, userCodeLength)
+	for _, c := range userCode {
+		assert.True(t, strings.ContainsRune(userCodeCharacters, c), "Unexpected character in user code: %c", c)
+	}
+	assert.NotEqual(t, userCode, newUserCode())
+}
+
+func TestUserCodeFormatting(t *testing.T) {
+	assert.Equal(t, "BCDF-GHJK", formatUserCode("BCDFGHJK"))
+	assert.Equal(t, "BCDFGHJK", normalizeUserCode("BCDF-GHJK"))
+	assert.Equal(t, "BCDFGHJK", normalizeUserCode("bcdf ghjk"))
+	assert.Equal(t, "", normalizeUserCode(""))
+	assert.Equal(t, "", formatUserCode(""))
+}
+
+func TestDeviceAuthorizationError(t *testing.T) {
+	now := time.Now()
+	type testcase struct {
+		da       *deviceAuthorization
+		expected string
+	}
+	testcases := []testcase{
+		{da: nil, expected: ErrorCodeInvalidGrant},
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, CreatedAt: now}, expected: deviceErrorAuthorizationPending},
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, CreatedAt: now, LastPolledAt: now.Add(-time.Second)}, expected: deviceErrorSlowDown},
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, CreatedAt: now, LastPolledAt: now.Add(-DevicePollingInterval)}, expected: deviceErrorAuthorizationPending},
+		//A device that was told to slow down needs to respect the increased interval
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, Interval: DevicePollingInterval + DeviceSlowDownIncrement, CreatedAt: now, LastPolledAt: now.Add(-DevicePollingInterval)}, expected: deviceErrorSlowDown},
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, Interval: DevicePollingInterval + DeviceSlowDownIncrement, CreatedAt: now, LastPolledAt: now.Add(-DevicePollingInterval - DeviceSlowDownIncrement)}, expected: deviceErrorAuthorizationPending},
+		{da: &deviceAuthorization{Status: deviceAuthorizationPending, CreatedAt: now.Add(-DeviceCodeExpiration - time.Second)}, expected: deviceErrorExpiredToken},
+		{da: &deviceAuthorization{Status: deviceAuthorizationDenied, CreatedAt: now}, expected: ErrorCodeAccessDenied},
+		{da: &deviceAuthorization{Status: deviceAuthorizationRedeemed, CreatedAt: now}, expected: ErrorCodeInvalidGrant},
+		{da: &deviceAuthorization{Status: deviceAuthorizationApproved, CreatedAt: now}, expected: ""},
+	}
+	for _, test := range testcases {
+		assert.Equal(t, test.expected, deviceAuthorizationError(test.da, now), "%+v", test.da)
+	}
+}
+
+func TestIsRedirectFromPage(t *testing.T) {
+	type testcase struct {
+		referrer string
+		expected bool
+	}
+	testcases := []testcase{
+		{referrer: "", expected: false},
+		{referrer: "https://itsyou.online/device", expected: true},
+		{referrer: "https://itsyou.online/authorize?client_id=test", expected: true},
+		{referrer: "https://itsyou.online/", expected: false},
+		{referrer: "https://evil.example.com/device", expected: false},
+	}
+	for _, test := range testcases {
+		r, _ := http.NewRequest("GET", "https://itsyou.online/v1/oauth/device/verify", nil)
+		r.Header.Set("Referer", test.referrer)
+		assert.Equal(t, test.expected, isRedirectFromPage(r, "/device", "/authorize"), test.referrer)
+	}
+}
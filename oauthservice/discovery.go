@@ -0,0 +1,89 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+//supportedScopes are the scopes that are listed in the discovery document, labelled scopes can also be requested with a label
+var supportedScopes = []string{
+	OpenIDScope,
+	"user:name",
+	"user:memberof:<globalid>",
+	"user:address",
+	"user:email",
+	"user:phone",
+	"user:github",
+	"user:facebook",
+	"user:bankaccount",
+}
+
+//discoveryDocument is the OpenID Connect provider metadata
+type discoveryDocument struct {
+	Issuer                                     string   `json:"issuer"`
+	AuthorizationEndpoint                      string   `json:"authorization_endpoint"`
+	TokenEndpoint                              string   `json:"token_endpoint"`
+	UserinfoEndpoint                           string   `json:"userinfo_endpoint"`
+	JWKSURI                                    string   `json:"jwks_uri"`
+	RevocationEndpoint                         string   `json:"revocation_endpoint"`
+	IntrospectionEndpoint                      string   `json:"introspection_endpoint"`
+	DeviceAuthorizationEndpoint                string   `json:"device_authorization_endpoint"`
+	RegistrationEndpoint                       string   `json:"registration_endpoint"`
+	JWTEndpoint                                string   `json:"jwt_endpoint"`
+	EndSessionEndpoint                         string   `json:"end_session_endpoint"`
+	ScopesSupported                            []string `json:"scopes_supported"`
+	ResponseTypesSupported                     []string `json:"response_types_supported"`
+	GrantTypesSupported                        []string `json:"grant_types_supported"`
+	SubjectTypesSupported                      []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported           []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported          []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgValuesSupported []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+	CodeChallengeMethodsSupported              []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                            []string `json:"claims_supported"`
+	BackchannelLogoutSupported                 bool     `json:"backchannel_logout_supported"`
+	BackchannelLogoutSessionSupported          bool     `json:"backchannel_logout_session_supported"`
+	FrontchannelLogoutSupported                bool     `json:"frontchannel_logout_supported"`
+	FrontchannelLogoutSessionSupported         bool     `json:"frontchannel_logout_session_supported"`
+}
+
+func newDiscoveryDocument() *discoveryDocument {
+	iss := issuer()
+	return &discoveryDocument{
+		Issuer:                            iss,
+		AuthorizationEndpoint:             iss + "/v1/oauth/authorize",
+		TokenEndpoint:                     iss + "/v1/oauth/access_token",
+		UserinfoEndpoint:                  iss + "/v1/oauth/userinfo",
+		JWKSURI:                           iss + "/.well-known/jwks.json",
+		RevocationEndpoint:                iss + "/v1/oauth/revoke",
+		IntrospectionEndpoint:             iss + "/v1/oauth/introspect",
+		DeviceAuthorizationEndpoint:       iss + "/v1/oauth/device/code",
+		RegistrationEndpoint:              iss + "/v1/oauth/register",
+		JWTEndpoint:                       iss + "/v1/oauth/jwt",
+		EndSessionEndpoint:                iss + "/v1/oauth/logout",
+		ScopesSupported:                   supportedScopes,
+		ResponseTypesSupported:            []string{AuthorizationGrantCodeType, ImplicitGrantCodeType},
+		GrantTypesSupported:               []string{AuthorizationCodeGrantType, "implicit", ClientCredentialsGrantCodeType, RefreshTokenGrantType, DeviceCodeGrantType, TokenExchangeGrantType},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"ES384"},
+		TokenEndpointAuthMethodsSupported: []string{TokenEndpointAuthMethodClientSecretPost, TokenEndpointAuthMethodClientSecretBasic, TokenEndpointAuthMethodNone, TokenEndpointAuthMethodPrivateKeyJWT, TokenEndpointAuthMethodTLSClientAuth},
+		TokenEndpointAuthSigningAlgValuesSupported: []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"},
+		CodeChallengeMethodsSupported:              []string{CodeChallengeMethodPlain, CodeChallengeMethodS256},
+		ClaimsSupported:                            []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "sid", "preferred_username", "name", "given_name", "family_name", "email", "email_verified", "phone_number"},
+		BackchannelLogoutSupported:                 true,
+		BackchannelLogoutSessionSupported:          true,
+		FrontchannelLogoutSupported:                true,
+		FrontchannelLogoutSessionSupported:         true,
+	}
+}
+
+//DiscoveryHandler is the handler of the /.well-known/openid-configuration endpoint
+func (service *Service) DiscoveryHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(newDiscoveryDocument())
+}
+
+//JWKSHandler is the handler of the /.well-known/jwks.json endpoint, it publishes the active and retired public keys used to sign the JWTs
+func (service *Service) JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&JSONWebKeySet{Keys: service.keyRing.JSONWebKeys()})
+}
@@ -0,0 +1,113 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+//Error codes as defined in RFC 6749 sections 4.1.2.1 and 5.2
+const (
+	ErrorCodeInvalidRequest          = "invalid_request"
+	ErrorCodeInvalidClient           = "invalid_client"
+	ErrorCodeInvalidGrant            = "invalid_grant"
+	ErrorCodeUnauthorizedClient      = "unauthorized_client"
+	ErrorCodeUnsupportedGrantType    = "unsupported_grant_type"
+	ErrorCodeUnsupportedResponseType = "unsupported_response_type"
+	ErrorCodeInvalidScope            = "invalid_scope"
+	ErrorCodeAccessDenied            = "access_denied"
+	ErrorCodeServerError             = "server_error"
+)
+
+//OAuthError is an error that is returned to an oauth client
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	//StatusCode is the http status code used when the error is not returned in a redirect
+	StatusCode int `json:"-"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Description
+}
+
+func newOAuthError(code string, statusCode int, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description, StatusCode: statusCode}
+}
+
+func errInvalidRequest(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidRequest, http.StatusBadRequest, description)
+}
+
+func errInvalidClient(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidClient, http.StatusUnauthorized, description)
+}
+
+func errInvalidGrant(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidGrant, http.StatusBadRequest, description)
+}
+
+func errUnauthorizedClient(description string) *OAuthError {
+	return newOAuthError(ErrorCodeUnauthorizedClient, http.StatusBadRequest, description)
+}
+
+func errUnsupportedGrantType(description string) *OAuthError {
+	return newOAuthError(ErrorCodeUnsupportedGrantType, http.StatusBadRequest, description)
+}
+
+func errInvalidScope(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidScope, http.StatusBadRequest, description)
+}
+
+func errUnsupportedResponseType(description string) *OAuthError {
+	return newOAuthError(ErrorCodeUnsupportedResponseType, http.StatusBadRequest, description)
+}
+
+//errServerError is used for unexpected errors, the cause is logged and not exposed to the client
+func errServerError() *OAuthError {
+	return newOAuthError(ErrorCodeServerError, http.StatusInternalServerError, "")
+}
+
+//writeOAuthError writes a json error response, used by the endpoints that are called by the client directly
+func writeOAuthError(w http.ResponseWriter, e *OAuthError) {
+	//Clients that failed to authenticate get a challenge back so they know they can use http basic authentication
+	if e.Code == ErrorCodeInvalidToken {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="itsyou.online", error="invalid_token"`)
+	} else if e.StatusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="itsyou.online"`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(e.StatusCode)
+	json.NewEncoder(w).Encode(e)
+}
+
+//authorizeErrorRedirectURI adds the error and state parameters to a validated redirect uri.
+// For the implicit flow, the parameters are added in the fragment instead of the query.
+func authorizeErrorRedirectURI(redirectURI, state string, e *OAuthError, fragment bool) string {
+	parameters := make(url.Values)
+	parameters.Add("error", e.Code)
+	if e.Description != "" {
+		parameters.Add("error_description", e.Description)
+	}
+	if state != "" {
+		parameters.Add("state", state)
+	}
+
+	separator := "?"
+	if fragment {
+		separator = "#"
+	}
+	//Don't parse the redirect url, can only give errors while we don't gain much
+	if !strings.Contains(redirectURI, separator) {
+		redirectURI += separator
+	} else if !strings.HasSuffix(redirectURI, "&") && !strings.HasSuffix(redirectURI, separator) {
+		redirectURI += "&"
+	}
+	return redirectURI + parameters.Encode()
+}
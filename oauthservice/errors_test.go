@@ -0,0 +1,52 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizeErrorRedirectURI(t *testing.T) {
+	type testcase struct {
+		redirectURI string
+		state       string
+		fragment    bool
+		expected    string
+	}
+	testcases := []testcase{
+		{redirectURI: "https://example.com/callback", state: "abc", expected: "https://example.com/callback?error=invalid_request&state=abc"},
+		{redirectURI: "https://example.com/callback?a=b", state: "abc", expected: "https://example.com/callback?a=b&error=invalid_request&state=abc"},
+		{redirectURI: "https://example.com/callback?", expected: "https://example.com/callback?error=invalid_request"},
+		{redirectURI: "https://example.com/callback", state: "abc", fragment: true, expected: "https://example.com/callback#error=invalid_request&state=abc"},
+	}
+	for _, test := range testcases {
+		assert.Equal(t, test.expected, authorizeErrorRedirectURI(test.redirectURI, test.state, errInvalidRequest(""), test.fragment))
+	}
+
+	withDescription := authorizeErrorRedirectURI("https://example.com/callback", "", errInvalidRequest("Missing code"), false)
+	assert.Equal(t, "https://example.com/callback?error=invalid_request&error_description=Missing+code", withDescription)
+}
+
+func TestWriteOAuthError(t *testing.T) {
+	w := httptest.NewRecorder()
+	writeOAuthError(w, errInvalidGrant("Expired authorization code"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("WWW-Authenticate"))
+	var body map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]string{"error": "invalid_grant", "error_description": "Expired authorization code"}, body)
+
+	w = httptest.NewRecorder()
+	writeOAuthError(w, errInvalidClient(""))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+	body = nil
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]string{"error": "invalid_client"}, body)
+}
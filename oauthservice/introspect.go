@@ -0,0 +1,77 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//introspectionResponse is the response of the introspection endpoint as defined in RFC 7662
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	GlobalID  string `json:"globalid,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+}
+
+//newIntrospectionResponse creates the introspection response for an access token, nil or expired tokens are not active
+func newIntrospectionResponse(at *AccessToken) *introspectionResponse {
+	if at == nil || at.IsExpired() {
+		return &introspectionResponse{Active: false}
+	}
+	return &introspectionResponse{
+		Active:    true,
+		Scope:     at.Scope,
+		ClientID:  at.ClientID,
+		Username:  at.Username,
+		GlobalID:  at.GlobalID,
+		TokenType: at.Type,
+		Exp:       at.ExpirationTime().Unix(),
+		Iat:       at.CreatedAt.Unix(),
+	}
+}
+
+//IntrospectHandler is the handler of the /v1/oauth/introspect endpoint, it implements RFC 7662
+// Only authenticated clients are allowed to introspect tokens.
+func (service *Service) IntrospectHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("ERROR parsing form: ", err)
+		writeOAuthError(w, errInvalidRequest("Unable to parse the request"))
+		return
+	}
+
+	mgr := NewManager(r)
+	client, err := authenticateClient(mgr, r)
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+	if client == nil {
+		writeOAuthError(w, errInvalidClient(""))
+		return
+	}
+
+	token := r.FormValue("token")
+	if token == "" {
+		writeOAuthError(w, errInvalidRequest("Missing token"))
+		return
+	}
+
+	at, err := mgr.GetAccessToken(token)
+	if err != nil {
+		log.Error("Error getting the access token: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+
+	w.Header().Set("Content-type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(newIntrospectionResponse(at))
+}
@@ -0,0 +1,30 @@
+package oauthservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIntrospectionResponse(t *testing.T) {
+	response := newIntrospectionResponse(nil)
+	assert.False(t, response.Active)
+	assert.Empty(t, response.ClientID)
+
+	at := newAccessToken("user1", "globalid1", "client1", "user:name")
+	response = newIntrospectionResponse(at)
+	assert.True(t, response.Active)
+	assert.Equal(t, "user1", response.Username)
+	assert.Equal(t, "globalid1", response.GlobalID)
+	assert.Equal(t, "client1", response.ClientID)
+	assert.Equal(t, "user:name", response.Scope)
+	assert.Equal(t, "bearer", response.TokenType)
+	assert.Equal(t, at.CreatedAt.Unix(), response.Iat)
+	assert.Equal(t, at.ExpirationTime().Unix(), response.Exp)
+
+	at.CreatedAt = time.Now().Add(-AccessTokenExpiration).Add(-time.Second)
+	response = newIntrospectionResponse(at)
+	assert.False(t, response.Active)
+	assert.Empty(t, response.Username)
+}
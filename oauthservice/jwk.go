@@ -0,0 +1,73 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+//JSONWebKey is the public part of a signing key in the JWK format (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	N   string `json:"n,omitempty"` //N is the modulus of an RSA key
+	E   string `json:"e,omitempty"` //E is the exponent of an RSA key
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+//JSONWebKeySet is a set of JSONWebKeys as published on the jwks endpoint
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey `json:"keys"`
+}
+
+//ErrUnsupportedCurve is returned when a JWK is requested for an ecdsa key on a curve that can not be used to sign JWTs
+var ErrUnsupportedCurve = errors.New("Unsupported elliptic curve")
+
+//NewECJSONWebKey creates a JSONWebKey from an ecdsa public key, the key id is the RFC 7638 thumbprint of the key
+func NewECJSONWebKey(publicKey *ecdsa.PublicKey) (jwk *JSONWebKey, err error) {
+	var crv, alg string
+	switch publicKey.Curve.Params().BitSize {
+	case 256:
+		crv, alg = "P-256", "ES256"
+	case 384:
+		crv, alg = "P-384", "ES384"
+	case 521:
+		crv, alg = "P-521", "ES512"
+	default:
+		err = ErrUnsupportedCurve
+		return
+	}
+	//The coordinates are encoded using the full size of the curve, RFC 7518 section 6.2.1.2
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+	x := make([]byte, size)
+	y := make([]byte, size)
+	xBytes := publicKey.X.Bytes()
+	yBytes := publicKey.Y.Bytes()
+	copy(x[size-len(xBytes):], xBytes)
+	copy(y[size-len(yBytes):], yBytes)
+
+	jwk = &JSONWebKey{
+		Kty: "EC",
+		Crv: crv,
+		X:   base64.RawURLEncoding.EncodeToString(x),
+		Y:   base64.RawURLEncoding.EncodeToString(y),
+		Use: "sig",
+		Alg: alg,
+	}
+	jwk.Kid = jwk.Thumbprint()
+	return
+}
+
+//Thumbprint calculates the RFC 7638 thumbprint of an EC key
+func (jwk *JSONWebKey) Thumbprint() string {
+	//The required members in lexicographic order without whitespace
+	canonical := fmt.Sprintf(`{"crv":"%s","kty":"%s","x":"%s","y":"%s"}`, jwk.Crv, jwk.Kty, jwk.X, jwk.Y)
+	hash := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,54 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewECJSONWebKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+
+	jwk, err := NewECJSONWebKey(&key.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "EC", jwk.Kty)
+	assert.Equal(t, "P-384", jwk.Crv)
+	assert.Equal(t, "ES384", jwk.Alg)
+	assert.Equal(t, "sig", jwk.Use)
+	assert.Equal(t, jwk.Thumbprint(), jwk.Kid)
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	assert.NoError(t, err)
+	assert.Len(t, x, 48)
+	assert.Equal(t, 0, key.PublicKey.X.Cmp(new(big.Int).SetBytes(x)))
+
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	otherJWK, _ := NewECJSONWebKey(&otherKey.PublicKey)
+	assert.NotEqual(t, jwk.Kid, otherJWK.Kid)
+
+	key224, _ := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	_, err = NewECJSONWebKey(&key224.PublicKey)
+	assert.Equal(t, ErrUnsupportedCurve, err)
+}
+
+func TestThumbprint(t *testing.T) {
+	jwk := &JSONWebKey{Kty: "EC", Crv: "P-256", X: "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU", Y: "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}
+	//Thumbprint calculated with an independent implementation
+	assert.Equal(t, "oKIywvGUpTVTyxMQ3bwIIeQUudfr_CkLMjCE19ECD-U", jwk.Thumbprint())
+}
+
+func TestNewDiscoveryDocument(t *testing.T) {
+	PublicURL = "https://itsyou.online"
+	doc := newDiscoveryDocument()
+	assert.Equal(t, "https://itsyou.online", doc.Issuer)
+	assert.Equal(t, "https://itsyou.online/v1/oauth/authorize", doc.AuthorizationEndpoint)
+	assert.Equal(t, "https://itsyou.online/v1/oauth/access_token", doc.TokenEndpoint)
+	assert.Equal(t, "https://itsyou.online/.well-known/jwks.json", doc.JWKSURI)
+	assert.Contains(t, doc.ScopesSupported, OpenIDScope)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/dgrijalva/jwt-go"
@@ -45,6 +46,12 @@ func (service *Service) JWTHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	validity, err := parseJWTValidity(r.FormValue("validity"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
 	token := jwt.New(jwt.SigningMethodES384)
 	if at.Username != "" {
 		token.Claims["username"] = at.Username
@@ -52,12 +59,24 @@ func (service *Service) JWTHandler(w http.ResponseWriter, r *http.Request) {
 	if at.GlobalID != "" {
 		token.Claims["globalid"] = at.GlobalID
 	}
+	if at.FamilyID != "" {
+		token.Claims["grant_id"] = at.FamilyID
+	}
+	if at.ClientLabel != "" {
+		token.Claims["client_label"] = at.ClientLabel
+	}
+	now := time.Now()
+	expiration := at.ExpirationTime()
+	if validity > 0 {
+		expiration = now.Add(validity)
+	}
 	token.Claims["aud"] = at.ClientID
-	token.Claims["exp"] = at.ExpirationTime().Unix()
-	token.Claims["iss"] = "itsyouonline"
+	token.Claims["iat"] = now.Unix()
+	token.Claims["exp"] = expiration.Unix()
+	token.Claims["iss"] = jwtIssuer
 	token.Claims["scope"] = requestedScopes
 
-	tokenString, err := token.SignedString(service.jwtSigningKey)
+	tokenString, err := service.signJWT(token)
 	if err != nil {
 		log.Error(err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -0,0 +1,163 @@
+package oauthservice
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+)
+
+//MaxJWTValidity is the longest validity that can be requested for a JWT
+var MaxJWTValidity = AccessTokenExpiration
+
+//JWTRefreshGracePeriod is how long after its expiration a JWT can still be refreshed
+var JWTRefreshGracePeriod = time.Minute * 5
+
+//errInvalidValidity is returned when the requested validity of a JWT is not a number of seconds between 1 and MaxJWTValidity
+var errInvalidValidity = errors.New("Invalid validity")
+
+//parseJWTValidity parses the validity parameter, the number of seconds a JWT is valid. Zero is returned if it is not specified.
+func parseJWTValidity(value string) (validity time.Duration, err error) {
+	if value == "" {
+		return
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 || seconds > int64(MaxJWTValidity.Seconds()) {
+		err = errInvalidValidity
+		return
+	}
+	validity = time.Duration(seconds) * time.Second
+	return
+}
+
+//jwtIsRefreshable checks if the claims of a verified JWT allow it to be refreshed at a specific time.
+// Only JWTs issued on the jwt endpoint that are not expired for longer than the grace period can be refreshed.
+func jwtIsRefreshable(claims map[string]interface{}, now time.Time) bool {
+	iss, _ := claims["iss"].(string)
+	aud, _ := claims["aud"].(string)
+	exp, hasExp := claims["exp"].(float64)
+	_, exchanged := claims["act"]
+	if iss != jwtIssuer || aud == "" || !hasExp || exchanged {
+		return false
+	}
+	return !now.After(time.Unix(int64(exp), 0).Add(JWTRefreshGracePeriod))
+}
+
+//refreshedJWTValidity returns the validity of a refreshed JWT, by default it is the same as the validity of the original JWT
+func refreshedJWTValidity(claims map[string]interface{}, requested time.Duration) time.Duration {
+	if requested > 0 {
+		return requested
+	}
+	exp, _ := claims["exp"].(float64)
+	iat, hasIat := claims["iat"].(float64)
+	validity := time.Duration(int64(exp)-int64(iat)) * time.Second
+	if !hasIat || validity <= 0 || validity > MaxJWTValidity {
+		validity = MaxJWTValidity
+	}
+	return validity
+}
+
+//jwtGrantIsActive checks if the grant a JWT was issued for still exists.
+// For a user this means the authorization for the client still covers the scopes of the JWT and, if the JWT was acquired
+// with a token that has a refresh token, that the refresh token was not revoked.
+// For an organization, the api key the JWT was acquired with still needs to exist and be usable in the client credentials flow.
+func (service *Service) jwtGrantIsActive(r *http.Request, claims map[string]interface{}) (active bool, err error) {
+	username, _ := claims["username"].(string)
+	globalID, _ := claims["globalid"].(string)
+	clientID, _ := claims["aud"].(string)
+	mgr := NewManager(r)
+
+	switch {
+	case username != "" && globalID == "":
+		scope, _ := claims["scope"].(string)
+		requestedScopes := splitScopes(scope)
+		authorizedScopes, err := service.identityService.FilterAuthorizedScopes(r, username, clientID, requestedScopes)
+		if err != nil || authorizedScopes == nil || len(authorizedScopes) != len(requestedScopes) {
+			return false, err
+		}
+		if familyID, _ := claims["grant_id"].(string); familyID != "" {
+			return mgr.isTokenFamilyActive(familyID)
+		}
+		return true, nil
+	case username == "" && globalID != "":
+		//JWTs issued before the label was recorded can not be refreshed
+		label, _ := claims["client_label"].(string)
+		if label == "" {
+			return false, nil
+		}
+		client, err := mgr.GetClient(globalID, label)
+		if err != nil || client == nil {
+			return false, err
+		}
+		return client.ClientCredentialsGrantType, nil
+	}
+	return false, nil
+}
+
+//JWTRefreshHandler is the handler of the /v1/oauth/jwt/refresh endpoint.
+// It returns a new JWT for a JWT that is still valid or expired less than JWTRefreshGracePeriod ago, as long as the grant it was issued for still exists.
+func (service *Service) JWTRefreshHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("Error parsing form: ", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	tokenString := accessTokenFromRequest(r)
+	if tokenString == "" {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	validity, err := parseJWTValidity(r.FormValue("validity"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	oldToken, err := jwt.Parse(tokenString, service.keyRing.Keyfunc)
+	if err != nil {
+		//Expired JWTs can still be refreshed during the grace period, any other validation error is fatal
+		if validationErr, ok := err.(*jwt.ValidationError); !ok || validationErr.Errors != jwt.ValidationErrorExpired {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+	now := time.Now()
+	if !jwtIsRefreshable(oldToken.Claims, now) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	active, err := service.jwtGrantIsActive(r, oldToken.Claims)
+	if err != nil {
+		log.Error("Error checking the grant of the JWT: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !active {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	token := jwt.New(jwt.SigningMethodES384)
+	for _, claim := range []string{"username", "globalid", "grant_id", "client_label", "aud", "scope"} {
+		if value, present := oldToken.Claims[claim]; present {
+			token.Claims[claim] = value
+		}
+	}
+	token.Claims["iat"] = now.Unix()
+	token.Claims["exp"] = now.Add(refreshedJWTValidity(oldToken.Claims, validity)).Unix()
+	token.Claims["iss"] = jwtIssuer
+
+	newTokenString, err := service.signJWT(token)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Write([]byte(newTokenString))
+}
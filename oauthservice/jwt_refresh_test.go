@@ -0,0 +1,58 @@
+package oauthservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJWTValidity(t *testing.T) {
+	validity, err := parseJWTValidity("")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), validity)
+
+	validity, err = parseJWTValidity("300")
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, validity)
+
+	for _, value := range []string{"0", "-5", "abc", "1.5", "86401"} {
+		_, err = parseJWTValidity(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestJWTIsRefreshable(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+	claims := map[string]interface{}{
+		"iss":      "itsyouonline",
+		"aud":      "org1",
+		"username": "bob",
+		"exp":      float64(now.Unix()),
+	}
+	assert.True(t, jwtIsRefreshable(claims, now))
+	assert.True(t, jwtIsRefreshable(claims, now.Add(JWTRefreshGracePeriod)))
+	assert.False(t, jwtIsRefreshable(claims, now.Add(JWTRefreshGracePeriod+time.Second)))
+
+	//Exchanged JWTs are not refreshable
+	claims["act"] = map[string]interface{}{"sub": "org2"}
+	assert.False(t, jwtIsRefreshable(claims, now))
+	delete(claims, "act")
+
+	claims["iss"] = "https://itsyou.online"
+	assert.False(t, jwtIsRefreshable(claims, now))
+	claims["iss"] = "itsyouonline"
+
+	delete(claims, "exp")
+	assert.False(t, jwtIsRefreshable(claims, now))
+}
+
+func TestRefreshedJWTValidity(t *testing.T) {
+	claims := map[string]interface{}{"iat": float64(1000), "exp": float64(1600)}
+	assert.Equal(t, 10*time.Minute, refreshedJWTValidity(claims, 0))
+	assert.Equal(t, time.Minute, refreshedJWTValidity(claims, time.Minute))
+
+	//JWTs issued before the iat claim was added get the maximum validity
+	delete(claims, "iat")
+	assert.Equal(t, MaxJWTValidity, refreshedJWTValidity(claims, 0))
+}
@@ -0,0 +1,295 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+const signingKeysCollectionName = "oauth_signingkeys"
+
+var (
+	//RetiredKeyRetention is the time a retired signing key can still be used to verify JWTs.
+	// It should be longer than the lifetime of the JWTs that are issued.
+	RetiredKeyRetention = time.Hour * 24 * 7
+	//keyRingReloadInterval is the time after which the keys are reloaded from the database,
+	// this makes sure all instances pick up a new active key after a rotation
+	keyRingReloadInterval = time.Minute
+	//keyRingMinimumReloadInterval limits the reloads triggered by JWTs with an unknown kid
+	keyRingMinimumReloadInterval = time.Second * 5
+)
+
+//ErrUnknownSigningKey is returned when a JWT is signed with a key that is not in the keyring
+var ErrUnknownSigningKey = errors.New("Unknown signing key")
+
+//signingKey is a JWT signing key as it is stored in the database
+type signingKey struct {
+	Kid       string
+	Key       string //PEM encoded private key
+	Active    bool
+	CreatedAt time.Time
+	RetiredAt time.Time
+}
+
+//KeyRing holds the active JWT signing key and the retired keys that can only be used to verify JWTs
+type KeyRing struct {
+	lock        sync.RWMutex
+	activeKid   string
+	privateKeys map[string]*ecdsa.PrivateKey
+	loadedAt    time.Time
+}
+
+func initKeyRingModels() {
+	index := mgo.Index{
+		Key:    []string{"kid"},
+		Unique: true,
+	}
+	db.EnsureIndex(signingKeysCollectionName, index)
+}
+
+func getSigningKeysCollection(session *mgo.Session) *mgo.Collection {
+	return db.GetCollection(session, signingKeysCollectionName)
+}
+
+//LoadKeyRing loads the signing keys from the database
+func LoadKeyRing() (kr *KeyRing, err error) {
+	initKeyRingModels()
+	kr = &KeyRing{}
+	err = kr.load()
+	return
+}
+
+func (kr *KeyRing) load() (err error) {
+	session := db.GetSession()
+	defer session.Close()
+
+	var storedKeys []signingKey
+	err = getSigningKeysCollection(session).Find(bson.M{}).Sort("createdat").All(&storedKeys)
+	if err != nil {
+		return
+	}
+
+	activeKid := ""
+	privateKeys := make(map[string]*ecdsa.PrivateKey)
+	for _, storedKey := range storedKeys {
+		if !storedKey.Active && time.Since(storedKey.RetiredAt) > RetiredKeyRetention {
+			continue
+		}
+		key, err := jwt.ParseECPrivateKeyFromPEM([]byte(storedKey.Key))
+		if err != nil {
+			log.Error("Unable to parse JWT signing key ", storedKey.Kid, ": ", err)
+			continue
+		}
+		privateKeys[storedKey.Kid] = key
+		//The keys are sorted by creation date, if multiple keys are active, the most recent one wins
+		if storedKey.Active {
+			activeKid = storedKey.Kid
+		}
+	}
+
+	kr.lock.Lock()
+	defer kr.lock.Unlock()
+	kr.activeKid = activeKid
+	kr.privateKeys = privateKeys
+	kr.loadedAt = time.Now()
+	return
+}
+
+func (kr *KeyRing) reloadIfOlderThan(interval time.Duration) {
+	kr.lock.RLock()
+	stale := time.Since(kr.loadedAt) > interval
+	kr.lock.RUnlock()
+	if !stale {
+		return
+	}
+	if err := kr.load(); err != nil {
+		log.Error("Error reloading the JWT signing keys: ", err)
+	}
+}
+
+//Empty returns true if the keyring does not have an active signing key
+func (kr *KeyRing) Empty() bool {
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+	return kr.activeKid == ""
+}
+
+//Bootstrap adds an existing key as the active signing key to an empty keyring
+func (kr *KeyRing) Bootstrap(key *ecdsa.PrivateKey) (err error) {
+	if !kr.Empty() {
+		return
+	}
+	storedKey, err := newSigningKey(key)
+	if err != nil {
+		return
+	}
+	session := db.GetSession()
+	defer session.Close()
+	err = getSigningKeysCollection(session).Insert(storedKey)
+	//Another instance might have bootstrapped the same key in the meantime
+	if err != nil && !mgo.IsDup(err) {
+		return
+	}
+	err = kr.load()
+	return
+}
+
+//SigningKey returns the active signing key and its key id
+func (kr *KeyRing) SigningKey() (kid string, key *ecdsa.PrivateKey) {
+	kr.reloadIfOlderThan(keyRingReloadInterval)
+
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+	kid = kr.activeKid
+	key = kr.privateKeys[kid]
+	return
+}
+
+//VerificationKey returns the public key of the signing key with a specific key id, active or retired.
+// If the key id is unknown, the keys are reloaded in case another instance rotated the key.
+func (kr *KeyRing) VerificationKey(kid string) (publicKey *ecdsa.PublicKey, err error) {
+	kr.reloadIfOlderThan(keyRingReloadInterval)
+	if publicKey = kr.publicKey(kid); publicKey != nil {
+		return
+	}
+	kr.reloadIfOlderThan(keyRingMinimumReloadInterval)
+	if publicKey = kr.publicKey(kid); publicKey == nil {
+		err = ErrUnknownSigningKey
+	}
+	return
+}
+
+func (kr *KeyRing) publicKey(kid string) *ecdsa.PublicKey {
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+	if key, found := kr.privateKeys[kid]; found {
+		return &key.PublicKey
+	}
+	return nil
+}
+
+//Keyfunc can be passed to jwt.Parse to verify JWTs issued by itsyou.online.
+// JWTs without a kid were issued before key rotation was supported, they are verified using any key in the keyring.
+func (kr *KeyRing) Keyfunc(token *jwt.Token) (key interface{}, err error) {
+	if token.Method != jwt.SigningMethodES384 {
+		err = fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		return
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return kr.verificationKeyWithoutKid(token)
+	}
+	return kr.VerificationKey(kid)
+}
+
+//verificationKeyWithoutKid returns the public key of the signing key a JWT without kid was signed with.
+// The active key is tried first, then the retired keys, so these JWTs remain valid after a key rotation until they expire.
+// If none of the keys verifies the signature, the active key is returned and the signature check of jwt.Parse fails.
+func (kr *KeyRing) verificationKeyWithoutKid(token *jwt.Token) (publicKey *ecdsa.PublicKey, err error) {
+	kr.reloadIfOlderThan(keyRingReloadInterval)
+
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+	activeKey, found := kr.privateKeys[kr.activeKid]
+	if !found {
+		err = ErrUnknownSigningKey
+		return
+	}
+	publicKey = &activeKey.PublicKey
+	separator := strings.LastIndex(token.Raw, ".")
+	if separator < 0 {
+		return
+	}
+	signingString, signature := token.Raw[:separator], token.Raw[separator+1:]
+	if token.Method.Verify(signingString, signature, publicKey) == nil {
+		return
+	}
+	for kid, key := range kr.privateKeys {
+		if kid != kr.activeKid && token.Method.Verify(signingString, signature, &key.PublicKey) == nil {
+			publicKey = &key.PublicKey
+			return
+		}
+	}
+	return
+}
+
+//JSONWebKeys returns the public keys of all signing keys in the keyring
+func (kr *KeyRing) JSONWebKeys() (keys []*JSONWebKey) {
+	kr.reloadIfOlderThan(keyRingReloadInterval)
+
+	kr.lock.RLock()
+	defer kr.lock.RUnlock()
+	keys = make([]*JSONWebKey, 0, len(kr.privateKeys))
+	for kid, key := range kr.privateKeys {
+		jwk, err := NewECJSONWebKey(&key.PublicKey)
+		if err != nil {
+			log.Error("Unable to create a JWK for signing key ", kid, ": ", err)
+			continue
+		}
+		keys = append(keys, jwk)
+	}
+	return
+}
+
+func newSigningKey(key *ecdsa.PrivateKey) (storedKey *signingKey, err error) {
+	jwk, err := NewECJSONWebKey(&key.PublicKey)
+	if err != nil {
+		return
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return
+	}
+	storedKey = &signingKey{
+		Kid:       jwk.Kid,
+		Key:       string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	return
+}
+
+//RotateSigningKey generates a new active signing key, the previously active keys are retired.
+// Retired keys are still used to verify JWTs during the RetiredKeyRetention period.
+func RotateSigningKey() (kid string, err error) {
+	initKeyRingModels()
+
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return
+	}
+	storedKey, err := newSigningKey(key)
+	if err != nil {
+		return
+	}
+
+	session := db.GetSession()
+	defer session.Close()
+	collection := getSigningKeysCollection(session)
+
+	if err = collection.Insert(storedKey); err != nil {
+		return
+	}
+	now := time.Now()
+	_, err = collection.UpdateAll(bson.M{"active": true, "kid": bson.M{"$ne": storedKey.Kid}}, bson.M{"$set": bson.M{"active": false, "retiredat": now}})
+	if err != nil {
+		return
+	}
+	_, err = collection.RemoveAll(bson.M{"active": false, "retiredat": bson.M{"$lt": now.Add(-RetiredKeyRetention)}})
+	kid = storedKey.Kid
+	return
+}
@@ -0,0 +1,81 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+//newTestKeyRing creates a keyring that does not need a database as long as no unknown keys are requested
+func newTestKeyRing(activeKid string, activeKey *ecdsa.PrivateKey) *KeyRing {
+	return &KeyRing{
+		activeKid:   activeKid,
+		privateKeys: map[string]*ecdsa.PrivateKey{activeKid: activeKey},
+		loadedAt:    time.Now(),
+	}
+}
+
+func TestNewSigningKey(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	storedKey, err := newSigningKey(key)
+	assert.NoError(t, err)
+	assert.True(t, storedKey.Active)
+
+	jwk, _ := NewECJSONWebKey(&key.PublicKey)
+	assert.Equal(t, jwk.Kid, storedKey.Kid)
+
+	parsedKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(storedKey.Key))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, key.D.Cmp(parsedKey.D))
+}
+
+func TestKeyRingKeyfunc(t *testing.T) {
+	activeKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	retiredKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	kr := newTestKeyRing("active", activeKey)
+	kr.privateKeys["retired"] = retiredKey
+
+	kid, key := kr.SigningKey()
+	assert.Equal(t, "active", kid)
+	assert.Equal(t, activeKey, key)
+
+	sign := func(kid string, key *ecdsa.PrivateKey) string {
+		token := jwt.New(jwt.SigningMethodES384)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		tokenString, _ := token.SignedString(key)
+		return tokenString
+	}
+
+	token, err := jwt.Parse(sign("retired", retiredKey), kr.Keyfunc)
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+
+	//Tokens without kid are verified with any key in the keyring, also after the key they were signed with is retired
+	token, err = jwt.Parse(sign("", activeKey), kr.Keyfunc)
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+	token, err = jwt.Parse(sign("", retiredKey), kr.Keyfunc)
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+
+	unknownKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	_, err = jwt.Parse(sign("", unknownKey), kr.Keyfunc)
+	assert.Error(t, err)
+
+	_, err = jwt.Parse(sign("active", retiredKey), kr.Keyfunc)
+	assert.Error(t, err)
+
+	hmacSigned := jwt.New(jwt.SigningMethodHS256)
+	hmacSignedString, _ := hmacSigned.SignedString([]byte("secret"))
+	_, err = jwt.Parse(hmacSignedString, kr.Keyfunc)
+	assert.Error(t, err)
+
+	assert.Len(t, kr.JSONWebKeys(), 2)
+}
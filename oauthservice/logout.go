@@ -0,0 +1,398 @@
+package oauthservice
+
+import (
+	"errors"
+	"html/template"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+)
+
+//BackChannelLogoutEvent is the event a logout token contains (OpenID Connect Back-Channel Logout 1.0 section 2.4)
+const BackChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+//SessionClientExpiration is how long itsyou.online remembers that a client was authorized during an interactive session
+var SessionClientExpiration = time.Hour * 24
+
+//BackChannelLogoutTimeout is how long itsyou.online waits for a client to acknowledge a logout token
+var BackChannelLogoutTimeout = time.Second * 5
+
+//FrontChannelLogoutTimeout is how long the browser of the user waits for the front-channel logout uris of the clients to load
+// before it continues to the post logout redirect uri
+var FrontChannelLogoutTimeout = time.Second * 5
+
+//ErrInvalidLogoutURI is returned when an invalid back-channel logout uri, front-channel logout uri or post logout redirect uri is registered for a client
+var ErrInvalidLogoutURI = errors.New("Invalid logout uri")
+
+//errInvalidIDTokenHint is returned when an id_token_hint was not issued by itsyou.online
+var errInvalidIDTokenHint = errors.New("Invalid id_token_hint")
+
+//errNonPublicLogoutHost is returned when the host of a back-channel logout uri resolves to an address that is not publicly routable
+var errNonPublicLogoutHost = errors.New("The back-channel logout uri does not resolve to a public address")
+
+//nonPublicNetworks are the loopback, private, link-local and shared address ranges logout tokens are never posted to
+var nonPublicNetworks = parseCIDRs("0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16", "::1/128", "fc00::/7", "fe80::/10")
+
+func parseCIDRs(cidrs ...string) (networks []*net.IPNet) {
+	for _, cidr := range cidrs {
+		_, network, _ := net.ParseCIDR(cidr)
+		networks = append(networks, network)
+	}
+	return
+}
+
+//isPublicIP checks if an ip address is publicly routable
+func isPublicIP(ip net.IP) bool {
+	if ip.IsUnspecified() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+//isValidBackChannelLogoutURI checks if logout tokens can be posted to a back-channel logout uri,
+// it has to be an https uri and the host can not be a loopback or private address
+func isValidBackChannelLogoutURI(backChannelLogoutURI string) bool {
+	if (RedirectURI{URI: backChannelLogoutURI}).Validate() != nil {
+		return false
+	}
+	u, err := url.Parse(backChannelLogoutURI)
+	if err != nil || u.Scheme != "https" || u.User != nil {
+		return false
+	}
+	host := hostname(u)
+	if strings.ToLower(host) == "localhost" || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return isPublicIP(ip)
+	}
+	return true
+}
+
+//isValidFrontChannelLogoutURI checks if a front-channel logout uri can be rendered in an iframe when the user logs out, it has to be an https uri
+func isValidFrontChannelLogoutURI(frontChannelLogoutURI string) bool {
+	if (RedirectURI{URI: frontChannelLogoutURI}).Validate() != nil {
+		return false
+	}
+	u, err := url.Parse(frontChannelLogoutURI)
+	return err == nil && u.Scheme == "https" && u.User == nil
+}
+
+//dialPublicHost only connects to hosts that resolve to public addresses, the check of the registered uri
+// can not prevent a hostname from resolving to an internal address later on
+func dialPublicHost(network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		if !isPublicIP(ip) {
+			return nil, errNonPublicLogoutHost
+		}
+	}
+	if len(ips) == 0 {
+		return nil, errNonPublicLogoutHost
+	}
+	return net.DialTimeout(network, net.JoinHostPort(ips[0].String(), port), BackChannelLogoutTimeout)
+}
+
+//sessionClient records that a client was authorized by a user during an interactive session
+type sessionClient struct {
+	SessionID string
+	Username  string
+	ClientID  string
+	CreatedAt time.Time
+}
+
+//ValidateLogoutURIs checks if the back-channel logout uri, the front-channel logout uri and the post logout redirect uris can be registered for a client
+func ValidateLogoutURIs(backChannelLogoutURI, frontChannelLogoutURI string, postLogoutRedirectURIs []string) error {
+	if backChannelLogoutURI != "" && !isValidBackChannelLogoutURI(backChannelLogoutURI) {
+		return ErrInvalidLogoutURI
+	}
+	if frontChannelLogoutURI != "" && !isValidFrontChannelLogoutURI(frontChannelLogoutURI) {
+		return ErrInvalidLogoutURI
+	}
+	for _, uri := range postLogoutRedirectURIs {
+		if err := (RedirectURI{URI: uri}).Validate(); err != nil {
+			return ErrInvalidLogoutURI
+		}
+	}
+	return nil
+}
+
+//trackSessionClient remembers that a client was authorized during the interactive session of the user,
+// the client is notified when the user logs out
+func trackSessionClient(r *http.Request, sessionID, username, clientID string) (err error) {
+	if sessionID == "" {
+		return
+	}
+	sc := &sessionClient{SessionID: sessionID, Username: username, ClientID: clientID, CreatedAt: time.Now()}
+	err = NewManager(r).saveSessionClient(sc)
+	return
+}
+
+//clientIDFromIDTokenHint returns the client an id_token_hint was issued to.
+// An id_token_hint is typically expired by the time the user logs out, so the expiration is not checked.
+func clientIDFromIDTokenHint(idTokenHint string, keyfunc jwt.Keyfunc, iss string) (clientID string, err error) {
+	token, err := jwt.Parse(idTokenHint, keyfunc)
+	if err != nil {
+		if validationErr, ok := err.(*jwt.ValidationError); !ok || validationErr.Errors != jwt.ValidationErrorExpired {
+			return
+		}
+		err = nil
+	}
+	if claimIss, _ := token.Claims["iss"].(string); claimIss != iss {
+		err = errInvalidIDTokenHint
+		return
+	}
+	clientID, _ = token.Claims["aud"].(string)
+	if clientID == "" {
+		err = errInvalidIDTokenHint
+	}
+	return
+}
+
+//validatePostLogoutRedirectURI checks if the post logout redirect uri is registered in 'a' apikey of the client
+func validatePostLogoutRedirectURI(mgr ClientManager, clientID, postLogoutRedirectURI string) (valid bool, err error) {
+	if clientID == "" {
+		return
+	}
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, client := range clients {
+		valid = valid || client.MatchesPostLogoutRedirectURI(postLogoutRedirectURI)
+	}
+	return
+}
+
+//postLogoutRedirectURL adds the state of the client to the post logout redirect uri
+func postLogoutRedirectURL(postLogoutRedirectURI, state string) string {
+	if state == "" {
+		return postLogoutRedirectURI
+	}
+	u, err := url.Parse(postLogoutRedirectURI)
+	if err != nil {
+		return postLogoutRedirectURI
+	}
+	query := u.Query()
+	query.Set("state", state)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+//newLogoutToken creates the logout token that is sent to a client when a user logs out (OpenID Connect Back-Channel Logout 1.0 section 2.4)
+func newLogoutToken(clientID, username, sessionID string) *jwt.Token {
+	token := jwt.New(jwt.SigningMethodES384)
+	token.Header["typ"] = "logout+jwt"
+	now := time.Now()
+	token.Claims["iss"] = issuer()
+	token.Claims["sub"] = username
+	token.Claims["aud"] = clientID
+	token.Claims["iat"] = now.Unix()
+	token.Claims["exp"] = now.Add(time.Minute * 2).Unix()
+	token.Claims["jti"] = newRandomToken()
+	token.Claims["sid"] = sessionID
+	token.Claims["events"] = map[string]interface{}{BackChannelLogoutEvent: map[string]interface{}{}}
+	return token
+}
+
+//frontChannelLogoutURL adds the issuer and the session id to a front-channel logout uri (OpenID Connect Front-Channel Logout 1.0 section 2)
+func frontChannelLogoutURL(frontChannelLogoutURI, iss, sessionID string) string {
+	u, err := url.Parse(frontChannelLogoutURI)
+	if err != nil {
+		return frontChannelLogoutURI
+	}
+	query := u.Query()
+	query.Set("iss", iss)
+	query.Set("sid", sessionID)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+//notifyLogout notifies the clients that were authorized during an interactive session that the user logged out.
+// The logout tokens of the back-channel logout are posted in the background, a client that is not reachable does not prevent the user from logging out.
+// The returned front-channel logout urls need to be loaded in the browser of the user.
+func (service *Service) notifyLogout(r *http.Request, sessionID string) (frontChannelLogoutURLs []string, err error) {
+	mgr := NewManager(r)
+	sessionClients, err := mgr.removeSessionClients(sessionID)
+	if err != nil {
+		return
+	}
+	frontChannelLogoutURIs := make(map[string]bool)
+	for _, sc := range sessionClients {
+		clients, err := mgr.AllByClientID(sc.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		logoutURIs := make(map[string]bool)
+		for _, client := range clients {
+			if client.BackChannelLogoutURI != "" {
+				logoutURIs[client.BackChannelLogoutURI] = true
+			}
+			if client.FrontChannelLogoutURI != "" && !frontChannelLogoutURIs[client.FrontChannelLogoutURI] {
+				frontChannelLogoutURIs[client.FrontChannelLogoutURI] = true
+				frontChannelLogoutURLs = append(frontChannelLogoutURLs, frontChannelLogoutURL(client.FrontChannelLogoutURI, issuer(), sessionID))
+			}
+		}
+		for logoutURI := range logoutURIs {
+			logoutToken, err := service.signJWT(newLogoutToken(sc.ClientID, sc.Username, sessionID))
+			if err != nil {
+				return nil, err
+			}
+			go postLogoutToken(logoutURI, logoutToken)
+		}
+	}
+	return
+}
+
+//postLogoutToken sends a logout token to the back-channel logout uri of a client
+func postLogoutToken(logoutURI, logoutToken string) {
+	//Clients that registered their logout uri before it had to be public are skipped
+	if !isValidBackChannelLogoutURI(logoutURI) {
+		log.Info("Not sending the logout token to ", logoutURI, ", it is not a public https uri")
+		return
+	}
+	client := &http.Client{
+		Timeout:   BackChannelLogoutTimeout,
+		Transport: &http.Transport{Dial: dialPublicHost, TLSHandshakeTimeout: BackChannelLogoutTimeout, DisableKeepAlives: true},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return errors.New("The back-channel logout uri redirected")
+		},
+	}
+	response, err := client.PostForm(logoutURI, url.Values{"logout_token": {logoutToken}})
+	if err != nil {
+		log.Info("Error sending the logout token to ", logoutURI, ": ", err)
+		return
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		log.Info("The logout token was not accepted by ", logoutURI, ": ", response.Status)
+	}
+}
+
+//frontChannelLogoutPage loads the front-channel logout uris of the clients in hidden iframes
+// and continues to the post logout redirect uri once they are loaded or the timeout expires
+var frontChannelLogoutPage = template.Must(template.New("frontchannellogout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Logging out</title>
+<script>
+var remaining = {{len .LogoutURLs}};
+function proceed() {
+	window.location.replace({{.RedirectURL}});
+}
+function loaded() {
+	remaining--;
+	if (remaining <= 0) {
+		proceed();
+	}
+}
+setTimeout(proceed, {{.TimeoutMilliseconds}});
+</script>
+</head>
+<body>
+<p>Logging out...</p>
+{{range .LogoutURLs}}<iframe src="{{.}}" onload="loaded()" style="display:none"></iframe>
+{{end}}<noscript><a href="{{.RedirectURL}}">Continue</a></noscript>
+</body>
+</html>
+`))
+
+//showFrontChannelLogoutPage renders the page that loads the front-channel logout uris of the clients
+func showFrontChannelLogoutPage(w http.ResponseWriter, logoutURLs []string, redirectURL string) {
+	data := struct {
+		LogoutURLs          []string
+		RedirectURL         string
+		TimeoutMilliseconds int64
+	}{
+		LogoutURLs:          logoutURLs,
+		RedirectURL:         redirectURL,
+		TimeoutMilliseconds: int64(FrontChannelLogoutTimeout / time.Millisecond),
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := frontChannelLogoutPage.Execute(w, data); err != nil {
+		log.Error("Error rendering the front-channel logout page: ", err)
+	}
+}
+
+//EndSessionHandler is the handler of the OpenID Connect end session endpoint /v1/oauth/logout.
+// It logs out the user, notifies the clients the user logged in to during this session using back-channel and front-channel logout
+// and redirects the user to the post_logout_redirect_uri if the client registered it.
+func (service *Service) EndSessionHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		log.Debug("Error parsing form: ", err)
+		showAuthorizeError(w, errInvalidRequest("Unable to parse the request"))
+		return
+	}
+
+	clientID := r.FormValue("client_id")
+	idTokenHint := r.FormValue("id_token_hint")
+	//Without an id_token_hint, only the logout form of the itsyou.online website that posts the csrf token can end the session,
+	// otherwise any site could log out the user by linking to this endpoint
+	if idTokenHint == "" && !service.sessionService.ValidCSRFToken(r, r.PostForm.Get("csrf_token")) {
+		showAuthorizeError(w, errInvalidRequest("Missing id_token_hint"))
+		return
+	}
+	if idTokenHint != "" {
+		hintClientID, err := clientIDFromIDTokenHint(idTokenHint, service.keyRing.Keyfunc, issuer())
+		if err != nil || (clientID != "" && clientID != hintClientID) {
+			showAuthorizeError(w, errInvalidRequest("Invalid id_token_hint"))
+			return
+		}
+		clientID = hintClientID
+	}
+
+	redirectURL := "/"
+	if postLogoutRedirectURI := r.FormValue("post_logout_redirect_uri"); postLogoutRedirectURI != "" {
+		valid, err := validatePostLogoutRedirectURI(NewManager(r), clientID, postLogoutRedirectURI)
+		if err != nil {
+			log.Error(err)
+			showAuthorizeError(w, errServerError())
+			return
+		}
+		if !valid {
+			showAuthorizeError(w, errInvalidRequest("Invalid client_id or post_logout_redirect_uri"))
+			return
+		}
+		redirectURL = postLogoutRedirectURL(postLogoutRedirectURI, r.FormValue("state"))
+	}
+
+	sessionID, err := service.sessionService.GetSessionID(r)
+	if err != nil {
+		showAuthorizeError(w, errServerError())
+		return
+	}
+	var frontChannelLogoutURLs []string
+	if sessionID != "" {
+		if frontChannelLogoutURLs, err = service.notifyLogout(r, sessionID); err != nil {
+			log.Error("Error notifying the clients of the logout: ", err)
+		}
+	}
+	if err = service.sessionService.ClearLoggedInUser(w, r); err != nil {
+		showAuthorizeError(w, errServerError())
+		return
+	}
+	if len(frontChannelLogoutURLs) > 0 {
+		showFrontChannelLogoutPage(w, frontChannelLogoutURLs, redirectURL)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
@@ -0,0 +1,129 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLogoutURIs(t *testing.T) {
+	assert.NoError(t, ValidateLogoutURIs("", "", nil))
+	assert.NoError(t, ValidateLogoutURIs("https://petshop.com/logout", "https://petshop.com/frontchannel", []string{"https://petshop.com", "https://petshop.com/loggedout?lang=en"}))
+	assert.Error(t, ValidateLogoutURIs("/logout", "", nil))
+	assert.Error(t, ValidateLogoutURIs("", "", []string{"https://petshop.com#loggedout"}))
+	assert.Error(t, ValidateLogoutURIs("http://petshop.com/logout", "", nil))
+	assert.Error(t, ValidateLogoutURIs("", "http://petshop.com/frontchannel", nil))
+	assert.Error(t, ValidateLogoutURIs("", "https://petshop.com/frontchannel#logout", nil))
+}
+
+func TestIsValidBackChannelLogoutURI(t *testing.T) {
+	assert.True(t, isValidBackChannelLogoutURI("https://petshop.com/logout"))
+	assert.True(t, isValidBackChannelLogoutURI("https://8.8.8.8:8443/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("http://petshop.com/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://localhost/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://127.0.0.1/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://10.1.2.3/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://192.168.1.1/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://169.254.169.254/latest/meta-data"))
+	assert.False(t, isValidBackChannelLogoutURI("https://[::1]/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://[fd00::1]/logout"))
+	assert.False(t, isValidBackChannelLogoutURI("https://user@petshop.com/logout"))
+}
+
+func TestFrontChannelLogoutURL(t *testing.T) {
+	assert.Equal(t, "https://petshop.com/logout?iss=https%3A%2F%2Fitsyou.online&sid=session1", frontChannelLogoutURL("https://petshop.com/logout", "https://itsyou.online", "session1"))
+	assert.Equal(t, "https://petshop.com/logout?iss=https%3A%2F%2Fitsyou.online&lang=en&sid=session1", frontChannelLogoutURL("https://petshop.com/logout?lang=en", "https://itsyou.online", "session1"))
+}
+
+func TestShowFrontChannelLogoutPage(t *testing.T) {
+	w := httptest.NewRecorder()
+	showFrontChannelLogoutPage(w, []string{"https://petshop.com/logout?iss=https%3A%2F%2Fitsyou.online&sid=session1"}, "https://petshop.com/loggedout?state=a\"b")
+	body := w.Body.String()
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Contains(t, body, `<iframe src="https://petshop.com/logout?iss=https%3A%2F%2Fitsyou.online&amp;sid=session1"`)
+	assert.Contains(t, body, "var remaining =  1 ;")
+	//The redirect url is escaped as a javascript string
+	assert.Contains(t, body, `window.location.replace("https://petshop.com/loggedout?state=a\"b")`)
+	assert.Contains(t, body, `<a href="https://petshop.com/loggedout?state=a%22b">`)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	assert.True(t, isPublicIP(net.ParseIP("8.8.8.8")))
+	assert.True(t, isPublicIP(net.ParseIP("2001:4860:4860::8888")))
+	assert.False(t, isPublicIP(net.ParseIP("172.16.0.1")))
+	assert.False(t, isPublicIP(net.ParseIP("::ffff:10.0.0.1")))
+	assert.False(t, isPublicIP(net.ParseIP("0.0.0.0")))
+	assert.False(t, isPublicIP(net.ParseIP("224.0.0.1")))
+}
+
+func TestValidatePostLogoutRedirectURI(t *testing.T) {
+	mgr := &testClientManager{clients: []*Oauth2Client{
+		{ClientID: "petshop", Label: "web"},
+		{ClientID: "petshop", Label: "mobile", PostLogoutRedirectURIs: []string{"https://petshop.com/loggedout"}},
+	}}
+	valid, err := validatePostLogoutRedirectURI(mgr, "petshop", "https://petshop.com/loggedout")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, _ = validatePostLogoutRedirectURI(mgr, "petshop", "https://petshop.com/loggedout?x=1")
+	assert.False(t, valid)
+	//Without a client, a post logout redirect uri can not be validated
+	valid, _ = validatePostLogoutRedirectURI(mgr, "", "https://petshop.com/loggedout")
+	assert.False(t, valid)
+}
+
+func TestPostLogoutRedirectURL(t *testing.T) {
+	assert.Equal(t, "https://petshop.com/loggedout", postLogoutRedirectURL("https://petshop.com/loggedout", ""))
+	assert.Equal(t, "https://petshop.com/loggedout?state=abc", postLogoutRedirectURL("https://petshop.com/loggedout", "abc"))
+	assert.Equal(t, "https://petshop.com/loggedout?lang=en&state=a+b", postLogoutRedirectURL("https://petshop.com/loggedout?lang=en", "a b"))
+}
+
+func TestClientIDFromIDTokenHint(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	jwk, _ := NewECJSONWebKey(&key.PublicKey)
+	service := &Service{keyRing: newTestKeyRing(jwk.Kid, key)}
+	sign := func(claims map[string]interface{}) string {
+		token := jwt.New(jwt.SigningMethodES384)
+		token.Claims = claims
+		tokenString, err := service.signJWT(token)
+		assert.NoError(t, err)
+		return tokenString
+	}
+
+	//Expired id tokens are accepted as hint
+	idTokenHint := sign(map[string]interface{}{"iss": "https://itsyou.online", "aud": "petshop", "exp": time.Now().Add(-time.Hour).Unix()})
+	clientID, err := clientIDFromIDTokenHint(idTokenHint, service.keyRing.Keyfunc, "https://itsyou.online")
+	assert.NoError(t, err)
+	assert.Equal(t, "petshop", clientID)
+
+	_, err = clientIDFromIDTokenHint(idTokenHint, service.keyRing.Keyfunc, "https://example.com")
+	assert.Error(t, err)
+
+	idTokenHint = sign(map[string]interface{}{"iss": "https://itsyou.online", "exp": time.Now().Add(time.Hour).Unix()})
+	_, err = clientIDFromIDTokenHint(idTokenHint, service.keyRing.Keyfunc, "https://itsyou.online")
+	assert.Error(t, err)
+
+	_, err = clientIDFromIDTokenHint("not.a.token", service.keyRing.Keyfunc, "https://itsyou.online")
+	assert.Error(t, err)
+}
+
+func TestNewLogoutToken(t *testing.T) {
+	PublicURL = "https://itsyou.online"
+	token := newLogoutToken("petshop", "bob", "sid1")
+	assert.Equal(t, "logout+jwt", token.Header["typ"])
+	assert.Equal(t, "https://itsyou.online", token.Claims["iss"])
+	assert.Equal(t, "bob", token.Claims["sub"])
+	assert.Equal(t, "petshop", token.Claims["aud"])
+	assert.Equal(t, "sid1", token.Claims["sid"])
+	assert.NotEmpty(t, token.Claims["jti"])
+	assert.Nil(t, token.Claims["nonce"])
+	events, _ := token.Claims["events"].(map[string]interface{})
+	assert.Contains(t, events, BackChannelLogoutEvent)
+}
@@ -0,0 +1,197 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+	userdb "github.com/itsyouonline/identityserver/db/user"
+	validationdb "github.com/itsyouonline/identityserver/db/validation"
+)
+
+//OpenIDScope is the scope a client requests to receive an id_token and get access to the userinfo endpoint
+const OpenIDScope = "openid"
+
+//PublicURL is the url users and clients reach this itsyou.online instance on, without a trailing slash.
+// It is configured instead of taken from the Host header of a request so a forged request can not change
+// the issuer identifier or the links that are sent to users.
+var PublicURL = "https://dev.itsyou.online:8443"
+
+//issuer returns the OpenID Connect issuer identifier for this itsyou.online instance
+func issuer() string {
+	return PublicURL
+}
+
+//splitScopes splits a scope parameter in separate scopes.
+// Itsyou.online uses commas as delimiter while standard oauth2 libraries use spaces, both are accepted.
+func splitScopes(scope string) (scopes []string) {
+	return strings.FieldsFunc(scope, func(c rune) bool {
+		return c == ',' || c == ' '
+	})
+}
+
+//scopeStringContains checks if a comma separated scope string contains a specific scope
+func scopeStringContains(scopeString, scope string) bool {
+	for _, s := range splitScopes(scopeString) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+//createIDToken creates a signed OpenID Connect id_token for the user the access token was issued for
+// The sid claim allows the client to match the logout tokens it receives with the session of the user.
+func (service *Service) createIDToken(at *AccessToken, nonce string, authTime time.Time, sessionID string) (tokenString string, err error) {
+	token := jwt.New(jwt.SigningMethodES384)
+	token.Claims["iss"] = issuer()
+	token.Claims["sub"] = at.Username
+	token.Claims["aud"] = at.ClientID
+	token.Claims["exp"] = at.ExpirationTime().Unix()
+	token.Claims["iat"] = time.Now().Unix()
+	if !authTime.IsZero() {
+		token.Claims["auth_time"] = authTime.Unix()
+	}
+	if nonce != "" {
+		token.Claims["nonce"] = nonce
+	}
+	if sessionID != "" {
+		token.Claims["sid"] = sessionID
+	}
+	tokenString, err = service.signJWT(token)
+	return
+}
+
+//accessTokenFromRequest gets the access token from the Authorization header ('bearer ABCD' or 'token ABCD')
+// or from the access_token form parameter
+func accessTokenFromRequest(r *http.Request) (token string) {
+	token = r.Header.Get("Authorization")
+	if token == "" {
+		token = r.FormValue("access_token")
+		return
+	}
+	if fields := strings.Fields(token); len(fields) == 2 && (strings.EqualFold(fields[0], "bearer") || strings.EqualFold(fields[0], "token")) {
+		token = fields[1]
+	}
+	return
+}
+
+//preferredLabel returns the "main" label if present, otherwise the first label in alphabetical order
+func preferredLabel(labels []string) string {
+	sort.Strings(labels)
+	for _, label := range labels {
+		if label == "main" {
+			return label
+		}
+	}
+	return labels[0]
+}
+
+//userInfoClaims creates the userinfo response for an already filtered user and the labels of its email addresses that are validated.
+// Next to the standard OpenID Connect claims, the labelled properties are added as they are returned by the user info api.
+func userInfoClaims(u *userdb.User, validatedEmail map[string]string) (claims map[string]interface{}) {
+	claims = map[string]interface{}{
+		"sub":                u.Username,
+		"preferred_username": u.Username,
+	}
+	if u.Firstname != "" || u.Lastname != "" {
+		claims["given_name"] = u.Firstname
+		claims["family_name"] = u.Lastname
+		claims["name"] = strings.TrimSpace(u.Firstname + " " + u.Lastname)
+	}
+	if len(u.Email) > 0 {
+		labels := make([]string, 0, len(u.Email))
+		for label := range u.Email {
+			labels = append(labels, label)
+		}
+		label := preferredLabel(labels)
+		claims["email"] = u.Email[label]
+		claims["email_verified"] = validatedEmail[label] == u.Email[label]
+		claims["emailaddresses"] = u.Email
+		claims["validatedemailaddresses"] = validatedEmail
+	}
+	if len(u.Phone) > 0 {
+		labels := make([]string, 0, len(u.Phone))
+		for label := range u.Phone {
+			labels = append(labels, label)
+		}
+		claims["phone_number"] = u.Phone[preferredLabel(labels)]
+		claims["phonenumbers"] = u.Phone
+	}
+	if len(u.Address) > 0 {
+		claims["addresses"] = u.Address
+	}
+	if len(u.Bank) > 0 {
+		claims["bankaccounts"] = u.Bank
+	}
+	if u.Github.Name != "" {
+		claims["github"] = u.Github.Name
+	}
+	if u.Facebook.Name != "" {
+		claims["facebook"] = u.Facebook.Name
+	}
+	return
+}
+
+//UserInfoHandler is the handler of the OpenID Connect /v1/oauth/userinfo endpoint
+func (service *Service) UserInfoHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("Error parsing form: ", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	accessToken := accessTokenFromRequest(r)
+	if accessToken == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="itsyou.online"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	at, err := NewManager(r).GetAccessToken(accessToken)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if at == nil || at.Username == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="itsyou.online", error="invalid_token"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if !scopeStringContains(at.Scope, OpenIDScope) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="itsyou.online", error="insufficient_scope", scope="openid"`)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	userMgr := userdb.NewManager(r)
+	u, err := userMgr.GetByName(at.Username)
+	if err != nil {
+		log.Error("Error getting user ", at.Username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	authorization, err := userMgr.GetAuthorization(at.Username, at.ClientID)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	filteredUser := authorization.FilterUser(u)
+	validatedEmail, err := validationdb.NewManager(r).GetValidatedEmailAddresses(filteredUser)
+	if err != nil {
+		log.Error("Error getting the validated email addresses of ", at.Username, ": ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(userInfoClaims(filteredUser, validatedEmail))
+}
@@ -0,0 +1,85 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	userdb "github.com/itsyouonline/identityserver/db/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitScopes(t *testing.T) {
+	assert.Equal(t, []string{"openid", "user:name"}, splitScopes("openid,user:name"))
+	assert.Equal(t, []string{"openid", "user:name"}, splitScopes("openid user:name"))
+	assert.Equal(t, []string{"openid", "user:name", "user:email"}, splitScopes(" openid, user:name user:email,"))
+	assert.Empty(t, splitScopes(""))
+
+	assert.True(t, scopeStringContains("user:name,openid", OpenIDScope))
+	assert.False(t, scopeStringContains("user:name,openidx", OpenIDScope))
+}
+
+func TestAccessTokenFromRequest(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/v1/oauth/userinfo?access_token=querytoken", nil)
+	assert.Equal(t, "querytoken", accessTokenFromRequest(r))
+
+	r.Header.Set("Authorization", "Bearer headertoken")
+	assert.Equal(t, "headertoken", accessTokenFromRequest(r))
+
+	r.Header.Set("Authorization", "token headertoken")
+	assert.Equal(t, "headertoken", accessTokenFromRequest(r))
+}
+
+func TestUserInfoClaims(t *testing.T) {
+	claims := userInfoClaims(&userdb.User{Username: "bob"}, nil)
+	assert.Equal(t, "bob", claims["sub"])
+	assert.Nil(t, claims["name"])
+	assert.Nil(t, claims["email"])
+
+	claims = userInfoClaims(&userdb.User{
+		Username:  "bob",
+		Firstname: "Bob",
+		Lastname:  "Builder",
+		Email:     map[string]string{"work": "bob@work.com", "main": "bob@home.com"},
+		Phone:     map[string]userdb.Phonenumber{"mobile": "+3212345678"},
+	}, map[string]string{"work": "bob@work.com"})
+	assert.Equal(t, "Bob Builder", claims["name"])
+	assert.Equal(t, "Bob", claims["given_name"])
+	assert.Equal(t, "Builder", claims["family_name"])
+	assert.Equal(t, "bob@home.com", claims["email"])
+	assert.Equal(t, false, claims["email_verified"])
+	assert.Equal(t, map[string]string{"work": "bob@work.com"}, claims["validatedemailaddresses"])
+	assert.Equal(t, userdb.Phonenumber("+3212345678"), claims["phone_number"])
+
+	claims = userInfoClaims(&userdb.User{Username: "bob", Email: map[string]string{"work": "bob@work.com"}}, map[string]string{"work": "bob@work.com"})
+	assert.Equal(t, true, claims["email_verified"])
+}
+
+func TestCreateIDToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	jwk, _ := NewECJSONWebKey(&key.PublicKey)
+	service := &Service{keyRing: newTestKeyRing(jwk.Kid, key)}
+
+	PublicURL = "https://itsyou.online"
+	at := newAccessToken("bob", "", "petshop", "openid")
+	authTime := time.Now().Add(-time.Minute)
+
+	tokenString, err := service.createIDToken(at, "n-0S6_WzA2Mj", authTime, "sid1")
+	assert.NoError(t, err)
+
+	token, err := jwt.Parse(tokenString, service.keyRing.Keyfunc)
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, jwk.Kid, token.Header["kid"])
+	assert.Equal(t, "https://itsyou.online", token.Claims["iss"])
+	assert.Equal(t, "bob", token.Claims["sub"])
+	assert.Equal(t, "petshop", token.Claims["aud"])
+	assert.Equal(t, "n-0S6_WzA2Mj", token.Claims["nonce"])
+	assert.Equal(t, float64(authTime.Unix()), token.Claims["auth_time"])
+	assert.Equal(t, "sid1", token.Claims["sid"])
+}
@@ -0,0 +1,91 @@
+package oauthservice
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+)
+
+const (
+	//CodeChallengeMethodPlain is the PKCE code_challenge_method where the code_challenge equals the code_verifier
+	CodeChallengeMethodPlain = "plain"
+	//CodeChallengeMethodS256 is the PKCE code_challenge_method where the code_challenge is the base64url encoded SHA256 hash of the code_verifier
+	CodeChallengeMethodS256 = "S256"
+)
+
+//isValidPKCEString checks if a code_verifier or code_challenge has the length and characters allowed by RFC 7636
+func isValidPKCEString(s string) bool {
+	if len(s) < 43 || len(s) > 128 {
+		return false
+	}
+	for _, c := range s {
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.ContainsRune("-._~", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+//validCodeChallenge checks if a code_challenge and code_challenge_method received on the authorize endpoint are acceptable
+func validCodeChallenge(codeChallenge, codeChallengeMethod string) bool {
+	if codeChallengeMethod != CodeChallengeMethodPlain && codeChallengeMethod != CodeChallengeMethodS256 {
+		return false
+	}
+	return isValidPKCEString(codeChallenge)
+}
+
+//verifyCodeVerifier checks if a code_verifier received on the token endpoint matches the code_challenge of the authorization request
+func verifyCodeVerifier(codeChallenge, codeChallengeMethod, codeVerifier string) bool {
+	if !isValidPKCEString(codeVerifier) {
+		return false
+	}
+	var computedChallenge string
+	switch codeChallengeMethod {
+	case CodeChallengeMethodPlain:
+		computedChallenge = codeVerifier
+	case CodeChallengeMethodS256:
+		hash := sha256.Sum256([]byte(codeVerifier))
+		computedChallenge = base64.RawURLEncoding.EncodeToString(hash[:])
+	default:
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(computedChallenge), []byte(codeChallenge)) == 1
+}
+
+//getPublicClient returns a public client with the given clientID that has the redirectURI registered.
+// If the redirectURI is empty, any public client with the given clientID is returned.
+// If no such client exists, nil is returned.
+func getPublicClient(mgr ClientManager, clientID, redirectURI string) (client *Oauth2Client, err error) {
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, c := range clients {
+		if c.Public && (redirectURI == "" || c.MatchesRedirectURI(redirectURI)) {
+			client = c
+			return
+		}
+	}
+	return
+}
+
+//getIssuedPublicClient returns the client with the given label if it is a public client, if not, nil is returned.
+// Grants issued to a confidential client can not be redeemed without client authentication,
+// not even when the organization also has a public client.
+func getIssuedPublicClient(mgr ClientManager, clientID, label string) (client *Oauth2Client, err error) {
+	if label == "" {
+		return
+	}
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, c := range clients {
+		if c.Label == label && c.Public {
+			client = c
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,78 @@
+package oauthservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidCodeChallenge(t *testing.T) {
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	assert.True(t, validCodeChallenge(challenge, CodeChallengeMethodS256))
+	assert.True(t, validCodeChallenge(challenge, CodeChallengeMethodPlain))
+	assert.False(t, validCodeChallenge(challenge, "S512"))
+	assert.False(t, validCodeChallenge(challenge, ""))
+	assert.False(t, validCodeChallenge("tooshort", CodeChallengeMethodS256))
+	assert.False(t, validCodeChallenge(strings.Repeat("a", 129), CodeChallengeMethodPlain))
+	assert.False(t, validCodeChallenge(strings.Repeat("a", 42)+"=", CodeChallengeMethodPlain))
+}
+
+func TestVerifyCodeVerifier(t *testing.T) {
+	//Example from RFC 7636 appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	assert.True(t, verifyCodeVerifier(challenge, CodeChallengeMethodS256, verifier))
+	assert.False(t, verifyCodeVerifier(challenge, CodeChallengeMethodPlain, verifier))
+	assert.False(t, verifyCodeVerifier(challenge, CodeChallengeMethodS256, verifier+"a"))
+	assert.False(t, verifyCodeVerifier(challenge, CodeChallengeMethodS256, ""))
+
+	assert.True(t, verifyCodeVerifier(verifier, CodeChallengeMethodPlain, verifier))
+	assert.False(t, verifyCodeVerifier(verifier, "", verifier))
+}
+
+func TestGetPublicClient(t *testing.T) {
+	mgr := &testClientManager{
+		clients: []*Oauth2Client{
+			&Oauth2Client{Label: "confidential", CallbackURL: "https://www.url.com/callback"},
+			&Oauth2Client{Label: "public", CallbackURL: "https://app.url.com/callback", Public: true},
+		},
+	}
+
+	client, err := getPublicClient(mgr, "clientID", "https://www.url.com/callback")
+	assert.NoError(t, err)
+	assert.Nil(t, client)
+
+	client, err = getPublicClient(mgr, "clientID", "https://app.url.com/callback")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, "public", client.Label)
+
+	client, err = getPublicClient(mgr, "clientID", "")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestGetIssuedPublicClient(t *testing.T) {
+	mgr := &testClientManager{
+		clients: []*Oauth2Client{
+			&Oauth2Client{Label: "confidential", CallbackURL: "https://www.url.com/callback"},
+			&Oauth2Client{Label: "public", CallbackURL: "https://app.url.com/callback", Public: true},
+		},
+	}
+
+	client, err := getIssuedPublicClient(mgr, "clientID", "public")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	//A grant issued to a confidential client can not be redeemed without authentication because the organization has a public client
+	client, err = getIssuedPublicClient(mgr, "clientID", "confidential")
+	assert.NoError(t, err)
+	assert.Nil(t, client)
+
+	//Grants issued before the label was recorded
+	client, err = getIssuedPublicClient(mgr, "clientID", "")
+	assert.NoError(t, err)
+	assert.Nil(t, client)
+}
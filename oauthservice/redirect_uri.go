@@ -0,0 +1,77 @@
+package oauthservice
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+//RedirectURI is a redirect uri registered for a client.
+// Redirect uris are compared exactly, only the port of a loopback redirect uri can be allowed to differ.
+type RedirectURI struct {
+	URI string `json:"uri"`
+	//AnyPort allows native apps to listen on any free port of the loopback interface (RFC 8252 section 7.3)
+	AnyPort bool `json:"anyPort,omitempty"`
+}
+
+//ErrInvalidRedirectURI is returned when an invalid redirect uri is registered for a client
+var ErrInvalidRedirectURI = errors.New("Invalid redirect uri")
+
+//isLoopbackHost checks if a hostname is the loopback interface
+func isLoopbackHost(hostname string) bool {
+	if strings.ToLower(hostname) == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(hostname)
+	return ip != nil && ip.IsLoopback()
+}
+
+//Validate checks if the redirect uri can be registered for a client
+func (r RedirectURI) Validate() error {
+	u, err := url.Parse(r.URI)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.Fragment != "" || len(r.URI) > 250 {
+		return ErrInvalidRedirectURI
+	}
+	if r.AnyPort && (u.Scheme != "http" || !isLoopbackHost(hostname(u))) {
+		return ErrInvalidRedirectURI
+	}
+	return nil
+}
+
+//Matches checks if a redirect uri received in a request matches this registered redirect uri
+func (r RedirectURI) Matches(redirectURI string) bool {
+	if redirectURI == "" {
+		return false
+	}
+	if redirectURI == r.URI {
+		return true
+	}
+	if !r.AnyPort {
+		return false
+	}
+	registered, err := url.Parse(r.URI)
+	if err != nil {
+		return false
+	}
+	requested, err := url.Parse(redirectURI)
+	if err != nil {
+		return false
+	}
+	return requested.Scheme == registered.Scheme &&
+		isLoopbackHost(hostname(requested)) &&
+		hostname(requested) == hostname(registered) &&
+		requested.User == nil &&
+		requested.Path == registered.Path &&
+		requested.RawQuery == registered.RawQuery &&
+		requested.Fragment == ""
+}
+
+//hostname returns the host of a url without the port
+func hostname(u *url.URL) string {
+	host, _, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		host = u.Host
+	}
+	return strings.Trim(host, "[]")
+}
@@ -0,0 +1,80 @@
+package oauthservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedirectURIValidate(t *testing.T) {
+	type testcase struct {
+		redirectURI RedirectURI
+		valid       bool
+	}
+	testcases := []testcase{
+		{redirectURI: RedirectURI{URI: "https://app.example.com/callback"}, valid: true},
+		{redirectURI: RedirectURI{URI: "com.example.app:/callback"}, valid: false},
+		{redirectURI: RedirectURI{URI: "/callback"}, valid: false},
+		{redirectURI: RedirectURI{URI: "https://app.example.com/callback#fragment"}, valid: false},
+		{redirectURI: RedirectURI{URI: "https://app.example.com/callback", AnyPort: true}, valid: false},
+		{redirectURI: RedirectURI{URI: "http://127.0.0.1/callback", AnyPort: true}, valid: true},
+		{redirectURI: RedirectURI{URI: "http://[::1]/callback", AnyPort: true}, valid: true},
+		{redirectURI: RedirectURI{URI: "http://localhost/callback", AnyPort: true}, valid: true},
+	}
+	for _, test := range testcases {
+		err := test.redirectURI.Validate()
+		assert.Equal(t, test.valid, err == nil, test.redirectURI.URI)
+	}
+}
+
+func TestRedirectURIMatches(t *testing.T) {
+	exact := RedirectURI{URI: "https://app.example.com/callback"}
+	assert.True(t, exact.Matches("https://app.example.com/callback"))
+	assert.False(t, exact.Matches("https://app.example.com/callback/subpath"))
+	assert.False(t, exact.Matches("https://app.example.com/callback?a=b"))
+	assert.False(t, exact.Matches("https://app.example.com.evil.io/callback"))
+	assert.False(t, exact.Matches("https://app.example.com:8443/callback"))
+	assert.False(t, exact.Matches(""))
+
+	loopback := RedirectURI{URI: "http://127.0.0.1/callback", AnyPort: true}
+	assert.True(t, loopback.Matches("http://127.0.0.1/callback"))
+	assert.True(t, loopback.Matches("http://127.0.0.1:51234/callback"))
+	assert.False(t, loopback.Matches("http://127.0.0.1:51234/other"))
+	assert.False(t, loopback.Matches("http://localhost:51234/callback"))
+	assert.False(t, loopback.Matches("https://127.0.0.1:51234/callback"))
+	assert.False(t, loopback.Matches("http://user@127.0.0.1:51234/callback"))
+
+	ipv6 := RedirectURI{URI: "http://[::1]/callback", AnyPort: true}
+	assert.True(t, ipv6.Matches("http://[::1]:51234/callback"))
+}
+
+func TestClientMatchesRedirectURI(t *testing.T) {
+	c := &Oauth2Client{
+		CallbackURL:  "https://app.example.com/callback",
+		RedirectURIs: []RedirectURI{RedirectURI{URI: "https://app.example.com/other"}},
+	}
+	assert.True(t, c.MatchesRedirectURI("https://app.example.com/callback"))
+	assert.True(t, c.MatchesRedirectURI("https://app.example.com/other"))
+	assert.False(t, c.MatchesRedirectURI("https://app.example.com/"))
+
+	c = &Oauth2Client{}
+	assert.False(t, c.MatchesRedirectURI(""))
+}
+
+func TestLegacyCallbackURLPrefix(t *testing.T) {
+	c := &Oauth2Client{CallbackURL: "https://app.example.com/callback"}
+	assert.False(t, c.MatchesRedirectURI("https://app.example.com/callback/sub"))
+
+	//Legacy clients are only matched as a prefix if the legacy matching is enabled
+	c.LegacyCallbackURLPrefix = true
+	assert.False(t, c.MatchesRedirectURI("https://app.example.com/callback/sub"))
+
+	LegacyCallbackURLPrefixMatching = true
+	defer func() { LegacyCallbackURLPrefixMatching = false }()
+	assert.True(t, c.MatchesRedirectURI("https://app.example.com/callback"))
+	assert.True(t, c.MatchesRedirectURI("https://app.example.com/callback/sub?x=1"))
+	assert.False(t, c.MatchesRedirectURI("https://evil.example.com/callback"))
+
+	c.CallbackURL = ""
+	assert.False(t, c.MatchesRedirectURI("https://app.example.com/callback"))
+}
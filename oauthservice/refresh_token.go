@@ -0,0 +1,170 @@
+package oauthservice
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//RefreshTokenGrantType is the requested grant_type for exchanging a refresh token for a new access token
+const RefreshTokenGrantType = "refresh_token"
+
+//RefreshTokenExpiration is the time a refresh token remains valid after it is issued
+var RefreshTokenExpiration = time.Hour * 24 * 30 //Refresh tokens expire after 30 days
+
+//RefreshToken is an oauth2 refresh token.
+// Refresh tokens are rotated: every time one is exchanged for a new access token, it is marked as rotated
+// and a new refresh token of the same family is issued. Presenting a rotated refresh token again means it
+// has leaked, in that case the entire family is revoked.
+type RefreshToken struct {
+	RefreshToken string
+	FamilyID     string //All refresh tokens (and access tokens) descending from the same authorization share the same FamilyID
+	Username     string
+	GlobalID     string
+	Scope        string
+	ClientID     string
+	ClientLabel  string //The label of the client the token was issued to, it can only be used without client authentication if that client is public
+	Rotated      bool
+	CreatedAt    time.Time
+}
+
+//IsExpiredAt checks if the refresh token is expired at a specific time
+func (rt *RefreshToken) IsExpiredAt(testtime time.Time) bool {
+	return testtime.After(rt.ExpirationTime())
+}
+
+//IsExpired is a convenience method for IsExpiredAt(time.Now())
+func (rt *RefreshToken) IsExpired() bool {
+	return rt.IsExpiredAt(time.Now())
+}
+
+//ExpirationTime return the time at which this refresh token expires
+func (rt *RefreshToken) ExpirationTime() time.Time {
+	return rt.CreatedAt.Add(RefreshTokenExpiration)
+}
+
+func newRandomToken() string {
+	randombytes := make([]byte, 21) //Multiple of 3 to make sure no padding is added
+	rand.Read(randombytes)
+	return base64.URLEncoding.EncodeToString(randombytes)
+}
+
+//newRefreshToken creates a new refresh token, if familyID is empty, a new token family is started
+func newRefreshToken(username, globalID, clientID, scope, familyID string) *RefreshToken {
+	var rt RefreshToken
+
+	rt.RefreshToken = newRandomToken()
+	if familyID == "" {
+		familyID = newRandomToken()
+	}
+	rt.FamilyID = familyID
+	rt.CreatedAt = time.Now()
+	rt.Username = username
+	rt.GlobalID = globalID
+	rt.ClientID = clientID
+	rt.Scope = scope
+
+	return &rt
+}
+
+//newSuccessor creates the refresh token that replaces this one when it is rotated
+func (rt *RefreshToken) newSuccessor() *RefreshToken {
+	successor := newRefreshToken(rt.Username, rt.GlobalID, rt.ClientID, rt.Scope, rt.FamilyID)
+	successor.ClientLabel = rt.ClientLabel
+	return successor
+}
+
+func refreshTokenHandler(refreshToken string, clientID string, secret string, r *http.Request) (at *AccessToken, rt *RefreshToken, oauthErr *OAuthError) {
+
+	mgr := NewManager(r)
+	issued, err := mgr.getRefreshToken(clientID, refreshToken)
+	if err != nil {
+		log.Error("Error getting the refresh token: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if issued == nil {
+		log.Debug("Unknown refresh token or it was not issued to this client")
+		oauthErr = errInvalidGrant("Invalid refresh token")
+		return
+	}
+
+	var client *Oauth2Client
+	if hasClientAuthentication(r, secret) {
+		client, err = authenticateConfidentialClient(mgr, r, clientID, secret)
+	} else {
+		//Public clients can not authenticate, the rotation and reuse detection of the refresh tokens protects them.
+		// Refresh tokens issued to a confidential client always require client authentication.
+		client, err = getIssuedPublicClient(mgr, clientID, issued.ClientLabel)
+	}
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if client == nil {
+		oauthErr = errInvalidClient("")
+		return
+	}
+	//All clients of an organization share the client_id, only the client the refresh token was issued to can use it
+	if !client.IsIssuedClient(issued.ClientLabel) {
+		log.Debug("The refresh token was issued to another client of the organization")
+		oauthErr = errInvalidGrant("Invalid refresh token")
+		return
+	}
+	if !client.AllowsGrantType(RefreshTokenGrantType) {
+		oauthErr = errUnauthorizedClient("The client is not registered for the refresh_token grant type")
+		return
+	}
+	//An expired refresh token is not rotated, a client that retries with it should not be mistaken for a replay that revokes the token family
+	if issued.IsExpired() {
+		log.Debug("Expired refresh token")
+		oauthErr = errInvalidGrant("Expired refresh token")
+		return
+	}
+
+	oldRefreshToken, err := mgr.rotateRefreshToken(clientID, refreshToken)
+	if err != nil {
+		log.Error("Error rotating the refresh token: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if oldRefreshToken == nil {
+		log.Debug("Unknown refresh token or it was not issued to this client")
+		oauthErr = errInvalidGrant("Invalid refresh token")
+		return
+	}
+	if oldRefreshToken.Rotated {
+		log.Warn("Reuse of a rotated refresh token detected, revoking the token family of client ", clientID, " for user ", oldRefreshToken.Username)
+		if err = mgr.revokeTokenFamily(oldRefreshToken.FamilyID); err != nil {
+			log.Error("Error revoking the refresh token family: ", err)
+			oauthErr = errServerError()
+			return
+		}
+		oauthErr = errInvalidGrant("Invalid refresh token")
+		return
+	}
+	if oldRefreshToken.IsExpired() {
+		log.Debug("Expired refresh token")
+		oauthErr = errInvalidGrant("Expired refresh token")
+		return
+	}
+
+	rt = oldRefreshToken.newSuccessor()
+	if err = mgr.saveRefreshToken(rt); err != nil {
+		log.Error("Error saving the refresh token: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	at = newAccessToken(rt.Username, rt.GlobalID, rt.ClientID, rt.Scope)
+	at.ClientLabel = rt.ClientLabel
+	at.FamilyID = rt.FamilyID
+	if err = mgr.saveAccessToken(at); err != nil {
+		log.Error("Error saving the access token: ", err)
+		oauthErr = errServerError()
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package oauthservice
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshTokenExpiration(t *testing.T) {
+	rt := &RefreshToken{CreatedAt: time.Now()}
+
+	assert.True(t, rt.IsExpiredAt(rt.CreatedAt.Add(RefreshTokenExpiration).Add(time.Second)))
+	assert.False(t, rt.IsExpiredAt(rt.CreatedAt.Add(RefreshTokenExpiration)))
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	rt := newRefreshToken("user1", "globalid1", "client1", "scope", "")
+	assert.NotEmpty(t, rt.RefreshToken)
+	assert.False(t, strings.HasSuffix(rt.RefreshToken, "="))
+	assert.NotEmpty(t, rt.FamilyID)
+	assert.NotEqual(t, rt.RefreshToken, rt.FamilyID)
+	assert.False(t, rt.Rotated)
+	assert.Equal(t, "user1", rt.Username)
+	assert.Equal(t, "client1", rt.ClientID)
+	assert.Equal(t, "globalid1", rt.GlobalID)
+	assert.Equal(t, "scope", rt.Scope)
+
+	rt = newRefreshToken("user1", "globalid1", "client1", "scope", "family1")
+	assert.Equal(t, "family1", rt.FamilyID)
+}
+
+func TestRefreshTokenSuccessor(t *testing.T) {
+	rt := newRefreshToken("user1", "", "client1", "scope", "")
+	rt.ClientLabel = "app"
+	rt.Rotated = true
+	successor := rt.newSuccessor()
+
+	assert.NotEqual(t, rt.RefreshToken, successor.RefreshToken)
+	assert.Equal(t, rt.FamilyID, successor.FamilyID)
+	assert.Equal(t, rt.Username, successor.Username)
+	assert.Equal(t, rt.ClientID, successor.ClientID)
+	assert.Equal(t, rt.Scope, successor.Scope)
+	assert.Equal(t, "app", successor.ClientLabel)
+	assert.False(t, successor.Rotated)
+}
@@ -0,0 +1,441 @@
+package oauthservice
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gorilla/mux"
+
+	"github.com/itsyouonline/identityserver/db"
+)
+
+//Token endpoint authentication methods a client can register with
+const (
+	TokenEndpointAuthMethodClientSecretBasic = "client_secret_basic"
+	TokenEndpointAuthMethodClientSecretPost  = "client_secret_post"
+	TokenEndpointAuthMethodNone              = "none"
+)
+
+//Error codes of the dynamic client registration endpoints as defined in RFC 7591 section 3.2.2
+const (
+	ErrorCodeInvalidRedirectURI    = "invalid_redirect_uri"
+	ErrorCodeInvalidClientMetadata = "invalid_client_metadata"
+	ErrorCodeInvalidToken          = "invalid_token"
+)
+
+//registrationScope is the scope an initial access token needs to register clients for an organization
+const registrationScope = "organization:owner"
+
+//clientMetadata are the client properties a client registers with (RFC 7591 section 2)
+type clientMetadata struct {
+	ClientName              string               `json:"client_name,omitempty"`
+	RedirectURIs            []string             `json:"redirect_uris,omitempty"`
+	GrantTypes              []string             `json:"grant_types,omitempty"`
+	TokenEndpointAuthMethod string               `json:"token_endpoint_auth_method,omitempty"`
+	Scope                   string               `json:"scope,omitempty"`
+	JWKS                    *clientJSONWebKeySet `json:"jwks,omitempty"`
+	TLSClientAuthSubjectDN  string               `json:"tls_client_auth_subject_dn,omitempty"`
+	BackChannelLogoutURI    string               `json:"backchannel_logout_uri,omitempty"`
+	FrontChannelLogoutURI   string               `json:"frontchannel_logout_uri,omitempty"`
+	PostLogoutRedirectURIs  []string             `json:"post_logout_redirect_uris,omitempty"`
+}
+
+//clientJSONWebKeySet is the key set a private_key_jwt client registers, the key is stored the way the client sent it
+type clientJSONWebKeySet struct {
+	Keys []json.RawMessage `json:"keys"`
+}
+
+//clientInformationResponse is the response of the registration and client configuration endpoints (RFC 7591 section 3.2.1, RFC 7592 section 3)
+type clientInformationResponse struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientSecretExpiresAt   int64  `json:"client_secret_expires_at"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+	clientMetadata
+}
+
+func errInvalidClientMetadata(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidClientMetadata, http.StatusBadRequest, description)
+}
+
+func errInvalidRedirectURI(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidRedirectURI, http.StatusBadRequest, description)
+}
+
+func errInvalidToken() *OAuthError {
+	return newOAuthError(ErrorCodeInvalidToken, http.StatusUnauthorized, "")
+}
+
+//hashRegistrationAccessToken hashes a registration access token, only the hash is stored
+func hashRegistrationAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+//isValidRegistrationLabel checks if a client_name can be used as label, it needs to be usable in the registration client uri
+func isValidRegistrationLabel(label string) bool {
+	if len(label) < 3 || len(label) > 50 {
+		return false
+	}
+	for _, c := range label {
+		if !((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || strings.ContainsRune("-._", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+//applyClientMetadata validates the registered client metadata and applies it to a client
+func applyClientMetadata(client *Oauth2Client, metadata *clientMetadata) *OAuthError {
+	client.Public = false
+	client.TokenEndpointAuthMethod = ""
+	client.PublicKey = ""
+	client.TLSClientAuthSubjectDN = ""
+	switch metadata.TokenEndpointAuthMethod {
+	case "", TokenEndpointAuthMethodClientSecretBasic, TokenEndpointAuthMethodClientSecretPost:
+	case TokenEndpointAuthMethodNone:
+		client.Public = true
+	case TokenEndpointAuthMethodPrivateKeyJWT:
+		if metadata.JWKS == nil || len(metadata.JWKS.Keys) != 1 {
+			return errInvalidClientMetadata("A private_key_jwt client needs to register exactly one key in jwks")
+		}
+		client.TokenEndpointAuthMethod = metadata.TokenEndpointAuthMethod
+		client.PublicKey = string(metadata.JWKS.Keys[0])
+	case TokenEndpointAuthMethodTLSClientAuth:
+		client.TokenEndpointAuthMethod = metadata.TokenEndpointAuthMethod
+		client.TLSClientAuthSubjectDN = metadata.TLSClientAuthSubjectDN
+	default:
+		return errInvalidClientMetadata("Unsupported token_endpoint_auth_method")
+	}
+	if err := ValidateClientAuthentication(client.TokenEndpointAuthMethod, client.PublicKey, client.TLSClientAuthSubjectDN); err != nil {
+		return errInvalidClientMetadata("Invalid jwks or tls_client_auth_subject_dn")
+	}
+
+	client.ClientCredentialsGrantType = false
+	client.GrantTypes = metadata.GrantTypes
+	tokenExchange := false
+	for _, grantType := range metadata.GrantTypes {
+		switch grantType {
+		case AuthorizationCodeGrantType, ImplicitGrantType, RefreshTokenGrantType, DeviceCodeGrantType:
+		case ClientCredentialsGrantCodeType:
+			client.ClientCredentialsGrantType = true
+		case TokenExchangeGrantType:
+			tokenExchange = true
+		default:
+			return errInvalidClientMetadata("Unsupported grant_type " + grantType)
+		}
+	}
+	if client.Public && (client.ClientCredentialsGrantType || tokenExchange) {
+		return errInvalidClientMetadata("A client without a secret can not use the client_credentials or token exchange grant type")
+	}
+
+	redirectURIs := make([]RedirectURI, 0, len(metadata.RedirectURIs))
+	for _, uri := range metadata.RedirectURIs {
+		redirectURI := RedirectURI{URI: uri}
+		if err := redirectURI.Validate(); err != nil {
+			return errInvalidRedirectURI(uri)
+		}
+		redirectURIs = append(redirectURIs, redirectURI)
+	}
+	if len(redirectURIs) == 0 && !client.ClientCredentialsGrantType && !tokenExchange {
+		return errInvalidRedirectURI("At least one redirect uri is required")
+	}
+	client.CallbackURL = ""
+	client.RedirectURIs = nil
+	if len(redirectURIs) > 0 {
+		client.CallbackURL = redirectURIs[0].URI
+		client.RedirectURIs = redirectURIs[1:]
+	}
+
+	if err := ValidateLogoutURIs(metadata.BackChannelLogoutURI, metadata.FrontChannelLogoutURI, metadata.PostLogoutRedirectURIs); err != nil {
+		return errInvalidClientMetadata("Invalid backchannel_logout_uri, frontchannel_logout_uri or post_logout_redirect_uris")
+	}
+	client.BackChannelLogoutURI = metadata.BackChannelLogoutURI
+	client.FrontChannelLogoutURI = metadata.FrontChannelLogoutURI
+	client.PostLogoutRedirectURIs = metadata.PostLogoutRedirectURIs
+
+	client.Scope = strings.Join(splitScopes(metadata.Scope), ",")
+	return nil
+}
+
+//newClientInformationResponse describes a registered client the way the server actually handles it
+func newClientInformationResponse(client *Oauth2Client) *clientInformationResponse {
+	response := &clientInformationResponse{
+		ClientID:              client.ClientID,
+		RegistrationClientURI: issuer() + (&url.URL{Path: "/v1/oauth/register/" + client.ClientID + "/" + client.Label}).String(),
+		clientMetadata: clientMetadata{
+			ClientName:              client.Label,
+			TokenEndpointAuthMethod: TokenEndpointAuthMethodClientSecretBasic,
+			Scope:                   client.Scope,
+			BackChannelLogoutURI:    client.BackChannelLogoutURI,
+			FrontChannelLogoutURI:   client.FrontChannelLogoutURI,
+			PostLogoutRedirectURIs:  client.PostLogoutRedirectURIs,
+		},
+	}
+	switch {
+	case client.Public:
+		response.TokenEndpointAuthMethod = TokenEndpointAuthMethodNone
+	case client.TokenEndpointAuthMethod != "":
+		//The secret can not be used by these clients
+		response.TokenEndpointAuthMethod = client.TokenEndpointAuthMethod
+		response.TLSClientAuthSubjectDN = client.TLSClientAuthSubjectDN
+		if strings.HasPrefix(strings.TrimSpace(client.PublicKey), "{") {
+			response.JWKS = &clientJSONWebKeySet{Keys: []json.RawMessage{json.RawMessage(client.PublicKey)}}
+		}
+	default:
+		response.ClientSecret = client.Secret
+	}
+	if client.CallbackURL != "" {
+		response.RedirectURIs = append(response.RedirectURIs, client.CallbackURL)
+	}
+	for _, redirectURI := range client.RedirectURIs {
+		response.RedirectURIs = append(response.RedirectURIs, redirectURI.URI)
+	}
+	for _, grantType := range []string{AuthorizationCodeGrantType, ImplicitGrantType, RefreshTokenGrantType, DeviceCodeGrantType, TokenExchangeGrantType} {
+		if client.AllowsGrantType(grantType) {
+			response.GrantTypes = append(response.GrantTypes, grantType)
+		}
+	}
+	if client.ClientCredentialsGrantType {
+		response.GrantTypes = append(response.GrantTypes, ClientCredentialsGrantCodeType)
+	}
+	return response
+}
+
+func writeClientInformationResponse(w http.ResponseWriter, statusCode int, response *clientInformationResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
+
+//organizationFromInitialAccessToken returns the organization an initial access token allows to register clients for.
+// Initial access tokens are acquired by the organization in a client credentials flow.
+func organizationFromInitialAccessToken(at *AccessToken) string {
+	if at == nil || at.Username != "" || at.GlobalID == "" || !scopeStringContains(at.Scope, registrationScope) {
+		return ""
+	}
+	return at.GlobalID
+}
+
+//scopesAllowedForClient checks if the requested scopes are within the scopes a client registered for.
+// A client that did not register scopes can request any scope, a registered scope also allows the labelled versions of it.
+func scopesAllowedForClient(registeredScope string, requestedScopes []string) bool {
+	if registeredScope == "" {
+		return true
+	}
+	registeredScopes := splitScopes(registeredScope)
+	for _, requested := range requestedScopes {
+		allowed := false
+		for _, registered := range registeredScopes {
+			if requested == registered || strings.HasPrefix(requested, registered+":") {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+//scopesAllowedForRedirectURI checks if one of the clients that registered the redirect uri is allowed to use the grant type and request the scopes
+func scopesAllowedForRedirectURI(mgr ClientManager, clientID, redirectURI, grantType string, requestedScopes []string) (allowed bool, err error) {
+	clients, err := mgr.AllByClientID(clientID)
+	if err != nil {
+		return
+	}
+	for _, client := range clients {
+		if client.MatchesRedirectURI(redirectURI) && client.AllowsGrantType(grantType) && scopesAllowedForClient(client.Scope, requestedScopes) {
+			allowed = true
+			return
+		}
+	}
+	return
+}
+
+//sharesClientIDWithPublicClient checks if a client would share its client_id with other clients while one of them is public.
+// All clients of an organization use the organization as client_id, the paths that do not require client authentication
+// for a public client can not be allowed next to other clients. The client being updated is passed as oldLabel.
+func sharesClientIDWithPublicClient(clients []*Oauth2Client, client *Oauth2Client, oldLabel string) bool {
+	for _, other := range clients {
+		if other.Label == oldLabel {
+			continue
+		}
+		if client.Public || other.Public {
+			return true
+		}
+	}
+	return false
+}
+
+//ClientIDSharedWithPublicClient checks if a new or updated client would share its client_id with the other clients of the organization while one of them is public.
+// The client being updated is passed as oldLabel, use an empty oldLabel for a new client.
+func ClientIDSharedWithPublicClient(mgr ClientManager, client *Oauth2Client, oldLabel string) (shared bool, err error) {
+	clients, err := mgr.AllByClientID(client.ClientID)
+	if err != nil {
+		return
+	}
+	shared = sharesClientIDWithPublicClient(clients, client, oldLabel)
+	return
+}
+
+//checkSharedClientID returns an error if the client can not share its client_id with the other clients of the organization
+func checkSharedClientID(mgr ClientManager, client *Oauth2Client, oldLabel string) (oauthErr *OAuthError) {
+	shared, err := ClientIDSharedWithPublicClient(mgr, client, oldLabel)
+	if err != nil {
+		log.Error("Error getting the clients of the organization: ", err)
+		return errServerError()
+	}
+	if shared {
+		return errInvalidClientMetadata("A client with token_endpoint_auth_method none can not be registered next to other clients of the organization")
+	}
+	return nil
+}
+
+//RegisterClientHandler is the handler of the /v1/oauth/register endpoint, it implements RFC 7591.
+// The request needs to be authorized with an access token the organization acquired in a client credentials flow.
+func (service *Service) RegisterClientHandler(w http.ResponseWriter, r *http.Request) {
+	mgr := NewManager(r)
+	at, err := mgr.GetAccessToken(accessTokenFromRequest(r))
+	if err != nil {
+		log.Error("Error getting the access token: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+	organization := organizationFromInitialAccessToken(at)
+	if organization == "" {
+		writeOAuthError(w, errInvalidToken())
+		return
+	}
+
+	metadata := &clientMetadata{}
+	if err = json.NewDecoder(r.Body).Decode(metadata); err != nil {
+		writeOAuthError(w, errInvalidClientMetadata("Unable to parse the client metadata"))
+		return
+	}
+
+	label := metadata.ClientName
+	if label == "" {
+		label = "client-" + newRandomToken()[:8]
+	}
+	if !isValidRegistrationLabel(label) {
+		writeOAuthError(w, errInvalidClientMetadata("The client_name should be 3 to 50 letters, digits, '-', '.' or '_'"))
+		return
+	}
+
+	client := NewOauth2Client(organization, label, "", false)
+	if oauthErr := applyClientMetadata(client, metadata); oauthErr != nil {
+		writeOAuthError(w, oauthErr)
+		return
+	}
+	if oauthErr := checkSharedClientID(mgr, client, ""); oauthErr != nil {
+		writeOAuthError(w, oauthErr)
+		return
+	}
+	registrationAccessToken := newRandomToken()
+	client.RegistrationAccessTokenHash = hashRegistrationAccessToken(registrationAccessToken)
+
+	err = mgr.CreateClient(client)
+	if err == db.ErrDuplicate {
+		writeOAuthError(w, errInvalidClientMetadata("A client with this client_name already exists"))
+		return
+	}
+	if err != nil {
+		log.Error("Error creating the client: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+
+	response := newClientInformationResponse(client)
+	response.RegistrationAccessToken = registrationAccessToken
+	writeClientInformationResponse(w, http.StatusCreated, response)
+}
+
+//registeredClientFromRequest returns the client that is managed through the registration client uri of the request,
+// it returns nil if the client does not exist or if the registration access token is not valid for it
+func registeredClientFromRequest(mgr *Manager, r *http.Request) (client *Oauth2Client, err error) {
+	token := accessTokenFromRequest(r)
+	if token == "" {
+		return
+	}
+	client, err = mgr.GetClient(mux.Vars(r)["clientid"], mux.Vars(r)["label"])
+	if err != nil || client == nil {
+		return
+	}
+	tokenHash := hashRegistrationAccessToken(token)
+	if client.RegistrationAccessTokenHash == "" || subtle.ConstantTimeCompare([]byte(tokenHash), []byte(client.RegistrationAccessTokenHash)) != 1 {
+		client = nil
+	}
+	return
+}
+
+//ClientConfigurationHandler is the handler of the /v1/oauth/register/{clientid}/{label} endpoint, it implements RFC 7592.
+// A registered client can be read, updated and deleted using the registration access token it received when it was registered.
+func (service *Service) ClientConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	mgr := NewManager(r)
+	client, err := registeredClientFromRequest(mgr, r)
+	if err != nil {
+		log.Error("Error getting the registered client: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+	if client == nil {
+		writeOAuthError(w, errInvalidToken())
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeClientInformationResponse(w, http.StatusOK, newClientInformationResponse(client))
+	case "PUT":
+		metadata := &clientMetadata{}
+		if err = json.NewDecoder(r.Body).Decode(metadata); err != nil {
+			writeOAuthError(w, errInvalidClientMetadata("Unable to parse the client metadata"))
+			return
+		}
+		oldLabel := client.Label
+		if metadata.ClientName != "" {
+			if !isValidRegistrationLabel(metadata.ClientName) {
+				writeOAuthError(w, errInvalidClientMetadata("The client_name should be 3 to 50 letters, digits, '-', '.' or '_'"))
+				return
+			}
+			client.Label = metadata.ClientName
+		}
+		if oauthErr := applyClientMetadata(client, metadata); oauthErr != nil {
+			writeOAuthError(w, oauthErr)
+			return
+		}
+		if oauthErr := checkSharedClientID(mgr, client, oldLabel); oauthErr != nil {
+			writeOAuthError(w, oauthErr)
+			return
+		}
+		err = mgr.updateRegisteredClient(oldLabel, client)
+		if err == db.ErrDuplicate {
+			writeOAuthError(w, errInvalidClientMetadata("A client with this client_name already exists"))
+			return
+		}
+		if err != nil {
+			log.Error("Error updating the registered client: ", err)
+			writeOAuthError(w, errServerError())
+			return
+		}
+		writeClientInformationResponse(w, http.StatusOK, newClientInformationResponse(client))
+	case "DELETE":
+		if err = mgr.DeleteClient(client.ClientID, client.Label); err != nil {
+			log.Error("Error deleting the registered client: ", err)
+			writeOAuthError(w, errServerError())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
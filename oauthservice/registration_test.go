@@ -0,0 +1,204 @@
+package oauthservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyClientMetadata(t *testing.T) {
+	client := NewOauth2Client("org1", "ci", "", false)
+	oauthErr := applyClientMetadata(client, &clientMetadata{
+		RedirectURIs: []string{"https://app.example.com/callback", "https://app.example.com/other"},
+		GrantTypes:   []string{AuthorizationCodeGrantType, RefreshTokenGrantType, ClientCredentialsGrantCodeType},
+		Scope:        "user:name user:email",
+	})
+	assert.Nil(t, oauthErr)
+	assert.Equal(t, "https://app.example.com/callback", client.CallbackURL)
+	assert.Equal(t, []RedirectURI{RedirectURI{URI: "https://app.example.com/other"}}, client.RedirectURIs)
+	assert.True(t, client.ClientCredentialsGrantType)
+	assert.False(t, client.Public)
+	assert.Equal(t, "user:name,user:email", client.Scope)
+
+	oauthErr = applyClientMetadata(client, &clientMetadata{
+		RedirectURIs:            []string{"https://app.example.com/callback"},
+		TokenEndpointAuthMethod: TokenEndpointAuthMethodNone,
+	})
+	assert.Nil(t, oauthErr)
+	assert.True(t, client.Public)
+	assert.False(t, client.ClientCredentialsGrantType)
+	assert.Empty(t, client.RedirectURIs)
+	assert.Empty(t, client.Scope)
+
+	type testcase struct {
+		metadata *clientMetadata
+		code     string
+	}
+	testcases := []testcase{
+		{metadata: &clientMetadata{}, code: ErrorCodeInvalidRedirectURI},
+		{metadata: &clientMetadata{RedirectURIs: []string{"/callback"}}, code: ErrorCodeInvalidRedirectURI},
+		{metadata: &clientMetadata{RedirectURIs: []string{"https://app.example.com/callback"}, GrantTypes: []string{"password"}}, code: ErrorCodeInvalidClientMetadata},
+		{metadata: &clientMetadata{RedirectURIs: []string{"https://app.example.com/callback"}, TokenEndpointAuthMethod: "private_key_jwt"}, code: ErrorCodeInvalidClientMetadata},
+		{metadata: &clientMetadata{GrantTypes: []string{ClientCredentialsGrantCodeType}, TokenEndpointAuthMethod: TokenEndpointAuthMethodNone}, code: ErrorCodeInvalidClientMetadata},
+		{metadata: &clientMetadata{GrantTypes: []string{TokenExchangeGrantType}, TokenEndpointAuthMethod: TokenEndpointAuthMethodNone}, code: ErrorCodeInvalidClientMetadata},
+	}
+	for i, test := range testcases {
+		oauthErr = applyClientMetadata(NewOauth2Client("org1", "ci", "", false), test.metadata)
+		if assert.NotNil(t, oauthErr, i) {
+			assert.Equal(t, test.code, oauthErr.Code, i)
+		}
+	}
+
+	//A client that only uses the client credentials flow does not need a redirect uri
+	oauthErr = applyClientMetadata(client, &clientMetadata{GrantTypes: []string{ClientCredentialsGrantCodeType}})
+	assert.Nil(t, oauthErr)
+
+	oauthErr = applyClientMetadata(client, &clientMetadata{GrantTypes: []string{TokenExchangeGrantType}})
+	assert.Nil(t, oauthErr)
+	assert.True(t, client.AllowsGrantType(TokenExchangeGrantType))
+	assert.False(t, client.AllowsGrantType(AuthorizationCodeGrantType))
+}
+
+func TestApplyClientMetadataPrivateKeyJWT(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	jwk, _ := NewECJSONWebKey(&ecKey.PublicKey)
+	key, _ := json.Marshal(jwk)
+
+	client := NewOauth2Client("org1", "ci", "", false)
+	oauthErr := applyClientMetadata(client, &clientMetadata{
+		GrantTypes:              []string{ClientCredentialsGrantCodeType},
+		TokenEndpointAuthMethod: TokenEndpointAuthMethodPrivateKeyJWT,
+		JWKS:                    &clientJSONWebKeySet{Keys: []json.RawMessage{key}},
+	})
+	assert.Nil(t, oauthErr)
+	assert.Equal(t, TokenEndpointAuthMethodPrivateKeyJWT, client.TokenEndpointAuthMethod)
+	assert.Equal(t, string(key), client.PublicKey)
+
+	response := newClientInformationResponse(client)
+	assert.Empty(t, response.ClientSecret)
+	assert.Equal(t, TokenEndpointAuthMethodPrivateKeyJWT, response.TokenEndpointAuthMethod)
+	if assert.NotNil(t, response.JWKS) {
+		assert.Equal(t, []json.RawMessage{key}, response.JWKS.Keys)
+	}
+
+	oauthErr = applyClientMetadata(client, &clientMetadata{
+		GrantTypes:              []string{ClientCredentialsGrantCodeType},
+		TokenEndpointAuthMethod: TokenEndpointAuthMethodPrivateKeyJWT,
+		JWKS:                    &clientJSONWebKeySet{Keys: []json.RawMessage{json.RawMessage(`{"kty":"oct","k":"c2VjcmV0"}`)}},
+	})
+	if assert.NotNil(t, oauthErr) {
+		assert.Equal(t, ErrorCodeInvalidClientMetadata, oauthErr.Code)
+	}
+
+	oauthErr = applyClientMetadata(client, &clientMetadata{
+		GrantTypes:              []string{ClientCredentialsGrantCodeType},
+		TokenEndpointAuthMethod: TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  "CN=ci,O=org1",
+	})
+	assert.Nil(t, oauthErr)
+	assert.Equal(t, "CN=ci,O=org1", client.TLSClientAuthSubjectDN)
+	assert.Empty(t, client.PublicKey)
+}
+
+func TestNewClientInformationResponse(t *testing.T) {
+	PublicURL = "https://itsyou.online"
+	client := &Oauth2Client{
+		ClientID:     "org1",
+		Label:        "ci",
+		Secret:       "secret",
+		CallbackURL:  "https://app.example.com/callback",
+		RedirectURIs: []RedirectURI{RedirectURI{URI: "https://app.example.com/other"}},
+		Scope:        "user:name",
+	}
+	response := newClientInformationResponse(client)
+	assert.Equal(t, "org1", response.ClientID)
+	assert.Equal(t, "secret", response.ClientSecret)
+	assert.Equal(t, "https://itsyou.online/v1/oauth/register/org1/ci", response.RegistrationClientURI)
+	assert.Equal(t, "ci", response.ClientName)
+	assert.Equal(t, []string{"https://app.example.com/callback", "https://app.example.com/other"}, response.RedirectURIs)
+	assert.Equal(t, TokenEndpointAuthMethodClientSecretBasic, response.TokenEndpointAuthMethod)
+	for _, grantType := range response.GrantTypes {
+		assert.NotEqual(t, ClientCredentialsGrantCodeType, grantType)
+	}
+	assert.Equal(t, "user:name", response.Scope)
+
+	client.GrantTypes = []string{AuthorizationCodeGrantType, RefreshTokenGrantType}
+	response = newClientInformationResponse(client)
+	assert.Equal(t, []string{AuthorizationCodeGrantType, RefreshTokenGrantType}, response.GrantTypes)
+
+	client.Public = true
+	response = newClientInformationResponse(client)
+	assert.Empty(t, response.ClientSecret)
+	assert.Equal(t, TokenEndpointAuthMethodNone, response.TokenEndpointAuthMethod)
+}
+
+func TestOrganizationFromInitialAccessToken(t *testing.T) {
+	assert.Equal(t, "", organizationFromInitialAccessToken(nil))
+	assert.Equal(t, "org1", organizationFromInitialAccessToken(newAccessToken("", "org1", "org1", "organization:owner")))
+	assert.Equal(t, "", organizationFromInitialAccessToken(newAccessToken("bob", "org1", "org1", "organization:owner")))
+	assert.Equal(t, "", organizationFromInitialAccessToken(newAccessToken("bob", "", "itsyouonline", "admin")))
+	assert.Equal(t, "", organizationFromInitialAccessToken(newAccessToken("", "org1", "org1", "user:name")))
+}
+
+func TestScopesAllowedForClient(t *testing.T) {
+	assert.True(t, scopesAllowedForClient("", []string{"user:name", "user:email"}))
+	assert.True(t, scopesAllowedForClient("user:name,user:email", []string{"user:name", "user:email:work"}))
+	assert.True(t, scopesAllowedForClient("user:name", []string{}))
+	assert.False(t, scopesAllowedForClient("user:name", []string{"user:name", "user:address"}))
+	assert.False(t, scopesAllowedForClient("user:email", []string{"user:emailaddress"}))
+}
+
+func TestScopesAllowedForRedirectURI(t *testing.T) {
+	mgr := &testClientManager{
+		clients: []*Oauth2Client{
+			&Oauth2Client{CallbackURL: "https://restricted.example.com/callback", Scope: "user:name"},
+			&Oauth2Client{CallbackURL: "https://app.example.com/callback"},
+		},
+	}
+	allowed, err := scopesAllowedForRedirectURI(mgr, "org1", "https://restricted.example.com/callback", AuthorizationCodeGrantType, []string{"user:name"})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = scopesAllowedForRedirectURI(mgr, "org1", "https://restricted.example.com/callback", AuthorizationCodeGrantType, []string{"user:email"})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = scopesAllowedForRedirectURI(mgr, "org1", "https://app.example.com/callback", AuthorizationCodeGrantType, []string{"user:email"})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	mgr.clients[1].GrantTypes = []string{ImplicitGrantType}
+	allowed, err = scopesAllowedForRedirectURI(mgr, "org1", "https://app.example.com/callback", AuthorizationCodeGrantType, []string{"user:email"})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestSharesClientIDWithPublicClient(t *testing.T) {
+	confidential := &Oauth2Client{ClientID: "org1", Label: "web"}
+	public := &Oauth2Client{ClientID: "org1", Label: "spa", Public: true}
+
+	assert.False(t, sharesClientIDWithPublicClient([]*Oauth2Client{}, public, ""))
+	assert.False(t, sharesClientIDWithPublicClient([]*Oauth2Client{confidential}, &Oauth2Client{ClientID: "org1", Label: "cli"}, ""))
+	assert.True(t, sharesClientIDWithPublicClient([]*Oauth2Client{confidential}, public, ""))
+	assert.True(t, sharesClientIDWithPublicClient([]*Oauth2Client{public}, confidential, ""))
+	assert.False(t, sharesClientIDWithPublicClient([]*Oauth2Client{public}, &Oauth2Client{ClientID: "org1", Label: "spa2", Public: true}, "spa"))
+
+	//A confidential api key can not become public next to other api keys of the organization
+	shared, err := ClientIDSharedWithPublicClient(&testClientManager{clients: []*Oauth2Client{confidential, {ClientID: "org1", Label: "cli"}}}, &Oauth2Client{ClientID: "org1", Label: "web", Public: true}, "web")
+	assert.NoError(t, err)
+	assert.True(t, shared)
+	shared, err = ClientIDSharedWithPublicClient(&testClientManager{clients: []*Oauth2Client{confidential}}, &Oauth2Client{ClientID: "org1", Label: "web", Public: true}, "web")
+	assert.NoError(t, err)
+	assert.False(t, shared)
+}
+
+func TestIsValidRegistrationLabel(t *testing.T) {
+	assert.True(t, isValidRegistrationLabel("ci-staging_1.0"))
+	assert.False(t, isValidRegistrationLabel("ci"))
+	assert.False(t, isValidRegistrationLabel("ci staging"))
+	assert.False(t, isValidRegistrationLabel("ci/staging"))
+}
@@ -0,0 +1,93 @@
+package oauthservice
+
+import (
+	"net/http"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//clientCredentialsFromRequest gets the client_id and client_secret from the http basic authentication header
+// or if not present, from the form parameters
+func clientCredentialsFromRequest(r *http.Request) (clientID, clientSecret string) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		return
+	}
+	clientID = r.FormValue("client_id")
+	clientSecret = r.FormValue("client_secret")
+	return
+}
+
+//authenticateClient returns the client identified by the credentials in the request or nil if the credentials are invalid
+func authenticateClient(mgr *Manager, r *http.Request) (client *Oauth2Client, err error) {
+	clientID, clientSecret := clientCredentialsFromRequest(r)
+	if clientID == "" {
+		clientID = clientIDFromAssertion(r.FormValue("client_assertion"))
+	}
+	if clientID == "" || !hasClientAuthentication(r, clientSecret) {
+		return
+	}
+	client, err = authenticateConfidentialClient(mgr, r, clientID, clientSecret)
+	return
+}
+
+//RevokeHandler is the handler of the /v1/oauth/revoke endpoint, it implements RFC 7009
+func (service *Service) RevokeHandler(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		log.Debug("ERROR parsing form: ", err)
+		writeOAuthError(w, errInvalidRequest("Unable to parse the request"))
+		return
+	}
+
+	mgr := NewManager(r)
+	client, err := authenticateClient(mgr, r)
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+	if client == nil {
+		writeOAuthError(w, errInvalidClient(""))
+		return
+	}
+
+	token := r.FormValue("token")
+	if token == "" {
+		writeOAuthError(w, errInvalidRequest("Missing token"))
+		return
+	}
+
+	//The token_type_hint is only an optimization, when the token is not found with the hinted type, the other type is tried as well
+	if r.FormValue("token_type_hint") == RefreshTokenGrantType {
+		err = revokeRefreshTokenOrAccessToken(mgr, client.ClientID, token)
+	} else {
+		err = revokeAccessTokenOrRefreshToken(mgr, client.ClientID, token)
+	}
+	if err != nil {
+		log.Error("Error revoking token: ", err)
+		writeOAuthError(w, errServerError())
+		return
+	}
+
+	//Invalid or unknown tokens do not cause an error response since the client can't handle such an error anyway
+	w.WriteHeader(http.StatusOK)
+}
+
+func revokeAccessTokenOrRefreshToken(mgr *Manager, clientID, token string) (err error) {
+	removed, err := mgr.removeAccessToken(clientID, token)
+	if err != nil || removed {
+		return
+	}
+	_, err = mgr.revokeRefreshToken(clientID, token)
+	return
+}
+
+func revokeRefreshTokenOrAccessToken(mgr *Manager, clientID, token string) (err error) {
+	removed, err := mgr.revokeRefreshToken(clientID, token)
+	if err != nil || removed {
+		return
+	}
+	_, err = mgr.removeAccessToken(clientID, token)
+	return
+}
@@ -0,0 +1,27 @@
+package oauthservice
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCredentialsFromRequest(t *testing.T) {
+	form := url.Values{"client_id": {"formclient"}, "client_secret": {"formsecret"}}
+
+	r, _ := http.NewRequest("POST", "/v1/oauth/revoke", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	clientID, clientSecret := clientCredentialsFromRequest(r)
+	assert.Equal(t, "formclient", clientID)
+	assert.Equal(t, "formsecret", clientSecret)
+
+	r, _ = http.NewRequest("POST", "/v1/oauth/revoke", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.SetBasicAuth("basicclient", "basicsecret")
+	clientID, clientSecret = clientCredentialsFromRequest(r)
+	assert.Equal(t, "basicclient", clientID)
+	assert.Equal(t, "basicsecret", clientSecret)
+}
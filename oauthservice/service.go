@@ -1,9 +1,10 @@
 package oauthservice
 
 import (
-	"crypto/ecdsa"
 	"net/http"
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/gorilla/mux"
 )
 
@@ -11,8 +12,14 @@ import (
 type SessionService interface {
 	//GetLoggedInUser returns an authenticated user, or an empty string if there is none
 	GetLoggedInUser(request *http.Request) (username string, err error)
-	//SetAPIAccessToken sets the api access token for this session
-	SetAPIAccessToken(w http.ResponseWriter, token string) (err error)
+	//GetAuthenticationTime returns the time the logged in user authenticated, or the zero time if unknown
+	GetAuthenticationTime(request *http.Request) (authTime time.Time, err error)
+	//GetSessionID returns the id of the session of the logged in user, or an empty string if there is none
+	GetSessionID(request *http.Request) (sessionID string, err error)
+	//ClearLoggedInUser ends the session of the authenticated user
+	ClearLoggedInUser(w http.ResponseWriter, request *http.Request) (err error)
+	//ValidCSRFToken checks if a submitted token is the csrf token of the session
+	ValidCSRFToken(request *http.Request, token string) (valid bool)
 }
 
 //IdentityService provides some basic knowledge about authorizations required for the oauthservice
@@ -29,20 +36,32 @@ type Service struct {
 	sessionService  SessionService
 	identityService IdentityService
 	router          *mux.Router
-	jwtSigningKey   *ecdsa.PrivateKey
+	keyRing         *KeyRing
 }
 
 //NewService creates and initializes a Service
-func NewService(sessionService SessionService, identityService IdentityService, ecdsaKey *ecdsa.PrivateKey) (service *Service, err error) {
-	service = &Service{sessionService: sessionService, identityService: identityService, jwtSigningKey: ecdsaKey}
+func NewService(sessionService SessionService, identityService IdentityService, keyRing *KeyRing) (service *Service, err error) {
+	service = &Service{sessionService: sessionService, identityService: identityService, keyRing: keyRing}
+	return
+}
+
+//signJWT adds the key id of the active signing key to the header of a JWT and signs it
+func (service *Service) signJWT(token *jwt.Token) (tokenString string, err error) {
+	kid, key := service.keyRing.SigningKey()
+	token.Header["kid"] = kid
+	tokenString, err = token.SignedString(key)
 	return
 }
 
 const (
 	//AuthorizationGrantCodeType is the requested response_type for an 'authorization code' oauth2 flow
 	AuthorizationGrantCodeType = "code"
+	//AuthorizationCodeGrantType is the (optional) grant_type when exchanging an authorization code for an access token
+	AuthorizationCodeGrantType = "authorization_code"
 	//ImplicitGrantCodeType is the requested response_type for an 'implicit' oauth2 flow
 	ImplicitGrantCodeType = "token"
+	//ImplicitGrantType is the grant type a client registers to use the 'implicit' oauth2 flow
+	ImplicitGrantType = "implicit"
 	//ClientCredentialsGrantCodeType is the requested grant_type for a 'client credentials' oauth2 flow
 	ClientCredentialsGrantCodeType = "client_credentials"
 )
@@ -56,8 +75,20 @@ func (service *Service) GetAuthenticatedUser(r *http.Request) (username string,
 //AddRoutes adds the routes and handlerfunctions to the router
 func (service *Service) AddRoutes(router *mux.Router) {
 	service.router = router
-	router.HandleFunc("/v1/oauth/authorize", service.AuthorizeHandler).Methods("GET")
+	router.HandleFunc("/v1/oauth/authorize", service.AuthorizeHandler).Methods("GET", "POST")
 	router.HandleFunc("/v1/oauth/access_token", service.AccessTokenHandler).Methods("POST")
 	router.HandleFunc("/v1/oauth/jwt", service.JWTHandler).Methods("POST", "GET")
+	router.HandleFunc("/v1/oauth/jwt/refresh", service.JWTRefreshHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/device/code", service.DeviceCodeHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/device/verify", service.DeviceVerificationHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/revoke", service.RevokeHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/introspect", service.IntrospectHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/userinfo", service.UserInfoHandler).Methods("GET", "POST")
+	router.HandleFunc("/v1/oauth/logout", service.EndSessionHandler).Methods("GET", "POST")
+	router.HandleFunc("/v1/oauth/session/token", service.SessionTokenHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/register", service.RegisterClientHandler).Methods("POST")
+	router.HandleFunc("/v1/oauth/register/{clientid}/{label}", service.ClientConfigurationHandler).Methods("GET", "PUT", "DELETE")
+	router.HandleFunc("/.well-known/openid-configuration", service.DiscoveryHandler).Methods("GET")
+	router.HandleFunc("/.well-known/jwks.json", service.JWKSHandler).Methods("GET")
 	InitModels()
 }
@@ -0,0 +1,73 @@
+package oauthservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//sessionTokenResponse is the response of the session token endpoint
+type sessionTokenResponse struct {
+	Username    string `json:"username"`
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+//isSameOriginRequest checks if a request was made by a script of the itsyou.online website itself.
+// Browsers only allow other sites to set the X-Requested-With header after a CORS preflight, which is never granted.
+// If the browser sends an Origin header, it must be the itsyou.online website.
+func isSameOriginRequest(r *http.Request) bool {
+	if r.Header.Get("X-Requested-With") != "XMLHttpRequest" {
+		return false
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Scheme == "https" && u.Host == r.Host
+}
+
+//SessionTokenHandler is the handler of the /v1/oauth/session/token endpoint.
+// It gives the itsyou.online website an access token for the api of the user that is logged in during the interactive session.
+// The token is never stored in a cookie and is revoked when the session ends.
+func (service *Service) SessionTokenHandler(w http.ResponseWriter, r *http.Request) {
+	if !isSameOriginRequest(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	username, err := service.GetAuthenticatedUser(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if username == "" {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	sessionID, err := service.sessionService.GetSessionID(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	at, err := service.createItsYouOnlineAdminToken(username, sessionID, r)
+	if err != nil {
+		log.Error("Error creating the session token: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := &sessionTokenResponse{
+		Username:    username,
+		AccessToken: at.AccessToken,
+		TokenType:   at.Type,
+		ExpiresIn:   int64(at.ExpirationTime().Sub(time.Now()).Seconds()),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response)
+}
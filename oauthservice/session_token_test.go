@@ -0,0 +1,30 @@
+package oauthservice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSameOriginRequest(t *testing.T) {
+	newRequest := func(requestedWith, origin string) *http.Request {
+		r, _ := http.NewRequest("POST", "/v1/oauth/session/token", nil)
+		r.Host = "itsyou.online"
+		if requestedWith != "" {
+			r.Header.Set("X-Requested-With", requestedWith)
+		}
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	assert.True(t, isSameOriginRequest(newRequest("XMLHttpRequest", "")))
+	assert.True(t, isSameOriginRequest(newRequest("XMLHttpRequest", "https://itsyou.online")))
+	//A form post from another site can not set the header
+	assert.False(t, isSameOriginRequest(newRequest("", "https://itsyou.online")))
+	assert.False(t, isSameOriginRequest(newRequest("XMLHttpRequest", "https://evil.com")))
+	assert.False(t, isSameOriginRequest(newRequest("XMLHttpRequest", "http://itsyou.online")))
+	assert.False(t, isSameOriginRequest(newRequest("XMLHttpRequest", "null")))
+}
@@ -0,0 +1,201 @@
+package oauthservice
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/dgrijalva/jwt-go"
+)
+
+//TokenExchangeGrantType is the grant_type to exchange a token for a token for another audience (RFC 8693)
+const TokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+//Token types that can be exchanged (RFC 8693 section 3)
+const (
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeJWT         = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+//ErrorCodeInvalidTarget is returned when the requested audience is unknown (RFC 8693 section 2.2.2)
+const ErrorCodeInvalidTarget = "invalid_target"
+
+//jwtIssuer is the iss claim of the JWTs issued on the jwt and token endpoints
+const jwtIssuer = "itsyouonline"
+
+//tokenExchangeSubject is the user or organization a subject token was issued for
+type tokenExchangeSubject struct {
+	Username  string
+	GlobalID  string
+	Scope     string
+	ExpiresAt time.Time
+	//Act is the act claim of the subject token if it was already the result of a token exchange
+	Act interface{}
+}
+
+//tokenExchangeResponse is the response of a successful token exchange (RFC 8693 section 2.2.1)
+type tokenExchangeResponse struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	Scope           string `json:"scope"`
+}
+
+func errInvalidTarget(description string) *OAuthError {
+	return newOAuthError(ErrorCodeInvalidTarget, http.StatusBadRequest, description)
+}
+
+//subjectFromAccessToken returns the subject of an access token, only the client the token was issued to can exchange it
+func subjectFromAccessToken(at *AccessToken, clientID string, now time.Time) *tokenExchangeSubject {
+	if at == nil || at.IsExpiredAt(now) || at.ClientID != clientID {
+		return nil
+	}
+	return &tokenExchangeSubject{
+		Username:  at.Username,
+		GlobalID:  at.GlobalID,
+		Scope:     at.Scope,
+		ExpiresAt: at.ExpirationTime(),
+	}
+}
+
+//subjectFromJWTClaims returns the subject of a verified JWT issued by itsyou.online, only the audience of the JWT can exchange it
+func subjectFromJWTClaims(claims map[string]interface{}, clientID string) *tokenExchangeSubject {
+	iss, _ := claims["iss"].(string)
+	aud, _ := claims["aud"].(string)
+	exp, hasExp := claims["exp"].(float64)
+	if iss != jwtIssuer || aud != clientID || !hasExp {
+		return nil
+	}
+	subject := &tokenExchangeSubject{ExpiresAt: time.Unix(int64(exp), 0), Act: claims["act"]}
+	subject.Username, _ = claims["username"].(string)
+	subject.GlobalID, _ = claims["globalid"].(string)
+	subject.Scope, _ = claims["scope"].(string)
+	if subject.Username == "" && subject.GlobalID == "" {
+		return nil
+	}
+	return subject
+}
+
+//exchangedScope returns the scope of the exchanged token, the requested scopes need to be a subset of the scopes of the subject token.
+// If no scopes are requested, the exchanged token has the same scopes as the subject token.
+func exchangedScope(subjectScope, requestedScope string) (scope string, allowed bool) {
+	subjectScope = strings.Join(splitScopes(subjectScope), ",")
+	requestedScope = strings.Join(splitScopes(requestedScope), ",")
+	if requestedScope == "" {
+		return subjectScope, true
+	}
+	if !jwtScopesAreAllowed(subjectScope, requestedScope) {
+		return "", false
+	}
+	return requestedScope, true
+}
+
+//newActClaim creates the act claim of an exchanged token, the act claim of the subject token is nested to keep the delegation chain (RFC 8693 section 4.1)
+func newActClaim(actor string, previousAct interface{}) map[string]interface{} {
+	act := map[string]interface{}{"sub": actor}
+	if previousAct != nil {
+		act["act"] = previousAct
+	}
+	return act
+}
+
+//tokenExchangeHandler exchanges a subject token that was issued to the authenticated client for a JWT for the requested audience
+func (service *Service) tokenExchangeHandler(clientID string, secret string, r *http.Request) (response *tokenExchangeResponse, oauthErr *OAuthError) {
+	mgr := NewManager(r)
+	client, err := authenticateConfidentialClient(mgr, r, clientID, secret)
+	if err != nil {
+		log.Error("Error getting the oauth client: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if client == nil {
+		oauthErr = errInvalidClient("")
+		return
+	}
+	if !client.AllowsGrantType(TokenExchangeGrantType) {
+		oauthErr = errUnauthorizedClient("The client is not registered for the token exchange grant type")
+		return
+	}
+
+	subjectToken := r.FormValue("subject_token")
+	audience := r.FormValue("audience")
+	if subjectToken == "" || audience == "" {
+		oauthErr = errInvalidRequest("Missing subject_token or audience")
+		return
+	}
+	if requestedTokenType := r.FormValue("requested_token_type"); requestedTokenType != "" && requestedTokenType != TokenTypeJWT {
+		oauthErr = errInvalidRequest("Only JWTs can be issued")
+		return
+	}
+
+	var subject *tokenExchangeSubject
+	switch r.FormValue("subject_token_type") {
+	case TokenTypeAccessToken:
+		at, err := mgr.GetAccessToken(subjectToken)
+		if err != nil {
+			log.Error("Error getting the access token: ", err)
+			oauthErr = errServerError()
+			return
+		}
+		subject = subjectFromAccessToken(at, clientID, time.Now())
+	case TokenTypeJWT:
+		token, err := jwt.Parse(subjectToken, service.keyRing.Keyfunc)
+		if err == nil && token.Valid {
+			subject = subjectFromJWTClaims(token.Claims, clientID)
+		}
+	default:
+		oauthErr = errInvalidRequest("Unsupported subject_token_type")
+		return
+	}
+	if subject == nil {
+		oauthErr = errInvalidGrant("Invalid subject_token or it was not issued to this client")
+		return
+	}
+
+	audienceClients, err := mgr.AllByClientID(audience)
+	if err != nil {
+		log.Error("Error getting the clients of the audience: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	if len(audienceClients) == 0 {
+		oauthErr = errInvalidTarget("Unknown audience")
+		return
+	}
+
+	scope, allowed := exchangedScope(subject.Scope, r.FormValue("scope"))
+	if !allowed {
+		oauthErr = errInvalidScope("The requested scopes are not granted to the subject_token")
+		return
+	}
+
+	token := jwt.New(jwt.SigningMethodES384)
+	if subject.Username != "" {
+		token.Claims["username"] = subject.Username
+	}
+	if subject.GlobalID != "" {
+		token.Claims["globalid"] = subject.GlobalID
+	}
+	token.Claims["aud"] = audience
+	token.Claims["exp"] = subject.ExpiresAt.Unix()
+	token.Claims["iss"] = jwtIssuer
+	token.Claims["scope"] = scope
+	token.Claims["act"] = newActClaim(clientID, subject.Act)
+
+	tokenString, err := service.signJWT(token)
+	if err != nil {
+		log.Error("Error signing the exchanged token: ", err)
+		oauthErr = errServerError()
+		return
+	}
+	response = &tokenExchangeResponse{
+		AccessToken:     tokenString,
+		IssuedTokenType: TokenTypeJWT,
+		TokenType:       "Bearer",
+		ExpiresIn:       int64(subject.ExpiresAt.Sub(time.Now()).Seconds()),
+		Scope:           scope,
+	}
+	return
+}
@@ -0,0 +1,81 @@
+package oauthservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubjectFromAccessToken(t *testing.T) {
+	at := newAccessToken("bob", "", "serviceA", "user:name,user:memberof:org1")
+	subject := subjectFromAccessToken(at, "serviceA", time.Now())
+	if assert.NotNil(t, subject) {
+		assert.Equal(t, "bob", subject.Username)
+		assert.Equal(t, "user:name,user:memberof:org1", subject.Scope)
+		assert.Equal(t, at.ExpirationTime(), subject.ExpiresAt)
+		assert.Nil(t, subject.Act)
+	}
+
+	assert.Nil(t, subjectFromAccessToken(nil, "serviceA", time.Now()))
+	assert.Nil(t, subjectFromAccessToken(at, "serviceB", time.Now()))
+	assert.Nil(t, subjectFromAccessToken(at, "serviceA", at.ExpirationTime().Add(time.Second)))
+}
+
+func TestSubjectFromJWTClaims(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+	act := map[string]interface{}{"sub": "serviceA"}
+	claims := map[string]interface{}{
+		"iss":      "itsyouonline",
+		"aud":      "serviceB",
+		"exp":      float64(exp),
+		"username": "bob",
+		"scope":    "user:name",
+		"act":      act,
+	}
+	subject := subjectFromJWTClaims(claims, "serviceB")
+	if assert.NotNil(t, subject) {
+		assert.Equal(t, "bob", subject.Username)
+		assert.Equal(t, "user:name", subject.Scope)
+		assert.Equal(t, exp, subject.ExpiresAt.Unix())
+		assert.Equal(t, act, subject.Act)
+	}
+
+	assert.Nil(t, subjectFromJWTClaims(claims, "serviceC"))
+
+	claims["iss"] = "https://itsyou.online"
+	assert.Nil(t, subjectFromJWTClaims(claims, "serviceB"))
+
+	claims["iss"] = "itsyouonline"
+	delete(claims, "username")
+	assert.Nil(t, subjectFromJWTClaims(claims, "serviceB"))
+}
+
+func TestExchangedScope(t *testing.T) {
+	type testcase struct {
+		subject   string
+		requested string
+		scope     string
+		allowed   bool
+	}
+	testcases := []testcase{
+		{subject: "user:name,user:email", requested: "", scope: "user:name,user:email", allowed: true},
+		{subject: "user:name,user:email", requested: "user:name", scope: "user:name", allowed: true},
+		{subject: "user:name,user:email", requested: "user:email user:name", scope: "user:email,user:name", allowed: true},
+		{subject: "user:memberof:org1", requested: "user:memberof:org1", scope: "user:memberof:org1", allowed: true},
+		{subject: "user:name", requested: "user:name,user:email", allowed: false},
+	}
+	for i, test := range testcases {
+		scope, allowed := exchangedScope(test.subject, test.requested)
+		assert.Equal(t, test.allowed, allowed, i)
+		assert.Equal(t, test.scope, scope, i)
+	}
+}
+
+func TestNewActClaim(t *testing.T) {
+	first := newActClaim("serviceA", nil)
+	assert.Equal(t, map[string]interface{}{"sub": "serviceA"}, first)
+
+	second := newActClaim("serviceB", first)
+	assert.Equal(t, map[string]interface{}{"sub": "serviceB", "act": map[string]interface{}{"sub": "serviceA"}}, second)
+}
@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/itsyouonline/identityserver/siteservice"
+)
+
+//sessionTokenPath is the only oauth endpoint that relies on the session cookies of the user
+const sessionTokenPath = "/v1/oauth/session/token"
+
+//CSRFMiddleware protects the requests that rely on the session cookies of the user against cross-site request forgery.
+// Every response hands out the csrf token of the session, requests that change state have to submit it
+// in the X-XSRF-TOKEN header or the csrf_token form field.
+func CSRFMiddleware(sc *siteservice.Service) Middleware {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isCSRFExempt(r) {
+				h.ServeHTTP(w, r)
+				return
+			}
+			if _, err := sc.CSRFToken(w, r); err != nil {
+				log.Error("Error creating the csrf token: ", err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			if !isSafeMethod(r.Method) && !sc.ValidCSRFToken(r, siteservice.SubmittedCSRFToken(r)) {
+				log.Debug("Invalid csrf token for ", r.Method, " ", r.URL.Path)
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+//isCSRFExempt checks if a request does not rely on the session cookies of the user.
+// Api calls authenticate with a bearer token and the oauth endpoints are called by the applications themselves.
+func isCSRFExempt(r *http.Request) bool {
+	if strings.HasPrefix(r.URL.Path, "/api/") {
+		return r.Header.Get("Authorization") != ""
+	}
+	return strings.HasPrefix(r.URL.Path, "/v1/oauth/") && r.URL.Path != sessionTokenPath
+}
+
+//isSafeMethod checks if the http method does not change state
+func isSafeMethod(method string) bool {
+	return method == "GET" || method == "HEAD" || method == "OPTIONS"
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCSRFExempt(t *testing.T) {
+	newRequest := func(path, authorization string) *http.Request {
+		r, _ := http.NewRequest("POST", path, nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		return r
+	}
+	assert.True(t, isCSRFExempt(newRequest("/api/users/bob", "token abc")))
+	assert.False(t, isCSRFExempt(newRequest("/api/users/bob", "")))
+	assert.True(t, isCSRFExempt(newRequest("/v1/oauth/access_token", "")))
+	assert.False(t, isCSRFExempt(newRequest("/v1/oauth/session/token", "")))
+	assert.False(t, isCSRFExempt(newRequest("/login", "")))
+	assert.False(t, isCSRFExempt(newRequest("/register", "")))
+}
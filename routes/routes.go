@@ -30,7 +30,7 @@ func GetRouter(sc *siteservice.Service, is *identityservice.Service, oauthsc *oa
 	dbmw := db.DBMiddleware()
 	recovery := handlers.RecoveryHandler()
 
-	router.Use(recovery, LoggingMiddleware, dbmw)
+	router.Use(recovery, CSRFMiddleware(sc), LoggingMiddleware, dbmw)
 
 	return router.Handler()
 }
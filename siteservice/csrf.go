@@ -0,0 +1,96 @@
+package siteservice
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/gorilla/sessions"
+)
+
+const (
+	//CSRFCookieName is the cookie the scripts of the website read the csrf token from,
+	// angular's $http sends it back in the X-XSRF-TOKEN header
+	CSRFCookieName = "XSRF-TOKEN"
+	//CSRFHeaderName is the header the csrf token is submitted in by scripts
+	CSRFHeaderName = "X-XSRF-TOKEN"
+	//CSRFFormField is the form field or query parameter the csrf token is submitted in by forms and links
+	CSRFFormField = "csrf_token"
+)
+
+//initializeCSRFSessionStore creates the cookieStore that keeps the csrf token,
+// the cookie lives as long as the browser session so forms that are open for a long time can still be submitted
+func initializeCSRFSessionStore(cookieSecret string) (sessionStore *sessions.CookieStore) {
+	sessionStore = sessions.NewCookieStore([]byte(cookieSecret))
+	sessionStore.Options.HttpOnly = true
+	sessionStore.Options.Secure = true
+	sessionStore.Options.MaxAge = 0
+	return
+}
+
+//getCSRFSession returns the session that keeps the csrf token, an invalid session cookie results in a new session
+func (service *Service) getCSRFSession(request *http.Request) *sessions.Session {
+	session, _ := service.GetSession(request, SessionCSRF, "csrf")
+	return session
+}
+
+//CSRFToken returns the csrf token of the session, if there is none yet a new one is created.
+// The token is kept in a signed session cookie and handed to the website in the XSRF-TOKEN cookie,
+// a request is genuine if it submits the same token (double submit).
+func (service *Service) CSRFToken(w http.ResponseWriter, request *http.Request) (token string, err error) {
+	session := service.getCSRFSession(request)
+	token, _ = session.Values["token"].(string)
+	if token == "" {
+		token, err = service.renewCSRFToken(w, request)
+		return
+	}
+	if cookie, cookieErr := request.Cookie(CSRFCookieName); cookieErr != nil || cookie.Value != token {
+		setCSRFCookie(w, token)
+	}
+	return
+}
+
+//renewCSRFToken replaces the csrf token of the session, tokens that were handed out before can not be used anymore
+func (service *Service) renewCSRFToken(w http.ResponseWriter, request *http.Request) (token string, err error) {
+	session := service.getCSRFSession(request)
+	token = newCSRFToken()
+	session.Values["token"] = token
+	if err = session.Save(request, w); err != nil {
+		return
+	}
+	setCSRFCookie(w, token)
+	return
+}
+
+//ValidCSRFToken checks if a submitted token is the csrf token of the session
+func (service *Service) ValidCSRFToken(request *http.Request, token string) (valid bool) {
+	expected, _ := service.getCSRFSession(request).Values["token"].(string)
+	valid = expected != "" && subtle.ConstantTimeCompare([]byte(expected), []byte(token)) == 1
+	return
+}
+
+//SubmittedCSRFToken returns the csrf token that was submitted with a request
+func SubmittedCSRFToken(request *http.Request) string {
+	if token := request.Header.Get(CSRFHeaderName); token != "" {
+		return token
+	}
+	return request.FormValue(CSRFFormField)
+}
+
+//setCSRFCookie hands the csrf token to the scripts of the website, it is not HttpOnly on purpose
+func setCSRFCookie(w http.ResponseWriter, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:   CSRFCookieName,
+		Value:  token,
+		Path:   "/",
+		Secure: true,
+	})
+}
+
+//newCSRFToken generates a random csrf token
+func newCSRFToken() string {
+	randombytes := make([]byte, 30) //Multiple of 3 to make sure no padding is added
+	rand.Read(randombytes)
+	return base64.URLEncoding.EncodeToString(randombytes)
+}
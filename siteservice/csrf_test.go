@@ -0,0 +1,44 @@
+package siteservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRFToken(t *testing.T) {
+	siteService := NewService("MyCookieSecret", nil, nil)
+
+	w := httptest.NewRecorder()
+	token, err := siteService.CSRFToken(w, &http.Request{Header: http.Header{}})
+	assert.NoError(t, err)
+	assert.Len(t, token, 40)
+
+	//The browser sends the session cookie and the XSRF-TOKEN cookie back
+	request := &http.Request{Header: http.Header{"Cookie": w.HeaderMap["Set-Cookie"]}}
+	assert.True(t, siteService.ValidCSRFToken(request, token))
+	assert.False(t, siteService.ValidCSRFToken(request, ""))
+	assert.False(t, siteService.ValidCSRFToken(request, newCSRFToken()))
+
+	w = httptest.NewRecorder()
+	sameToken, err := siteService.CSRFToken(w, request)
+	assert.NoError(t, err)
+	assert.Equal(t, token, sameToken)
+	assert.Empty(t, w.HeaderMap["Set-Cookie"])
+
+	//Without the session cookie, a token can not be valid
+	assert.False(t, siteService.ValidCSRFToken(&http.Request{Header: http.Header{}}, token))
+}
+
+func TestSubmittedCSRFToken(t *testing.T) {
+	r, _ := http.NewRequest("POST", "/login", strings.NewReader("csrf_token=fromform"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Equal(t, "fromform", SubmittedCSRFToken(r))
+
+	r, _ = http.NewRequest("POST", "/login", strings.NewReader("{}"))
+	r.Header.Set(CSRFHeaderName, "fromheader")
+	assert.Equal(t, "fromheader", SubmittedCSRFToken(r))
+}
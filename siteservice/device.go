@@ -0,0 +1,60 @@
+package siteservice
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gorilla/sessions"
+	"github.com/itsyouonline/identityserver/siteservice/website/packaged/html"
+)
+
+const devicePageFileName = "device.html"
+
+//deviceStatusMessages are the messages shown after a user code was submitted, indexed by the status query parameter
+var deviceStatusMessages = map[string]string{
+	"":         "Enter the code shown on your device",
+	"approved": "Your device is connected, you can return to it now",
+	"denied":   "Your device was denied access",
+	"invalid":  "Invalid or expired code, try again",
+}
+
+//ShowDeviceForm shows the form where a logged in user enters the code displayed on a device
+func (service *Service) ShowDeviceForm(w http.ResponseWriter, request *http.Request) {
+	loggedinuser, err := service.GetLoggedInUser(request)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	queryValues := request.URL.Query()
+	if loggedinuser == "" {
+		queryValues.Set("endpoint", "/device")
+		http.Redirect(w, request, "/login?"+queryValues.Encode(), http.StatusFound)
+		return
+	}
+
+	text, known := deviceStatusMessages[queryValues.Get("status")]
+	if !known {
+		text = deviceStatusMessages[""]
+	}
+
+	csrfToken, err := service.CSRFToken(w, request)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	htmlData, err := html.Asset(devicePageFileName)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	htmlData = bytes.Replace(htmlData, []byte(`{{ text }}`), []byte(text), 1)
+	htmlData = bytes.Replace(htmlData, []byte(`{{ usercode }}`), []byte(template.HTMLEscapeString(queryValues.Get("user_code"))), 1)
+	htmlData = bytes.Replace(htmlData, []byte(`{{ csrftoken }}`), []byte(csrfToken), 1)
+	sessions.Save(request, w)
+	w.Write(htmlData)
+}
@@ -59,11 +59,17 @@ func newLoginSessionInformation() (sessionInformation *loginSessionInformation,
 	if err != nil {
 		return
 	}
+	sessionInformation.SMSCode, err = generateSMSCode()
+	return
+}
+
+//generateSMSCode generates a random 6 digit code to send by sms
+func generateSMSCode() (code string, err error) {
 	numbercode, err := rand.Int(rand.Reader, big.NewInt(999999))
 	if err != nil {
 		return
 	}
-	sessionInformation.SMSCode = fmt.Sprintf("%06d", numbercode)
+	code = fmt.Sprintf("%06d", numbercode)
 	return
 }
 
@@ -84,8 +90,7 @@ func (service *Service) ShowLoginForm(w http.ResponseWriter, request *http.Reque
 
 //ProcessLoginForm logs a user in if the credentials are valid
 func (service *Service) ProcessLoginForm(w http.ResponseWriter, request *http.Request) {
-	//TODO: validate csrf token
-	//TODO: limit the number of failed/concurrent requests
+	//The csrf token is validated by the csrf middleware
 
 	err := request.ParseForm()
 	if err != nil {
@@ -105,6 +110,9 @@ func (service *Service) ProcessLoginForm(w http.ResponseWriter, request *http.Re
 	}
 
 	username := values.Login
+	if !service.checkLoginThrottle(w, request, username) {
+		return
+	}
 
 	//validate the username exists
 	var userexists bool
@@ -124,6 +132,7 @@ func (service *Service) ProcessLoginForm(w http.ResponseWriter, request *http.Re
 
 	validcredentials := userexists && validpassword
 	if !validcredentials {
+		service.registerLoginFailure(request, username)
 		w.WriteHeader(422)
 		return
 	}
@@ -216,6 +225,9 @@ func (service *Service) ProcessTOTPConfirmation(w http.ResponseWriter, request *
 		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
 		return
 	}
+	if !service.checkLoginThrottle(w, request, username) {
+		return
+	}
 	values := struct {
 		Totpcode string `json:"totpcode"`
 	}{}
@@ -231,7 +243,8 @@ func (service *Service) ProcessTOTPConfirmation(w http.ResponseWriter, request *
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
-	if !validtotpcode { //TODO: limit to 3 failed attempts
+	if !validtotpcode {
+		service.registerLoginFailure(request, username)
 		w.WriteHeader(422)
 		return
 	}
@@ -270,6 +283,16 @@ func (service *Service) MobileSMSConfirmation(w http.ResponseWriter, request *ht
 	values := request.Form
 	sessionKey := values.Get("k")
 	smscode := values.Get("c")
+	retryAfter, _, err := loginRetryAfter(request, "")
+	if err != nil {
+		log.Error("Error getting the failed login attempts: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if retryAfter > 0 {
+		service.renderSMSConfirmationPage(w, request, "Too many failed attempts, try again later")
+		return
+	}
 
 	var validsmscode bool
 	sessionInfo, err := service.getLoginSessionInformation(request, sessionKey)
@@ -285,7 +308,8 @@ func (service *Service) MobileSMSConfirmation(w http.ResponseWriter, request *ht
 
 	validsmscode = (smscode == sessionInfo.SMSCode)
 
-	if !validsmscode { //TODO: limit to 3 failed attempts
+	if !validsmscode {
+		service.registerLoginFailure(request, "")
 		service.renderSMSConfirmationPage(w, request, "Invalid or expired link")
 		return
 	}
@@ -331,6 +355,9 @@ func (service *Service) Process2FASMSConfirmation(w http.ResponseWriter, request
 		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
 		return
 	}
+	if !service.checkLoginThrottle(w, request, username) {
+		return
+	}
 	values := struct {
 		Smscode string `json:"smscode"`
 	}{}
@@ -353,7 +380,8 @@ func (service *Service) Process2FASMSConfirmation(w http.ResponseWriter, request
 	if !sessionInfo.Confirmed { //Already confirmed on the phone
 		validsmscode := (values.Smscode == sessionInfo.SMSCode)
 
-		if !validsmscode { //TODO: limit to 3 failed attempts
+		if !validsmscode {
+			service.registerLoginFailure(request, username)
 			w.WriteHeader(422)
 			return
 		}
@@ -363,6 +391,7 @@ func (service *Service) Process2FASMSConfirmation(w http.ResponseWriter, request
 
 func (service *Service) loginUser(w http.ResponseWriter, request *http.Request, username string) {
 	//TODO: Clear login session
+	service.resetLoginThrottle(request, username)
 	if err := service.SetLoggedInUser(w, request, username); err != nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -371,17 +400,13 @@ func (service *Service) loginUser(w http.ResponseWriter, request *http.Request,
 
 	log.Debugf("Successfull login by '%s'", username)
 
-	redirectURL := ""
+	//The website gets its api token from the session token endpoint
+	redirectURL := "/"
 	queryValues := request.URL.Query()
 	endpoint := queryValues.Get("endpoint")
 	if endpoint != "" {
 		queryValues.Del("endpoint")
 		redirectURL = endpoint + "?" + queryValues.Encode()
-	} else {
-		parameters := make(url.Values)
-		parameters.Add("client_id", "itsyouonline")
-		parameters.Add("response_type", "token")
-		redirectURL = fmt.Sprintf("https://%s/v1/oauth/authorize?%s", request.Host, parameters.Encode())
 	}
 
 	sessions.Save(request, w)
@@ -391,30 +416,3 @@ func (service *Service) loginUser(w http.ResponseWriter, request *http.Request,
 	response.Redirecturl = redirectURL
 	json.NewEncoder(w).Encode(response)
 }
-
-func (service *Service) ForgotPassword(w http.ResponseWriter, request *http.Request) {
-	// login can be username or email
-	values := struct {
-		Login string `json:"login"`
-	}{}
-
-	if err := json.NewDecoder(request.Body).Decode(&values); err != nil {
-		log.Debug("Error decoding the ForgotPassword request:", err)
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-		return
-	}
-	userMgr := user.NewManager(request)
-	user, err := userMgr.FindByVerifiedEmailOrUsername(values.Login)
-	if err == mgo.ErrNotFound {
-		w.WriteHeader(http.StatusNotFound)
-		return
-	} else if err != nil {
-		log.Error(err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
-	}
-	// todo: implementation
-	log.Debug(user)
-	w.WriteHeader(http.StatusNoContent)
-	return
-}
\ No newline at end of file
@@ -0,0 +1,305 @@
+package siteservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log "github.com/Sirupsen/logrus"
+	"gopkg.in/mgo.v2"
+
+	"github.com/itsyouonline/identityserver/communication"
+	"github.com/itsyouonline/identityserver/credentials/password"
+	"github.com/itsyouonline/identityserver/credentials/totp"
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
+	"github.com/itsyouonline/identityserver/db/user"
+	validationdb "github.com/itsyouonline/identityserver/db/validation"
+	"github.com/itsyouonline/identityserver/oauthservice"
+)
+
+//passwordResetError tells the password reset pages why a request was refused
+type passwordResetError struct {
+	Error string `json:"error"`
+}
+
+//writePasswordResetError writes a 422 response with an error code the password reset pages can show
+func writePasswordResetError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+	json.NewEncoder(w).Encode(&passwordResetError{Error: code})
+}
+
+//validatedMainPhonenumber returns the main phonenumber of a user if it is validated, or an empty string
+func validatedMainPhonenumber(request *http.Request, u *user.User) (phonenumber string, err error) {
+	mainPhonenumber, found := u.Phone["main"]
+	if !found {
+		return
+	}
+	validatednumbers, err := validationdb.NewManager(request).GetByUsernameValidatedPhonenumbers(u.Username)
+	if err != nil {
+		return
+	}
+	for _, validatednumber := range validatednumbers {
+		if validatednumber.Phonenumber == string(mainPhonenumber) {
+			phonenumber = validatednumber.Phonenumber
+			return
+		}
+	}
+	return
+}
+
+//passwordResetLinkChannels returns where the password reset link can be sent to.
+// The link and the second factor code can not go to the same phone, users with sms as second factor only get the link by email.
+func passwordResetLinkChannels(twoFAMethod, phonenumber, email string) (linkPhonenumber, linkEmail string) {
+	linkEmail = email
+	if twoFAMethod != "sms" {
+		linkPhonenumber = phonenumber
+	}
+	return
+}
+
+//findUserByVerifiedEmailOrUsername returns the user with a username, or the user that validated an email address.
+// If several users validated the same email address, the first one that did is returned.
+func findUserByVerifiedEmailOrUsername(request *http.Request, usernameOrEmail string) (u *user.User, err error) {
+	userMgr := user.NewManager(request)
+	u, err = userMgr.GetByName(usernameOrEmail)
+	if err != mgo.ErrNotFound {
+		return
+	}
+	validatedemailaddresses, err := validationdb.NewManager(request).GetByEmailAddressValidatedEmailAddresses(usernameOrEmail)
+	if err != nil {
+		return
+	}
+	if len(validatedemailaddresses) == 0 {
+		err = mgo.ErrNotFound
+		return
+	}
+	u, err = userMgr.GetByName(validatedemailaddresses[0].Username)
+	return
+}
+
+//ForgotPassword sends a link to reset the password to the user that forgot it
+func (service *Service) ForgotPassword(w http.ResponseWriter, request *http.Request) {
+	// login can be username or email
+	values := struct {
+		Login string `json:"login"`
+	}{}
+
+	if err := json.NewDecoder(request.Body).Decode(&values); err != nil {
+		log.Debug("Error decoding the ForgotPassword request:", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if !service.checkLoginThrottle(w, request, "") {
+		return
+	}
+	u, err := findUserByVerifiedEmailOrUsername(request, values.Login)
+	if err == mgo.ErrNotFound {
+		service.registerLoginFailure(request, "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	phonenumber, err := validatedMainPhonenumber(request, u)
+	if err != nil {
+		log.Error("Error getting the validated phonenumbers: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	email, err := validationdb.NewManager(request).GetPreferredValidatedEmailAddress(u)
+	if err != nil {
+		log.Error("Error getting the validated email addresses: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	phonenumber, email = passwordResetLinkChannels(u.TwoFAMethod, phonenumber, email)
+	if phonenumber == "" && email == "" {
+		writePasswordResetError(w, "no_recovery_channel")
+		return
+	}
+
+	resetToken := password.NewResetToken(u.Username)
+	if err = password.NewManager(request).SaveResetToken(resetToken); err != nil {
+		log.Error("Error saving the password reset token: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	link := oauthservice.PublicURL + "/login#/resetpassword/" + resetToken.Token
+	expirationMinutes := int(password.ResetTokenExpiration.Minutes())
+	if email != "" {
+		data := struct {
+			Link              string
+			ExpirationMinutes int
+		}{Link: link, ExpirationMinutes: expirationMinutes}
+		go communication.SendTemplate(service.emailService, []string{email}, communication.PasswordResetEmail, data)
+	}
+	if phonenumber != "" {
+		smsmessage := fmt.Sprintf("To reset your itsyou.online password use this link within %d minutes: %s", expirationMinutes, link)
+		go service.smsService.Send(phonenumber, smsmessage)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//getPasswordResetToken returns the reset token that is submitted if it is valid.
+// If it is not, the response is written and nil is returned.
+func (service *Service) getPasswordResetToken(w http.ResponseWriter, request *http.Request, token string) (resetToken *password.ResetToken) {
+	if !service.checkLoginThrottle(w, request, "") {
+		return
+	}
+	resetToken, err := password.NewManager(request).GetResetToken(token)
+	if err != nil {
+		log.Error("Error getting the password reset token: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if resetToken == nil {
+		service.registerLoginFailure(request, "")
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	}
+	return
+}
+
+//StartPasswordReset is called when a password reset link is opened, it tells the page which second factor is required.
+// If the user does not use totp, a code is sent by sms.
+func (service *Service) StartPasswordReset(w http.ResponseWriter, request *http.Request) {
+	values := struct {
+		Token string `json:"token"`
+	}{}
+	if err := json.NewDecoder(request.Body).Decode(&values); err != nil {
+		log.Debug("Error decoding the password reset start request:", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	resetToken := service.getPasswordResetToken(w, request, values.Token)
+	if resetToken == nil {
+		return
+	}
+	u, err := user.NewManager(request).GetByName(resetToken.Username)
+	if err != nil {
+		log.Error("Error getting the user that resets the password: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	response := struct {
+		TwoFAMethod string `json:"twoFAMethod"`
+	}{TwoFAMethod: u.TwoFAMethod}
+	if response.TwoFAMethod == "" {
+		response.TwoFAMethod = "totp"
+	}
+	if response.TwoFAMethod == "sms" {
+		phonenumber, err := validatedMainPhonenumber(request, u)
+		if err != nil {
+			log.Error("Error getting the validated phonenumbers: ", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if phonenumber == "" {
+			writePasswordResetError(w, "no_recovery_channel")
+			return
+		}
+		smsCode, err := generateSMSCode()
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if err = password.NewManager(request).SetResetTokenSMSCode(resetToken.Token, smsCode); err != nil {
+			log.Error("Error saving the password reset sms code: ", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		smsmessage := fmt.Sprintf("To reset your itsyou.online password enter the code %s in the form", smsCode)
+		go service.smsService.Send(phonenumber, smsmessage)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&response)
+}
+
+//validPasswordResetCode checks the second factor code that is submitted to reset the password of a user
+func validPasswordResetCode(request *http.Request, resetToken *password.ResetToken, code string) (valid bool, err error) {
+	if resetToken.SMSCode != "" {
+		valid = code == resetToken.SMSCode
+		return
+	}
+	valid, err = totp.NewManager(request).Validate(resetToken.Username, code)
+	return
+}
+
+//ResetPassword sets a new password for a user with a reset token and second factor code.
+// All sessions and website tokens of the user are invalidated.
+func (service *Service) ResetPassword(w http.ResponseWriter, request *http.Request) {
+	values := struct {
+		Token    string `json:"token"`
+		Code     string `json:"code"`
+		Password string `json:"password"`
+	}{}
+	if err := json.NewDecoder(request.Body).Decode(&values); err != nil {
+		log.Debug("Error decoding the password reset request:", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	resetToken := service.getPasswordResetToken(w, request, values.Token)
+	if resetToken == nil {
+		return
+	}
+	username := resetToken.Username
+	if !service.checkLoginThrottle(w, request, username) {
+		return
+	}
+	validcode, err := validPasswordResetCode(request, resetToken, values.Code)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !validcode {
+		service.registerLoginFailure(request, username)
+		writePasswordResetError(w, "invalid_code")
+		return
+	}
+	u, err := user.NewManager(request).GetByName(username)
+	if err != nil {
+		log.Error("Error getting the user that resets the password: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	passwdMgr := password.NewManager(request)
+	//check the password before the token is redeemed so the user can choose another one
+	err = passwdMgr.CheckPolicy(username, values.Password, u.GetEmailAddresses())
+	if password.IsPolicyError(err) {
+		writePasswordResetError(w, err.Error())
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	redeemed, err := passwdMgr.RedeemResetToken(resetToken.Token)
+	if err != nil {
+		log.Error("Error redeeming the password reset token: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !redeemed {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err = passwdMgr.Save(username, values.Password, u.GetEmailAddresses()); err != nil {
+		log.Error("Error saving the new password: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	log.Info("The password of ", username, " is reset")
+
+	if err = sessiondb.NewManager(request).DeleteAllForUser(username); err != nil {
+		log.Error("Error removing the sessions after a password reset: ", err)
+	}
+	if err = oauthservice.NewManager(request).RevokeClientTokensForUser(username, "itsyouonline"); err != nil {
+		log.Error("Error revoking the website tokens after a password reset: ", err)
+	}
+	service.resetLoginThrottle(request, username)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,26 @@
+package siteservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordResetLinkChannels(t *testing.T) {
+	phonenumber, email := passwordResetLinkChannels("totp", "+32123456789", "bob@example.com")
+	assert.Equal(t, "+32123456789", phonenumber)
+	assert.Equal(t, "bob@example.com", email)
+
+	phonenumber, email = passwordResetLinkChannels("", "+32123456789", "")
+	assert.Equal(t, "+32123456789", phonenumber)
+	assert.Equal(t, "", email)
+
+	//The second factor code is sent to the phone, the link can not go there as well
+	phonenumber, email = passwordResetLinkChannels("sms", "+32123456789", "bob@example.com")
+	assert.Equal(t, "", phonenumber)
+	assert.Equal(t, "bob@example.com", email)
+
+	phonenumber, email = passwordResetLinkChannels("sms", "+32123456789", "")
+	assert.Equal(t, "", phonenumber)
+	assert.Equal(t, "", email)
+}
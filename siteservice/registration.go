@@ -321,19 +321,27 @@ func (service *Service) ProcessRegistrationForm(w http.ResponseWriter, request *
 		}
 	}
 
+	passwdMgr := password.NewManager(request)
+	//check the password before the user is stored so a refused password does not leave a user without one
+	err = passwdMgr.CheckPolicy(newuser.Username, values.Password, newuser.GetEmailAddresses())
+	if password.IsPolicyError(err) {
+		log.Debug("Password refused during registration: ", err)
+		w.WriteHeader(422)
+		response.Error = err.Error()
+		json.NewEncoder(w).Encode(&response)
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
 	//TODO: this should only be a temporary user registration (until the email/phone validation is completed)
 	userMgr.Save(newuser)
-	passwdMgr := password.NewManager(request)
-	err = passwdMgr.Save(newuser.Username, values.Password)
+	err = passwdMgr.Save(newuser.Username, values.Password, newuser.GetEmailAddresses())
 	if err != nil {
 		log.Error(err)
-		if (err.Error() != "internal_error") {
-			w.WriteHeader(422)
-			response.Error = "invalid_password";
-			json.NewEncoder(w).Encode(&response)
-		} else {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
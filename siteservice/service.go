@@ -7,6 +7,10 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
 	"github.com/itsyouonline/identityserver/communication"
+	"github.com/itsyouonline/identityserver/credentials/password"
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
+	"github.com/itsyouonline/identityserver/db/throttle"
+	validationdb "github.com/itsyouonline/identityserver/db/validation"
 	"github.com/itsyouonline/identityserver/siteservice/apiconsole"
 	"github.com/itsyouonline/identityserver/siteservice/website/packaged/assets"
 	"github.com/itsyouonline/identityserver/siteservice/website/packaged/components"
@@ -23,16 +27,19 @@ import (
 
 //Service is the identityserver http service
 type Service struct {
-	Sessions                     map[SessionType]*sessions.CookieStore
+	Sessions                     map[SessionType]sessions.Store
 	smsService                   communication.SMSService
+	emailService                 communication.EmailService
 	phonenumberValidationService *validation.IYOPhonenumberValidationService
+	emailValidationService       *validation.IYOEmailValidationService
 }
 
 //NewService creates and initializes a Service
-func NewService(cookieSecret string, smsService communication.SMSService) (service *Service) {
-	service = &Service{smsService: smsService}
+func NewService(cookieSecret string, smsService communication.SMSService, emailService communication.EmailService) (service *Service) {
+	service = &Service{smsService: smsService, emailService: emailService}
 	p := &validation.IYOPhonenumberValidationService{SMSService: smsService}
 	service.phonenumberValidationService = p
+	service.emailValidationService = &validation.IYOEmailValidationService{EmailService: emailService}
 	service.initializeSessions(cookieSecret)
 	return
 }
@@ -40,7 +47,11 @@ func NewService(cookieSecret string, smsService communication.SMSService) (servi
 //InitModels initialize persistance models
 func (service *Service) InitModels() {
 	service.initLoginModels()
+	password.InitModels()
+	throttle.InitModels()
 	service.initRegistrationModels()
+	sessiondb.InitModels()
+	validationdb.InitModels()
 }
 
 //AddRoutes registers the http routes with the router
@@ -51,6 +62,7 @@ func (service *Service) AddRoutes(router *mux.Router) {
 	router.Methods("GET").Path("/register").HandlerFunc(service.ShowRegistrationForm)
 	router.Methods("POST").Path("/register").HandlerFunc(service.ProcessRegistrationForm)
 	router.Methods("GET").Path("/phonevalidation").HandlerFunc(service.PhonenumberValidation)
+	router.Methods("GET").Path("/emailvalidation").HandlerFunc(service.EmailAddressValidation)
 	router.Methods("POST").Path("/register/resendsms").HandlerFunc(service.ResendPhonenumberConfirmation)
 	router.Methods("GET").Path("/register/smsconfirmed").HandlerFunc(service.CheckRegistrationSMSConfirmation)
 	router.Methods("POST").Path("/register/smsconfirmation").HandlerFunc(service.ProcessPhonenumberConfirmationForm)
@@ -62,14 +74,18 @@ func (service *Service) AddRoutes(router *mux.Router) {
 	router.Methods("GET").Path("/sc").HandlerFunc(service.MobileSMSConfirmation)
 	router.Methods("GET").Path("/login/smsconfirmed").HandlerFunc(service.Check2FASMSConfirmation)
 	router.Methods("POST").Path("/login/forgotpassword").HandlerFunc(service.ForgotPassword)
+	router.Methods("POST").Path("/login/resetpassword/start").HandlerFunc(service.StartPasswordReset)
+	router.Methods("POST").Path("/login/resetpassword").HandlerFunc(service.ResetPassword)
 	//Authorize form
 	router.Methods("GET").Path("/authorize").HandlerFunc(service.ShowAuthorizeForm)
+	//Device authorization form
+	router.Methods("GET").Path("/device").HandlerFunc(service.ShowDeviceForm)
 	//Facebook callback
 	router.Methods("GET").Path("/facebook_callback").HandlerFunc(service.FacebookCallback)
 	//Github callback
 	router.Methods("GET").Path("/github_callback").HandlerFunc(service.GithubCallback)
-	//Logout link
-	router.Methods("GET").Path("/logout").HandlerFunc(service.Logout)
+	//Logout form, protected by the csrf middleware
+	router.Methods("POST").Path("/logout").HandlerFunc(service.Logout)
 	//Error page
 	router.Methods("GET").Path("/error").HandlerFunc(service.ErrorPage)
 	router.Methods("GET").Path("/error{errornumber}").HandlerFunc(service.ErrorPage)
@@ -142,12 +158,11 @@ func (service *Service) HomePage(w http.ResponseWriter, request *http.Request) {
 	w.Write(htmlData)
 }
 
-//Logout logs out the user and redirect to the homepage
-//TODO: csrf protection, really important here!
+//Logout logs out the user using the end session endpoint of the oauthservice,
+// this way the applications the user logged in to during this session are notified.
+// The form is posted again to the end session endpoint so it receives the csrf token as well.
 func (service *Service) Logout(w http.ResponseWriter, request *http.Request) {
-	service.SetLoggedInUser(w, request, "")
-	sessions.Save(request, w)
-	http.Redirect(w, request, "", http.StatusFound)
+	http.Redirect(w, request, "/v1/oauth/logout", http.StatusTemporaryRedirect)
 }
 
 //ErrorPage shows the errorpage
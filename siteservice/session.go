@@ -2,9 +2,13 @@ package siteservice
 
 import (
 	"net/http"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/gorilla/sessions"
+
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
+	"github.com/itsyouonline/identityserver/oauthservice"
 )
 
 //SessionType is used to define the type of session
@@ -17,6 +21,8 @@ const (
 	SessionInteractive SessionType = iota
 	//SessionLogin is the session during the login flow
 	SessionLogin SessionType = iota
+	//SessionCSRF is the session that keeps the csrf token
+	SessionCSRF SessionType = iota
 )
 
 //initializeSessionStore creates a cookieStore
@@ -31,11 +37,12 @@ func initializeSessionStore(cookieSecret string, maxAge int) (sessionStore *sess
 }
 
 func (service *Service) initializeSessions(cookieSecret string) {
-	service.Sessions = make(map[SessionType]*sessions.CookieStore)
+	service.Sessions = make(map[SessionType]sessions.Store)
 
 	service.Sessions[SessionForRegistration] = initializeSessionStore(cookieSecret, 10*60)
-	service.Sessions[SessionInteractive] = initializeSessionStore(cookieSecret, 10*60)
+	service.Sessions[SessionInteractive] = newMongoStore(cookieSecret, sessiondb.MaxInactivity)
 	service.Sessions[SessionLogin] = initializeSessionStore(cookieSecret, 5*60)
+	service.Sessions[SessionCSRF] = initializeCSRFSessionStore(cookieSecret)
 
 }
 
@@ -51,31 +58,34 @@ func (service *Service) SetLoggedInUser(w http.ResponseWriter, request *http.Req
 		log.Error(err)
 		return
 	}
+	//Every login gets a new session id to prevent session fixation, the previous session and its api tokens can not be used anymore
+	if authenticatedSession.ID != "" {
+		if err = sessiondb.NewManager(request).Delete(authenticatedSession.ID); err != nil {
+			log.Error(err)
+			return
+		}
+		if err = oauthservice.NewManager(request).RevokeSessionTokens(authenticatedSession.ID); err != nil {
+			log.Error(err)
+			return
+		}
+		authenticatedSession.ID = ""
+	}
 	authenticatedSession.Values["username"] = username
+	authenticatedSession.Values["authtime"] = time.Now().Unix()
 
-	//TODO: rework this, is not really secure I think
-	// Set user cookie after successful login
-	cookie := &http.Cookie{
-		Name:  "itsyou.online.user",
-		Path:  "/",
-		Value: username,
+	//The csrf token is renewed as well, tokens that leaked before the login are useless
+	if _, err = service.renewCSRFToken(w, request); err != nil {
+		log.Error(err)
 	}
-	http.SetCookie(w, cookie)
-
 	return
 }
 
-//SetAPIAccessToken sets the api access token in a cookie
-//TODO: is not safe to do. Now there are also two ways of passing tokens to the client
-func (service *Service) SetAPIAccessToken(w http.ResponseWriter, token string) (err error) {
-	// Set token cookie
-	cookie := &http.Cookie{
-		Name:  "itsyou.online.apitoken",
-		Path:  "/",
-		Value: token,
+//ClearLoggedInUser ends the session of the authenticated user
+func (service *Service) ClearLoggedInUser(w http.ResponseWriter, request *http.Request) (err error) {
+	if err = service.SetLoggedInUser(w, request, ""); err != nil {
+		return
 	}
-	http.SetCookie(w, cookie)
-
+	err = sessions.Save(request, w)
 	return
 }
 
@@ -92,3 +102,29 @@ func (service *Service) GetLoggedInUser(request *http.Request) (username string,
 	}
 	return
 }
+
+//GetAuthenticationTime returns the time the logged in user authenticated, or the zero time if unknown
+func (service *Service) GetAuthenticationTime(request *http.Request) (authTime time.Time, err error) {
+	authenticatedSession, err := service.GetSession(request, SessionInteractive, "authenticatedsession")
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if savedAuthTime, ok := authenticatedSession.Values["authtime"].(int64); ok {
+		authTime = time.Unix(savedAuthTime, 0)
+	}
+	return
+}
+
+//GetSessionID returns the id of the session of the logged in user, or an empty string if there is none
+func (service *Service) GetSessionID(request *http.Request) (sessionID string, err error) {
+	authenticatedSession, err := service.GetSession(request, SessionInteractive, "authenticatedsession")
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if username, _ := authenticatedSession.Values["username"].(string); username != "" {
+		sessionID = authenticatedSession.ID
+	}
+	return
+}
@@ -0,0 +1,169 @@
+package siteservice
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+
+	sessiondb "github.com/itsyouonline/identityserver/db/session"
+)
+
+//mongoStore is a session store that keeps the values of a session in mongo, the cookie only contains the signed session id.
+// This way the sessions of a user can be listed and a stolen session cookie can be invalidated.
+// Only sessions of logged in users are stored.
+type mongoStore struct {
+	Codecs  []securecookie.Codec
+	Options *sessions.Options
+}
+
+//newMongoStore creates a mongoStore, sessions expire when they are not used for maxInactivity
+func newMongoStore(cookieSecret string, maxInactivity time.Duration) *mongoStore {
+	return &mongoStore{
+		Codecs: securecookie.CodecsFromPairs([]byte(cookieSecret)),
+		Options: &sessions.Options{
+			Path:     "/",
+			MaxAge:   int(maxInactivity.Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+		},
+	}
+}
+
+//Get returns a session for the given name after adding it to the registry
+func (s *mongoStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+//New returns a session for the given name without adding it to the registry.
+// An invalid cookie or a session that is revoked or expired results in a new session.
+func (s *mongoStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(s, name)
+	opts := *s.Options
+	session.Options = &opts
+	session.IsNew = true
+
+	c, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+	var sessionID string
+	if err = securecookie.DecodeMulti(name, c.Value, &sessionID, s.Codecs...); err != nil {
+		return session, nil
+	}
+	stored, err := sessiondb.NewManager(r).Get(sessionID)
+	if err != nil || stored == nil || stored.IsExpiredAt(time.Duration(s.Options.MaxAge)*time.Second, time.Now()) {
+		return session, err
+	}
+	if err = securecookie.DecodeMulti(name, stored.Values, &session.Values, s.Codecs...); err != nil {
+		return session, nil
+	}
+	session.ID = sessionID
+	session.IsNew = false
+	return session, nil
+}
+
+//Save stores the session and sets the session cookie, a session without a logged in user is removed
+func (s *mongoStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) (err error) {
+	mgr := sessiondb.NewManager(r)
+	username, _ := session.Values["username"].(string)
+	if username == "" || session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			if err = mgr.Delete(session.ID); err != nil {
+				return
+			}
+			session.ID = ""
+		}
+		opts := *session.Options
+		opts.MaxAge = -1
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", &opts))
+		return
+	}
+
+	if session.ID == "" {
+		session.ID = newSessionID()
+	}
+	encodedValues, err := securecookie.EncodeMulti(session.Name(), session.Values, s.Codecs...)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	err = mgr.Save(&sessiondb.Session{
+		SessionID:    session.ID,
+		Username:     username,
+		Values:       encodedValues,
+		Device:       deviceFromUserAgent(r.UserAgent()),
+		IP:           remoteIP(r),
+		UserAgent:    r.UserAgent(),
+		CreatedAt:    now,
+		LastActivity: now,
+	})
+	if err != nil {
+		return
+	}
+	encodedID, err := securecookie.EncodeMulti(session.Name(), session.ID, s.Codecs...)
+	if err != nil {
+		return
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encodedID, session.Options))
+	return
+}
+
+//newSessionID generates a random session id that can be used in an url
+func newSessionID() string {
+	randombytes := make([]byte, 30) //Multiple of 3 to make sure no padding is added
+	rand.Read(randombytes)
+	return base64.URLEncoding.EncodeToString(randombytes)
+}
+
+//remoteIP returns the ip address the request came from
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+//deviceFromUserAgent describes the browser and operating system of a user agent, for example "Firefox on Windows"
+func deviceFromUserAgent(userAgent string) string {
+	browser := "Unknown browser"
+	for _, b := range []struct{ token, name string }{
+		{"Edge/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Chrome/", "Chrome"},
+		{"CriOS/", "Chrome"},
+		{"Firefox/", "Firefox"},
+		{"Safari/", "Safari"},
+		{"MSIE ", "Internet Explorer"},
+		{"Trident/", "Internet Explorer"},
+	} {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	os := ""
+	for _, o := range []struct{ token, name string }{
+		{"Windows", "Windows"},
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iOS"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	} {
+		if strings.Contains(userAgent, o.token) {
+			os = o.name
+			break
+		}
+	}
+	if os == "" {
+		return browser
+	}
+	return browser + " on " + os
+}
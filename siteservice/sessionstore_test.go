@@ -0,0 +1,36 @@
+package siteservice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceFromUserAgent(t *testing.T) {
+	testcases := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:47.0) Gecko/20100101 Firefox/47.0":                                                            "Firefox on Windows",
+		"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/51.0.2704.103 Safari/537.36":                                 "Chrome on Linux",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/46.0.2486.0 Safari/537.36 Edge/13.10586":           "Edge on Windows",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 9_3_2 like Mac OS X) AppleWebKit/601.1.46 (KHTML, like Gecko) Version/9.0 Mobile/13F69 Safari/601.1":    "Safari on iOS",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_11_5) AppleWebKit/601.6.17 (KHTML, like Gecko) Version/9.1.1 Safari/601.6.17":                    "Safari on macOS",
+		"Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/51.0.2704.81 Mobile Safari/537.36": "Chrome on Android",
+		"curl/7.47.0": "Unknown browser",
+	}
+	for userAgent, device := range testcases {
+		assert.Equal(t, device, deviceFromUserAgent(userAgent), userAgent)
+	}
+}
+
+func TestRemoteIP(t *testing.T) {
+	r := &http.Request{RemoteAddr: "192.0.2.1:1234"}
+	assert.Equal(t, "192.0.2.1", remoteIP(r))
+	r.RemoteAddr = "[2001:db8::1]:1234"
+	assert.Equal(t, "2001:db8::1", remoteIP(r))
+}
+
+func TestNewSessionID(t *testing.T) {
+	sessionID := newSessionID()
+	assert.Len(t, sessionID, 40)
+	assert.NotEqual(t, sessionID, newSessionID())
+}
@@ -0,0 +1,127 @@
+package siteservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/itsyouonline/identityserver/db/throttle"
+	"github.com/itsyouonline/identityserver/db/user"
+)
+
+//throttledResponse tells the login page why an attempt was refused and when it can try again
+type throttledResponse struct {
+	Error      string `json:"error"`
+	RetryAfter int64  `json:"retryafter"`
+}
+
+//loginRetryAfter returns how long to wait before a login attempt for a username from the ip address of the request is allowed.
+// An empty username only checks the ip address.
+func loginRetryAfter(request *http.Request, username string) (retryAfter time.Duration, locked bool, err error) {
+	mgr := throttle.NewManager(request)
+	now := time.Now()
+
+	ipThrottle, err := mgr.Get(throttle.IPKey(remoteIP(request)))
+	if err != nil {
+		return
+	}
+	if ipThrottle != nil {
+		retryAfter = ipThrottle.RetryAfter(throttle.IPFreeAttempts, now)
+	}
+	if username == "" {
+		return
+	}
+	userThrottle, err := mgr.Get(throttle.UserKey(username))
+	if err != nil || userThrottle == nil {
+		return
+	}
+	if userRetryAfter := userThrottle.RetryAfter(throttle.FreeAttempts, now); userRetryAfter > retryAfter {
+		retryAfter = userRetryAfter
+	}
+	locked = userThrottle.IsLockedAt(now)
+	return
+}
+
+//checkLoginThrottle checks if a login attempt for a username from the ip address of the request is allowed.
+// If it is not, the response is written and false is returned.
+func (service *Service) checkLoginThrottle(w http.ResponseWriter, request *http.Request, username string) (allowed bool) {
+	retryAfter, locked, err := loginRetryAfter(request, username)
+	if err != nil {
+		log.Error("Error getting the failed login attempts: ", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if retryAfter <= 0 {
+		allowed = true
+		return
+	}
+
+	response := throttledResponse{Error: "too_many_attempts", RetryAfter: retryAfterSeconds(retryAfter)}
+	if locked {
+		response.Error = "account_locked"
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(response.RetryAfter, 10))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(&response)
+	return
+}
+
+//registerLoginFailure records a failed login attempt for a username from the ip address of the request,
+// the user is notified when this attempt locks the account
+func (service *Service) registerLoginFailure(request *http.Request, username string) {
+	mgr := throttle.NewManager(request)
+	if _, _, err := mgr.RegisterFailure(throttle.IPKey(remoteIP(request)), false); err != nil {
+		log.Error("Error registering a failed login attempt: ", err)
+	}
+	if username == "" {
+		return
+	}
+	_, locked, err := mgr.RegisterFailure(throttle.UserKey(username), true)
+	if err != nil {
+		log.Error("Error registering a failed login attempt: ", err)
+		return
+	}
+	if locked {
+		log.Info("The account of ", username, " is locked after too many failed login attempts")
+		service.notifyAccountLocked(request, username)
+	}
+}
+
+//resetLoginThrottle forgets the failed login attempts of a user after a successful login
+func (service *Service) resetLoginThrottle(request *http.Request, username string) {
+	if err := throttle.NewManager(request).Reset(throttle.UserKey(username)); err != nil {
+		log.Error("Error resetting the failed login attempts: ", err)
+	}
+}
+
+//notifyAccountLocked lets a user know by sms that the account is locked
+func (service *Service) notifyAccountLocked(request *http.Request, username string) {
+	u, err := user.NewManager(request).GetByName(username)
+	if err != nil {
+		//The username does not need to exist for failed attempts to be counted
+		log.Debug("Not notifying ", username, " about the locked account: ", err)
+		return
+	}
+	phonenumber, found := u.Phone["main"]
+	if !found {
+		for _, phonenumber = range u.Phone {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return
+	}
+	message := fmt.Sprintf("Your itsyou.online account is locked for %d minutes after too many failed login attempts. If this was not you, change your password after the lock expires.", int(throttle.LockoutDuration.Minutes()))
+	go service.smsService.Send(string(phonenumber), message)
+}
+
+//retryAfterSeconds rounds a wait time up to whole seconds
+func retryAfterSeconds(wait time.Duration) int64 {
+	return int64((wait + time.Second - 1) / time.Second)
+}
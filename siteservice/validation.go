@@ -34,3 +34,31 @@ func (service *Service) PhonenumberValidation(w http.ResponseWriter, request *ht
 
 	service.renderSMSConfirmationPage(w, request, "Your phonenumber is confirmed")
 }
+
+//EmailAddressValidation is the page that is linked to in the email for emailaddressvalidation
+func (service *Service) EmailAddressValidation(w http.ResponseWriter, request *http.Request) {
+
+	err := request.ParseForm()
+	if err != nil {
+		log.Debug(err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	values := request.Form
+	key := values.Get("k")
+	secret := values.Get("c")
+
+	err = service.emailValidationService.ConfirmValidation(request, key, secret)
+	if err == validation.ErrInvalidCode || err == validation.ErrInvalidOrExpiredKey {
+		service.renderSMSConfirmationPage(w, request, "Invalid or expired link")
+		return
+	}
+	if err != nil {
+		log.Error(err)
+		service.renderSMSConfirmationPage(w, request, "An unexpected error occurred, please try again later")
+		return
+	}
+
+	service.renderSMSConfirmationPage(w, request, "Your email address is confirmed")
+}
@@ -1,14 +1,29 @@
 (function () {
     'use strict';
     angular
-        .module('itsyouonlineApp', ['ngCookies', 'ngMaterial', 'ngRoute', 'ngMessages',
+        .module('itsyouonlineApp', ['ngMaterial', 'ngRoute', 'ngMessages',
             'itsyouonline.shared', 'itsyouonline.header', 'itsyouonline.footer'])
         .config(['$mdThemingProvider', themingConfig])
         .config(['$httpProvider', httpConfig])
         .config(['$routeProvider', routeConfig])
-        .factory('authenticationInterceptor', ['$q', '$window', authenticationInterceptor])
+        .factory('authenticationInterceptor', ['$q', '$window', 'session', authenticationInterceptor])
         .directive('pagetitle', ['$rootScope', '$timeout', pagetitle])
-        .run(['$cookies', '$rootScope', '$location', runFunction]);
+        .run(['$rootScope', 'session', runFunction]);
+
+    //The api token is bound to the session of the logged in user, fetch it before starting the app.
+    // The X-Requested-With header prevents other sites from getting a token.
+    angular.element(document).ready(function () {
+        var $http = angular.injector(['ng']).get('$http');
+        $http.post('v1/oauth/session/token', null, {headers: {'X-Requested-With': 'XMLHttpRequest'}}).then(
+            function (response) {
+                angular.module('itsyouonlineApp').constant('session', response.data);
+                angular.bootstrap(document, ['itsyouonlineApp']);
+            },
+            function () {
+                window.location.href = '/login';
+            }
+        );
+    });
 
     function themingConfig($mdThemingProvider) {
         $mdThemingProvider.definePalette('blueish', {
@@ -43,14 +58,14 @@
         $httpProvider.defaults.headers.get['If-Modified-Since'] = '0';
     }
 
-    function authenticationInterceptor($q, $window) {
+    function authenticationInterceptor($q, $window, session) {
         return {
             'request': function (config) {
                 if (config) {
                     var url = config.url;
 
                     if (/(api\/)/i.test(url)) {
-                        config.headers["Authorization"] = "token " + sessionStorage.getItem("oauthToken");
+                        config.headers["Authorization"] = "token " + session.access_token;
                     }
                 }
                 return config || $q.when(config);
@@ -132,13 +147,7 @@
         };
     }
 
-    function runFunction($cookies, $rootScope, $location) {
-        var pathParts = $location.path().split("=");
-        if (pathParts.length === 2 && pathParts[0] === "/token") {
-            sessionStorage.setItem("oauthToken", pathParts[1]);
-            $location.path("/");
-            $location.replace();
-        }
-        $rootScope.user = $cookies.get('itsyou.online.user');
+    function runFunction($rootScope, session) {
+        $rootScope.user = session.username;
     }
 })();
\ No newline at end of file
@@ -21,6 +21,11 @@
                         case 404:
                             $scope.form.login.$setValidity("invalid", false);
                             break;
+                        case 422:
+                        case 429:
+                            // no_recovery_channel, account_locked or too_many_attempts
+                            $scope.form.login.$setValidity(response.data.error, false);
+                            break;
                         default:
                             $window.location.href = 'error' + response.status;
                     }
@@ -30,6 +35,9 @@
 
         function clearValidation() {
             $scope.form.login.$setValidity("invalid", true);
+            $scope.form.login.$setValidity("no_recovery_channel", true);
+            $scope.form.login.$setValidity("account_locked", true);
+            $scope.form.login.$setValidity("too_many_attempts", true);
         }
     }
 })();
\ No newline at end of file
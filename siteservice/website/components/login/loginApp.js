@@ -50,6 +50,11 @@
                 controller: 'forgotPasswordController',
                 controllerAs: 'vm'
             })
+            .when('/resetpassword/:token', {
+                templateUrl: 'components/login/views/resetpassword.html',
+                controller: 'resetPasswordController',
+                controllerAs: 'vm'
+            })
             .otherwise('/');
     }
 })();
\ No newline at end of file
@@ -23,12 +23,18 @@
                     if (response.status === 422) {
                         $scope.loginform.password.$setValidity("invalidcredentials", false);
                     }
+                    if (response.status === 429) {
+                        // too many failed attempts, the error is account_locked or too_many_attempts
+                        $scope.loginform.password.$setValidity(response.data.error, false);
+                    }
                 }
             );
         }
 
         function clearValidation() {
             $scope.loginform.password.$setValidity("invalidcredentials", true);
+            $scope.loginform.password.$setValidity("account_locked", true);
+            $scope.loginform.password.$setValidity("too_many_attempts", true);
         }
     }
 })();
\ No newline at end of file
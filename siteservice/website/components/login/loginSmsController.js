@@ -42,6 +42,10 @@
                         case 422:
                             $scope.smsform.smscode.$setValidity("invalid_code", false);
                             break;
+                        case 429:
+                            // too many failed attempts, the error is account_locked or too_many_attempts
+                            $scope.smsform.smscode.$setValidity(response.data.error, false);
+                            break;
                         case 401:
                             // Login session expired. Go back to username/password screen.
                             $window.location.hash = '#/';
@@ -55,6 +59,8 @@
 
         function resetValidation() {
             $scope.smsform.smscode.$setValidity("invalid_code", true);
+            $scope.smsform.smscode.$setValidity("account_locked", true);
+            $scope.smsform.smscode.$setValidity("too_many_attempts", true);
         }
     }
 })();
\ No newline at end of file
@@ -24,6 +24,10 @@
                         case 422:
                             $scope.totpform.totpcode.$setValidity("invalid_code", false);
                             break;
+                        case 429:
+                            // too many failed attempts, the error is account_locked or too_many_attempts
+                            $scope.totpform.totpcode.$setValidity(response.data.error, false);
+                            break;
                         case 401:
                             // Login session expired. Go back to username/password screen.
                             $window.location.hash = '#/';
@@ -37,6 +41,8 @@
 
         function resetValidation() {
             $scope.totpform.totpcode.$setValidity("invalid_code", true);
+            $scope.totpform.totpcode.$setValidity("account_locked", true);
+            $scope.totpform.totpcode.$setValidity("too_many_attempts", true);
         }
     }
 })();
\ No newline at end of file
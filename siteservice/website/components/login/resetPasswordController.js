@@ -0,0 +1,79 @@
+(function () {
+    'use strict';
+    angular.module('loginApp')
+        .controller('resetPasswordController', ['$http', '$window', '$scope', '$routeParams', resetPasswordController]);
+
+    function resetPasswordController($http, $window, $scope, $routeParams) {
+        var vm = this;
+        vm.submit = submit;
+        vm.clearValidation = clearValidation;
+        vm.twoFAMethod = '';
+        vm.invalidLink = false;
+        vm.passwordReset = false;
+
+        start();
+
+        function start() {
+            $http.post('/login/resetpassword/start', {token: $routeParams.token}).then(
+                function (response) {
+                    vm.twoFAMethod = response.data.twoFAMethod;
+                },
+                function (response) {
+                    switch (response.status) {
+                        case 404:
+                        case 422:
+                        case 429:
+                            vm.invalidLink = true;
+                            break;
+                        default:
+                            $window.location.href = 'error' + response.status;
+                    }
+                }
+            );
+        }
+
+        function submit() {
+            var data = {
+                token: $routeParams.token,
+                code: vm.code,
+                password: vm.password
+            };
+            $http.post('/login/resetpassword', data).then(
+                function (response) {
+                    vm.passwordReset = true;
+                },
+                function (response) {
+                    switch (response.status) {
+                        case 404:
+                            vm.invalidLink = true;
+                            break;
+                        case 422:
+                            if (response.data.error.indexOf('password_') === 0) {
+                                $scope.form.password.$setValidity(response.data.error, false);
+                            } else {
+                                $scope.form.code.$setValidity(response.data.error, false);
+                            }
+                            break;
+                        case 429:
+                            // account_locked or too_many_attempts
+                            $scope.form.code.$setValidity(response.data.error, false);
+                            break;
+                        default:
+                            $window.location.href = 'error' + response.status;
+                    }
+                }
+            );
+        }
+
+        function clearValidation() {
+            $scope.form.code.$setValidity("invalid_code", true);
+            $scope.form.code.$setValidity("account_locked", true);
+            $scope.form.code.$setValidity("too_many_attempts", true);
+            $scope.form.password.$setValidity("password_too_short", true);
+            $scope.form.password.$setValidity("password_contains_username", true);
+            $scope.form.password.$setValidity("password_contains_email", true);
+            $scope.form.password.$setValidity("password_reused", true);
+            $scope.form.password.$setValidity("password_breached", true);
+        }
+    }
+})();
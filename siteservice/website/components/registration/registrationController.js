@@ -44,7 +44,10 @@
                                 case 'invalid_totpcode':
                                     $scope.signupform.totpcode.$setValidity(err, false);
                                     break;
-                                case 'invalid_password':
+                                case 'password_too_short':
+                                case 'password_contains_username':
+                                case 'password_contains_email':
+                                case 'password_breached':
                                     $scope.signupform.password.$setValidity(err, false);
                                     break;
                                 case 'invalid_username_format':
@@ -76,6 +79,12 @@
                 case 'totpcode':
                     $scope.signupform[prop].$setValidity("invalid_totpcode", true);
                     break;
+                case 'password':
+                    $scope.signupform[prop].$setValidity("password_too_short", true);
+                    $scope.signupform[prop].$setValidity("password_contains_username", true);
+                    $scope.signupform[prop].$setValidity("password_contains_email", true);
+                    $scope.signupform[prop].$setValidity("password_breached", true);
+                    break;
                 case 'twoFAMethod':
                     $scope.signupform.totpcode.$setValidity("totpcode", true);
                     $scope.signupform.phonenumber.$setValidity("invalid_phonenumber", true);
@@ -8,6 +8,11 @@
                 templateUrl: 'components/shared/directives/header.html',
                 link: function (scope, element, attr) {
                     scope.header_login = attr.register !== undefined;
+                    // the logout form posts the csrf token of the session
+                    scope.csrfToken = function () {
+                        var match = document.cookie.match(/(?:^|;\s*)XSRF-TOKEN=([^;]*)/);
+                        return match ? decodeURIComponent(match[1]) : '';
+                    };
                 }
             };
         });
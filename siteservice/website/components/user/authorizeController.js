@@ -133,17 +133,39 @@
                 .saveAuthorization($scope.authorizations)
                 .then(
                     function (data) {
-                        var u = URI($location.absUrl());
                         var endpoint = queryParams["endpoint"];
                         delete queryParams.endpoint;
-                        u.pathname(endpoint);
-                        u.search(queryParams);
-                        $window.location.href = u.toString();
+                        // proves to the authorization endpoint that the user submitted this page,
+                        // it is posted so the token does not end up in urls
+                        queryParams.csrf_token = csrfToken();
+                        submitForm(endpoint, queryParams);
                     },
                     function(reason) {
                         $window.location.href = "error" + reason.status;
                     }
                 );
         }
+
+        function submitForm(action, params) {
+            var form = document.createElement('form');
+            form.method = 'post';
+            form.action = action;
+            angular.forEach(params, function (value, key) {
+                angular.forEach(angular.isArray(value) ? value : [value], function (item) {
+                    var input = document.createElement('input');
+                    input.type = 'hidden';
+                    input.name = key;
+                    input.value = item;
+                    form.appendChild(input);
+                });
+            });
+            document.body.appendChild(form);
+            form.submit();
+        }
+
+        function csrfToken() {
+            var match = document.cookie.match(/(?:^|;\s*)XSRF-TOKEN=([^;]*)/);
+            return match ? decodeURIComponent(match[1]) : '';
+        }
     }
 })();
@@ -50,11 +50,16 @@
         vm.loadOrganizations = loadOrganizations;
         vm.loadUser = loadUser;
         vm.loadAuthorizations = loadAuthorizations;
+        vm.loadSessions = loadSessions;
+        vm.deleteSession = deleteSession;
+        vm.logoutEverywhere = logoutEverywhere;
         vm.loadVerifiedPhones = loadVerifiedPhones;
+        vm.loadVerifiedEmails = loadVerifiedEmails;
         vm.showAuthorizationDetailDialog = showAuthorizationDetailDialog;
         vm.showChangePasswordDialog = showChangePasswordDialog;
         vm.showEditNameDialog = showEditNameDialog;
         vm.verifyPhone = verifyPhone;
+        vm.verifyEmail = verifyEmail;
 
         var genericDetailControllerParams = ['$scope', '$mdDialog', 'username', '$window', 'label', 'data',
             'createFunction', 'updateFunction', 'deleteFunction', GenericDetailDialogController];
@@ -117,6 +122,34 @@
                 );
         }
 
+        function loadSessions() {
+            UserService.getSessions(vm.username)
+                .then(
+                    function (data) {
+                        vm.sessions = data;
+                        vm.loaded.sessions = true;
+                    }
+                );
+        }
+
+        function deleteSession(session) {
+            UserService.deleteSession(vm.username, session.sessionid)
+                .then(
+                    function () {
+                        vm.sessions.splice(vm.sessions.indexOf(session), 1);
+                    }
+                );
+        }
+
+        function logoutEverywhere() {
+            UserService.deleteAllSessions(vm.username)
+                .then(
+                    function () {
+                        $window.location.href = '/';
+                    }
+                );
+        }
+
         function loadUser() {
             if (vm.loaded.user) {
                 return;
@@ -128,6 +161,7 @@
                         vm.user = data;
                         vm.loaded.user = true;
                         loadVerifiedPhones();
+                        loadVerifiedEmails();
                     }
                 );
         }
@@ -144,6 +178,18 @@
                 });
         }
 
+        function loadVerifiedEmails() {
+            if (vm.loaded.verifiedEmails) {
+                return;
+            }
+            UserService
+                .getVerifiedEmails(vm.username)
+                .then(function (data) {
+                    vm.user.verifiedEmails = data;
+                    vm.loaded.verifiedEmails = true;
+                });
+        }
+
         function getPendingCount(invitations) {
             var count = 0;
             invitations.forEach(function(invitation) {
@@ -557,7 +603,11 @@
 
                 function resetValidation() {
                     $scope.changepasswordform.currentPassword.$setValidity('incorrect_password', true);
-                    $scope.changepasswordform.currentPassword.$setValidity('invalid_password', true);
+                    $scope.changepasswordform.newPassword.$setValidity('password_too_short', true);
+                    $scope.changepasswordform.newPassword.$setValidity('password_contains_username', true);
+                    $scope.changepasswordform.newPassword.$setValidity('password_contains_email', true);
+                    $scope.changepasswordform.newPassword.$setValidity('password_reused', true);
+                    $scope.changepasswordform.newPassword.$setValidity('password_breached', true);
                 }
 
                 function updatepwd() {
@@ -579,8 +629,12 @@
                                     case 'incorrect_password':
                                         $scope.changepasswordform.currentPassword.$setValidity('incorrect_password', false);
                                         break;
-                                    case 'invalid_password':
-                                        $scope.changepasswordform.currentPassword.$setValidity('invalid_password', false);
+                                    case 'password_too_short':
+                                    case 'password_contains_username':
+                                    case 'password_contains_email':
+                                    case 'password_reused':
+                                    case 'password_breached':
+                                        $scope.changepasswordform.newPassword.$setValidity(response.data.error, false);
                                         break;
                                 }
                                 break;
@@ -731,6 +785,72 @@
                 }
             }
         }
+
+        function verifyEmail(event, label, emailaddress) {
+            var useFullScreen = ($mdMedia('sm') || $mdMedia('xs'));
+            var interval;
+
+            $mdDialog.show({
+                controller: ['$mdDialog', '$interval', 'label', 'emailaddress', verifyEmailDialogController],
+                controllerAs: 'ctrl',
+                templateUrl: 'components/user/views/verifyEmailDialog.html',
+                targetEvent: event,
+                fullscreen: useFullScreen,
+                locals: {
+                    label: label,
+                    emailaddress: emailaddress
+                }
+            }).finally(function () {
+                $interval.cancel(interval);
+            });
+
+            function verifyEmailDialogController($mdDialog, $interval, label, emailaddress) {
+                var ctrl = this;
+                ctrl.label = label;
+                ctrl.emailaddress = emailaddress;
+                ctrl.close = close;
+                ctrl.validationKey = '';
+
+                init();
+
+                function init() {
+                    UserService
+                        .sendEmailVerification(vm.username, label)
+                        .then(function (responseData) {
+                            ctrl.validationKey = responseData.validationkey;
+                            interval = $interval(checkconfirmation, 2000);
+                        }, function (response) {
+                            $mdDialog.show(
+                                $mdDialog.alert()
+                                    .clickOutsideToClose(true)
+                                    .title('Error')
+                                    .textContent('Failed to send the verification email. Please try again later.')
+                                    .ariaLabel('Error while sending verification email')
+                                    .ok('Close')
+                                    .targetEvent(event)
+                            );
+                        });
+                }
+
+                function close() {
+                    $mdDialog.cancel();
+                }
+
+                function checkconfirmation() {
+                    UserService
+                        .getEmailVerification(vm.username, ctrl.label, ctrl.validationKey)
+                        .then(function success(responseData) {
+                            if (responseData.confirmed) {
+                                vm.user.verifiedEmails[ctrl.label] = ctrl.emailaddress;
+                                close();
+                            }
+                        }, function (response) {
+                            // the validation expired
+                            $interval.cancel(interval);
+                        });
+                }
+            }
+        }
     }
 
 
@@ -83,6 +83,9 @@
             getAuthorizations: getAuthorizations,
             saveAuthorization: saveAuthorization,
             deleteAuthorization: deleteAuthorization,
+            getSessions: getSessions,
+            deleteSession: deleteSession,
+            deleteAllSessions: deleteAllSessions,
             registerNewBankAccount: registerNewBankAccount,
             updateBankAccount: updateBankAccount,
             deleteBankAccount: deleteBankAccount,
@@ -92,7 +95,10 @@
             updateName: updateName,
             getVerifiedPhones: getVerifiedPhones,
             sendPhoneVerificationCode: sendPhoneVerificationCode,
-            verifyPhone: verifyPhone
+            verifyPhone: verifyPhone,
+            getVerifiedEmails: getVerifiedEmails,
+            sendEmailVerification: sendEmailVerification,
+            getEmailVerification: getEmailVerification
         };
 
         function genericHttpCall(httpFunction, url, data) {
@@ -205,6 +211,21 @@
             return genericHttpCall($http.delete, url);
         }
 
+        function getSessions(username) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/sessions';
+            return genericHttpCall($http.get, url);
+        }
+
+        function deleteSession(username, sessionid) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/sessions/' + encodeURIComponent(sessionid);
+            return genericHttpCall($http.delete, url);
+        }
+
+        function deleteAllSessions(username) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/sessions';
+            return genericHttpCall($http.delete, url);
+        }
+
         function deleteFacebookAccount(username) {
             var url = apiURL + '/' + encodeURIComponent(username) + '/facebook';
             return genericHttpCall($http.delete, url);
@@ -251,5 +272,20 @@
             };
             return genericHttpCall($http.put, url, data);
         }
+
+        function getVerifiedEmails(username) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/emailaddresses?validated=true';
+            return genericHttpCall($http.get, url);
+        }
+
+        function sendEmailVerification(username, label) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/emailaddresses/' + encodeURIComponent(label) + '/validate';
+            return genericHttpCall($http.post, url);
+        }
+
+        function getEmailVerification(username, label, validationKey) {
+            var url = apiURL + '/' + encodeURIComponent(username) + '/emailaddresses/' + encodeURIComponent(label) + '/validate?validationkey=' + encodeURIComponent(validationKey);
+            return genericHttpCall($http.get, url);
+        }
     }
 })();
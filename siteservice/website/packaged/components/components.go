@@ -5,13 +5,17 @@
 // components/company/service.js
 // components/company/views/detail.html
 // components/company/views/new.html
+// components/login/forgotPasswordController.js
 // components/login/loginApp.js
 // components/login/loginController.js
 // components/login/loginSmsController.js
 // components/login/loginTotpController.js
+// components/login/resetPasswordController.js
+// components/login/views/forgotpassword.html
 // components/login/views/loginform.html
 // components/login/views/loginsmsform.html
 // components/login/views/logintotpform.html
+// components/login/views/resetpassword.html
 // components/organization/controller.js
 // components/organization/service.js
 // components/organization/views/apikeydialog.html
@@ -23,13 +27,17 @@
 // components/registration/registrationApp.js
 // components/registration/registrationController.js
 // components/registration/registrationResendSmsController.js
+// components/registration/registrationService.js
 // components/registration/registrationSmsController.js
 // components/registration/views/registrationform.html
 // components/registration/views/registrationresendsms.html
 // components/registration/views/registrationsmsform.html
 // components/shared/configService.js
+// components/shared/directives/footer.html
+// components/shared/directives/footer.js
 // components/shared/directives/header.html
 // components/shared/directives/header.js
+// components/shared/shared.js
 // components/user/authorizeController.js
 // components/user/controller.js
 // components/user/directives/authorizationDetails.html
@@ -43,7 +51,11 @@
 // components/user/views/facebookDialog.html
 // components/user/views/githubDialog.html
 // components/user/views/home.html
+// components/user/views/nameDialog.html
 // components/user/views/phonenumberdialog.html
+// components/user/views/resetPasswordDialog.html
+// components/user/views/verifyEmailDialog.html
+// components/user/views/verifyPhoneDialog.html
 // DO NOT EDIT!
 
 package components
@@ -111,7 +123,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _appJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe4\x58\x4b\x6f\xdb\xb8\x13\xbf\xf7\x53\xf0\xef\xbf\x51\xc9\xa8\x2b\xd9\x49\xd3\xa4\x29\x72\x30\xdc\x2e\x36\x87\x6e\x8b\x4d\x7b\x58\xa4\x39\xd0\x12\x65\xb3\xa1\x49\x85\xa4\xec\xa6\xad\xbf\xfb\x0e\x25\xea\x2d\x39\x8f\x02\xb9\x2c\x81\x04\x16\xe7\xfd\x9b\xe1\x68\x28\x37\x4a\x78\xa0\xa9\xe0\xc8\x1d\xa1\x9f\xcf\x10\x2c\x27\x51\x04\x29\x2d\x69\xa0\x9d\xb7\xe9\x0e\xe6\xcb\x84\x61\x99\xfe\x36\xcb\x5b\x8b\x30\x61\xc4\x75\xa8\x56\xb7\x22\x11\x9c\x51\x4e\x66\x71\xec\x8c\xd1\xa5\xc3\x97\x73\x21\xae\x29\x51\xf0\x04\x0f\x1f\xb0\x26\x92\x62\x96\x3d\xfd\x2d\x12\x4d\x2c\x81\x28\x85\x97\x86\xad\xd0\x9b\x5a\xaf\xea\xf4\x02\xc1\x23\xba\x34\x02\xb5\xed\x15\xc1\x21\x91\xce\xd5\xa8\x74\x29\xe3\x74\x2f\x9d\xe1\x3a\xfc\xbc\x22\x6b\xca\x97\x9f\xa4\xd8\x50\xc3\x37\x46\x3a\xdb\x99\xa7\x4c\xdd\x62\x2b\xad\xe3\x8a\x84\x79\xdc\xc7\x2e\x4d\x24\x15\xfe\xf4\xb9\x2d\x10\xe1\x40\x0b\x79\xeb\x3a\x38\x01\x1f\xb8\xa6\x01\x36\x68\x9f\x73\x40\x25\x20\x31\xd0\x52\xd0\x86\x37\x26\xc6\xe1\x96\xf2\x50\x6c\xe1\x67\x2f\x77\x55\x77\x48\x25\x81\xdc\x6d\x20\x11\x31\x20\xa9\xa9\x66\x24\xd3\x26\x85\xd0\x17\x81\x88\x53\xa8\x87\x9a\xae\x09\x78\x07\xbf\x0b\xb6\xaa\x1a\x99\x70\x13\x50\x50\x66\xad\x21\xcf\x44\xe6\x86\x89\x32\xe1\x7f\xd8\x82\xb9\x1a\xbd\x7d\x96\x2a\x29\x2a\xa8\x06\xb2\xdb\xce\x43\x5e\x60\x66\xb5\xa9\x5e\x48\x22\xc8\xed\x27\xcc\x88\xd6\x10\xd2\x82\x25\x84\xaa\x15\x18\xfd\x59\x2f\x90\xa3\x89\x73\x8a\x9c\xff\x47\xc7\xd1\x22\x0a\x9b\xd5\x33\x9d\x64\xd4\x05\x14\x08\x69\x51\x0f\x2c\xf5\x84\x04\x27\xe4\xa0\x49\x3d\xb4\xd4\xa3\x23\x1c\x86\x87\x4d\xea\x2b\x4b\x3d\x0c\xf0\x34\x68\x69\x3e\xca\xa9\x93\x37\xd3\xc5\xa2\x49\x7d\x6d\xa9\x07\xf8\x98\xe0\x96\xe6\xe3\x9c\x7a\xf8\x7a\x71\x82\x9b\xd4\x13\x4b\x9d\x86\x47\x27\xc7\x2d\x9f\xdf\xe4\xd4\xe3\x57\xe0\x76\x93\x3a\xcb\xe1\xe8\x06\x6b\x76\xb0\x17\xad\xd9\xfe\x90\x67\xfb\xfd\x86\xa3\xa2\x25\x56\xfa\x1d\x89\x70\xc2\xf4\x5c\x30\xa8\x75\x60\x67\x74\xb9\xd2\xbd\xcc\x58\x5e\xa7\x9c\xca\xb0\x1e\x4d\x10\x04\x80\xc0\x4b\x04\xb9\x41\xe0\x0e\x32\x11\x21\xe3\x37\x4a\xbd\x2b\xb4\xec\x46\x6f\xf7\xd5\x96\x29\x4d\xa8\xa9\x30\xf3\xc5\x19\xd5\xac\x7b\xb1\xa4\x6b\x2c\x6f\x5b\xa5\x67\x75\xee\x1a\x75\x5e\xb6\x06\xb7\xd6\x35\x6a\xf5\x5d\x25\x78\xb4\x3c\xc0\xca\x8b\x13\xb5\xda\xd7\x0f\x2a\x91\xf8\x3e\xe5\x54\x43\xf7\xa4\x3f\x08\x82\xa3\x8b\x68\x84\xb8\xd0\xe6\xa4\x49\x52\x70\xc1\xa6\xfb\xbf\xba\x41\x1b\xa8\xb2\x9d\x52\x79\x20\x3c\x6a\x1c\xa4\xbb\x25\xd0\x19\xfa\xb9\x2b\xbd\xd9\x55\xfc\x0a\xa9\xc2\x0b\x46\xd0\xf9\x7b\x84\xbf\xe1\xef\x48\x92\x9b\x84\x28\x8d\x02\x1c\xac\x00\xf8\x1e\x1c\xba\x8c\x5c\x3a\xe7\xd1\xcb\x0f\x22\xa4\x11\x25\xe1\xcb\x0b\xca\x03\xe2\x5c\x81\x65\x67\xe2\x74\xc3\xdf\x8b\x9c\x3b\xbc\x19\x23\xdb\x43\xab\xc1\x4a\xa2\x13\xc9\x9b\x6d\xc4\x7a\x0c\x75\x56\xbe\x02\xb3\xf6\xde\x04\x2a\xc7\xb8\x9f\x6a\xd6\x06\x4b\x94\x48\x06\x9e\x67\x7c\x1e\x3c\xd8\x0e\xd9\xa5\xcc\x77\x71\x4c\xbf\xfa\x23\x9f\x7a\x1a\xdc\x70\x81\x7b\xd4\xa7\xda\x2c\xab\xd4\x02\x77\x39\x98\x01\x0a\x42\xd2\x1f\x29\x08\x03\x03\xd8\x40\x8b\x6b\xc2\xd1\x00\xbd\x40\x0a\xde\xad\xb0\x7d\x01\xa0\x40\xc7\x37\x28\x9f\x6b\xb2\x76\x07\xc2\x60\xf7\xd9\xb0\x0d\x2a\x55\x56\x5d\xbb\xd6\x6e\x7b\xc7\xe2\x99\x79\x84\x7e\xfd\x42\xc3\x1b\x6f\x0b\x29\xc9\x01\xaa\x6b\xde\x8d\x9b\xb8\xab\x58\x70\x45\x6a\xc0\xe7\x9b\x5d\x08\x58\x73\x39\x4b\xd5\x60\x21\xd6\x32\xd9\x6d\xf3\xbd\x94\x69\x13\xaa\x1a\xfe\x46\xd2\x9f\x7d\x49\x2f\x18\x3c\xa5\xb1\x4e\x14\x3a\x3b\x83\x46\x34\x35\x5e\x74\x93\x0e\x7b\x49\xd3\x37\x7d\x09\xb6\x45\xeb\xe5\x6f\x5a\x6f\x25\x49\x64\x52\x3a\x68\x67\x69\xd7\xae\x29\x8b\x10\xa0\x92\xd9\xad\x44\xd5\x00\xa6\x3c\xcb\xf9\xd9\x32\xff\xeb\xe7\xab\x32\xc9\xb8\xf5\x31\xa7\xd6\xe0\x6a\x94\x7a\x3f\x4d\x73\xe3\xf8\xad\x57\xb7\x59\x50\x87\x31\x83\x99\xf0\x8b\x64\xa7\xa6\xef\xaf\x21\x31\x70\x9a\x95\x0f\x53\xa7\xf4\x37\x94\x6c\x95\xbf\x12\x6b\x98\xf1\xf4\x9a\x35\x5e\x13\x66\xa5\x6f\x0a\xc1\x18\x91\x20\xfe\x05\x64\xfe\x04\xe6\x79\xb1\xb9\x57\x62\xa6\x40\x66\xb3\xee\xe0\x09\xb1\xc6\xa7\x3d\xc9\x31\x43\xd3\x67\x33\x34\x81\xb0\x31\xe6\xdc\x71\x44\x76\xa3\x4e\x34\xb0\x3d\xb0\xe4\xd1\xb0\x14\x1a\xee\x85\x4d\xde\x20\x9e\x0e\x9c\xc2\xe2\x23\x11\x32\x51\x63\x7e\xeb\x73\xb2\x7d\x18\x46\xb9\x60\x06\x13\x88\xdf\x0b\xa0\x79\x26\xf5\x64\xf0\xfc\x45\xb6\xc8\x7a\xfa\x48\x80\x84\x5c\x62\x6e\x5b\xfe\xc3\x51\xaa\x49\x3f\x0c\xaa\x8f\x15\xd1\x27\xc5\x4b\x25\x8b\xaa\xdb\x8f\xc3\xad\x16\xf8\xe9\x92\x89\x05\x0c\x54\xe1\x7f\x03\xc0\xdf\x47\xcf\xef\x86\xef\xb7\xa1\x0b\x89\xc6\x94\x3d\x18\xbd\x77\xa9\xd8\x93\x61\x58\x35\x8d\x32\x97\x1f\x0a\xa3\x30\xd3\xfa\x96\x2a\x62\x5e\x89\x3d\x57\x8a\xe2\x62\xee\x96\x77\x6f\x98\x65\xed\xcd\xfd\xce\x61\x96\x51\x7e\x5d\x9d\x6a\x54\x26\x4f\x18\xdc\x7a\x78\x6b\xf0\x37\xcb\x4c\xab\x8c\x2a\x4d\x38\x91\x30\x68\x94\xa2\x64\x03\x12\x63\x14\x24\x52\xa6\x3f\x62\x49\x36\x54\x24\x6a\xdf\xd8\x5b\x00\x66\xc6\xf6\x73\xfd\xd5\x51\xe8\x1f\x91\xa0\x8f\xe9\xc7\x1a\xa7\x7b\xd0\x4c\x07\xea\xcc\x88\x37\xcc\x86\x09\xf4\xfc\x39\x6a\x6c\x79\x26\x4f\x7d\xfb\x5e\x61\x77\xdf\xe0\x5c\x75\x6e\xbf\x16\x18\x9c\x1d\xf4\x12\xfe\x5e\x94\x42\xf7\x9d\x92\xcd\xca\xd3\xd5\xf1\x4d\xad\x6b\xd9\xec\xc0\xf8\xff\x5d\xbb\x65\x28\x3d\x16\xc7\x68\x32\x46\x11\x66\xad\x61\x37\xa5\x76\x5c\x34\xca\x42\xf2\x86\x02\xce\x71\x16\xf2\x7c\x85\xf9\x92\x5c\x24\x41\x00\x57\x04\x38\xc3\x79\x0d\xdc\x3d\x28\x36\x86\xc4\xf2\x43\x90\x9b\x7f\x3a\x1a\xa3\x5a\xf1\xe6\xc3\x6c\x15\x83\xac\x5a\xf4\xea\x13\x96\x1a\x86\xe2\x92\xc9\x33\xbb\xee\xc8\x53\x31\xa3\xda\x1d\x9c\x55\xef\x27\xa6\x54\x0a\x21\x8f\x11\xbe\xd4\x2b\x18\xa8\xcf\xd0\x81\xa9\x8c\x82\x72\x39\xb9\x4a\x77\x07\xbe\xce\x6e\x38\x0d\xec\x1b\x97\x22\xd5\x71\x29\x1a\x57\xb4\x4d\xaf\x1a\x98\x34\x7c\x1d\xf8\xcd\x3b\x54\xc9\x20\x09\xb4\xc1\x80\xb8\xa3\xae\xcb\x73\x25\x31\x66\xb8\x33\x28\x58\x00\xcd\x45\x2d\xff\xa8\xea\xd9\x4f\x9d\x86\xa5\xec\x1a\xbb\x11\xe8\xfc\x37\x00\x00\xff\xff\xdc\x1b\x9f\x82\xb4\x15\x00\x00")
+var _appJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x58\x6d\x6f\xdb\x36\x10\xfe\xde\x5f\xc1\x65\x46\x29\xa3\x8e\x14\x27\x4d\x93\x26\x28\x86\x20\xed\xd0\x00\xcb\x5a\x34\x2d\xd6\x21\x0d\x06\x5a\xa2\x6c\xb6\x32\xa9\x90\x94\xdd\x34\xf5\x7f\xdf\x91\xa2\x64\xbd\x3a\x4d\x37\x84\x40\x02\x89\xc7\x7b\x7b\xee\x78\x77\x96\x17\x67\x3c\xd4\x4c\x70\xe4\x0d\xd1\xed\x23\x04\x0b\x67\x8a\x22\xa5\x25\x0b\x35\x3e\xb6\x3b\x84\x4f\xb3\x84\x48\xfb\x6c\x96\x3f\x17\x51\x96\x50\x0f\x33\xad\x6e\x44\x26\x78\xc2\x38\x3d\x49\x53\x3c\x42\x97\x98\x4f\xcf\x89\xa6\x92\x91\x04\x5e\xe1\xed\x9d\xc8\x34\xcd\x1f\xcf\xa9\x52\x64\x4a\x15\x1e\x95\xa2\xac\xc2\xaa\x18\x5f\xcd\x88\xa4\x91\x61\xa8\x6d\xcf\x28\x89\xa8\x6c\x6d\xc7\x42\x80\x32\x7c\x35\x5c\x1b\x17\x0a\x1e\xb3\xa9\x77\x89\x07\xf3\xe8\xfd\x8c\xce\x19\x9f\xbe\x95\x62\xc1\x72\x76\x9d\xef\x9c\xda\x43\xdd\x6c\x33\xad\xd3\x0a\x87\x79\xdd\x74\x5c\x1a\x07\x2b\xe7\xed\x7b\x9b\x21\x26\xa1\x16\xf2\xc6\xc3\x24\x03\x1b\xb8\x66\x21\x31\xb8\x9f\x71\xb0\x3f\xa4\x29\xd0\x2c\x7c\x83\x6b\xe3\xe3\x60\xc9\x78\x24\x96\xe6\x51\x01\x68\x70\x0e\x1e\x7b\x19\xab\x6a\x22\x26\x29\x04\x74\x01\xd1\x49\x01\x6b\xcd\x74\x42\x73\xc1\x12\xa0\xba\x08\x45\x6a\x83\x31\xd0\x6c\x4e\xc1\x50\x78\x2e\x8f\x55\xc5\xc8\x8c\x7b\x4d\x9e\xb5\x25\x40\xfd\xdd\xe5\xcd\xd5\xf0\xf8\x91\x65\x0b\x02\x00\x1b\x91\x94\x21\x2d\xbe\x50\x8e\x98\x42\x13\x91\xf1\x08\x5e\x0d\xe8\xc8\x71\x23\x11\xdb\xd7\x44\x4c\xa7\x34\x42\x8c\x23\x48\x37\x39\x42\x31\xd5\xe1\x0c\x31\x8d\x26\x34\x16\xd2\x24\x20\x91\x1a\x02\x65\x0f\x93\x34\xf5\x9d\x12\x64\xb4\x7c\xdc\x7e\x47\xaf\x33\xaa\x34\x8d\xb6\xff\x62\x7a\x86\xf2\xec\x40\xa9\xa4\x0b\x40\x48\x21\x01\x5c\x12\x29\xa6\xa9\x42\xb1\x14\x73\x04\x2e\x5a\x69\x24\xb7\xce\xaf\xe6\xb5\x4f\x13\x3a\x07\x36\x2f\x12\x61\x66\x1e\x86\xbe\x04\x79\x37\x5e\xfb\x6e\x98\xb5\x20\x12\xd9\x1c\x41\x2f\x4a\x09\x8c\x7f\xa6\x26\xbc\x9e\xc9\x7f\xc8\x46\x1f\xf4\x79\x79\x26\xe1\xe1\x71\xc9\x6a\x37\xfc\x54\x28\x20\x2e\xc6\x81\x30\x11\x0d\x1c\x30\x81\x35\x0c\xc0\xe5\x59\x92\x8c\xd0\x6d\xee\x92\x3a\x42\xb7\xb8\xe9\x2e\x3e\x42\xf8\xe3\xf9\x1f\xaf\x41\x98\x23\xe0\xd5\x6a\xe8\x9b\xec\xf0\x6a\x37\x6b\xed\x80\xa4\x2a\x15\x5c\xd1\xaa\x23\xc5\x2a\x9c\xe8\xbb\xd5\x43\x93\xec\x10\x0f\x40\xa8\x9a\x03\x4e\xa2\x1f\x11\x4d\x2a\x3e\x36\xa5\x4e\x20\x85\xa0\x9a\x90\xb4\x84\xd7\x64\x63\x53\xc7\x55\x43\xc2\x6a\xd4\xe3\x48\x97\x03\xf9\x65\xf1\x13\x91\xdf\x0c\x7f\x26\x69\x0c\xc1\xc1\x01\x24\x19\xe3\xb8\x21\xb9\x7c\x73\x2a\x57\x45\x06\x97\x4a\x6a\x35\xc2\x6b\x97\x91\xaa\x0d\x6d\xaa\x1f\xd1\x18\xbc\x7a\x4b\x12\xc8\x39\x80\x73\x92\x64\x94\xa9\x19\x40\x56\xb7\x1c\xef\xef\x98\x40\xfe\x1a\x1f\xc4\x93\x38\x6a\xd6\xc4\xf1\x4e\x4e\x9d\x40\x16\xd0\x16\x75\xd7\x51\x0f\x69\x78\x48\x77\x9b\xd4\x3d\x47\xdd\xdf\x27\x51\xb4\xd7\xa4\x3e\x75\xd4\xbd\x90\x8c\xc3\x96\xe4\xfd\x82\xba\xf3\x7c\x3c\x99\x34\xa9\xcf\x1c\x75\x97\x1c\x50\xd2\x92\x7c\x50\x50\xf7\x9e\x4d\x0e\x49\x93\x7a\xe8\xa8\xe3\x68\xff\xf0\xa0\x65\xf3\xf3\x82\x7a\xf0\x14\xcc\x6e\x52\x4f\x0a\x38\xba\xc1\x3a\xd9\xdd\x88\xd6\xc9\x66\x97\x4f\x36\xdb\x0d\xc9\x0f\xe9\xab\xf4\x4b\x1a\x93\x2c\xd1\xa7\x22\x81\x52\x0d\xc7\x13\x36\x9d\xe9\xde\xc3\x44\x7e\xb1\x27\x95\x39\xba\xbf\x83\xc0\x01\x04\x56\x22\x88\x0d\x02\x73\x90\xf1\x08\x19\xbb\x91\xb5\xae\x94\xb2\xaa\x96\x8b\x76\x6e\x99\xd4\x84\x9c\x8a\x72\x5b\xf0\xb0\xa6\xdd\x4f\x25\x9b\x13\x79\xd3\x4a\xbd\x22\xd3\x1b\x79\xbe\xee\x6c\x5e\xad\xe9\xd5\xf2\xbb\x4a\x80\x3a\x57\x36\x1d\xe5\xa7\x99\x9a\x6d\x6a\x67\x15\x4f\x82\x80\x71\xa6\x61\x26\x60\xdf\xa8\xa9\xc5\x88\xc5\x88\x0b\x6d\x6e\x9a\xa4\xe5\x29\xd8\xf4\x7e\xa9\x2b\x74\x8e\x2a\xd7\xff\x95\x29\xac\xcd\x12\x70\x37\x07\x14\x83\xdb\xd5\xda\x9a\x55\xc5\xae\x88\x29\x32\x49\x28\x3a\x7b\x85\xc8\x67\xf2\x15\xca\x9a\x2d\xa8\x28\x24\xe1\x0c\x80\xef\xc1\xa1\x4b\xc9\x25\x3e\x8b\xb7\xcf\x45\xc4\x62\x06\x75\xfa\x82\xf1\x90\xe2\x2b\x53\x86\x76\x70\x37\xfc\xbd\xc8\x79\x83\xeb\x11\x72\x23\xc0\xa8\x68\x9c\x55\xaf\x25\xd5\x99\xe4\xcd\x7a\xe2\x4c\x87\x84\x5b\x97\xcb\x7c\x4c\xe9\x2a\x9a\x06\xec\x7e\xaa\x59\xa6\xd1\x65\x32\x01\x17\xf2\x73\x3e\xbc\xb8\x52\xd9\x25\x2c\xf0\xa0\xf9\x7f\x0a\x86\x01\xf3\xa1\xed\x6a\x0f\x4e\x0f\xfb\x44\x9b\xe5\x84\x3a\x04\x2f\xb7\x4e\x00\x0e\x21\xd9\x37\x8b\xc6\x96\x41\x6e\x2b\x9f\x24\xb6\xd0\x93\x02\x04\x9f\x84\x21\x3c\xfd\x63\x09\xed\x9e\x53\x0f\x6e\xff\x8e\xc3\x2f\xb7\x00\x7d\xff\x8e\x06\xd7\xfe\xd2\x74\x4f\x07\xc8\xc6\x5e\x84\x8b\xce\x57\x03\x7a\x53\x83\x75\xea\x8a\x23\x55\x85\x25\x5b\x4b\x65\xb7\xce\x57\x52\xda\xea\x53\x55\x6c\x46\x8f\x46\x82\x14\xcb\xc4\xa5\x3c\xe0\x43\x0f\xd7\x99\x42\x2f\x5e\x40\x05\x1a\x1b\x2b\xba\x49\x7b\xbd\xa4\xf1\xf3\xbe\x80\x0e\x7a\x7a\xf0\xd6\x56\x3b\x4a\xab\x76\x0e\x39\x84\x00\x95\x5c\x6f\xc5\xab\xbe\xee\xbd\x2a\x2e\x95\xf9\x5f\xbf\x58\x95\x09\xdc\xab\x8f\xe7\xb5\xca\x56\xa3\xd4\x0b\xa9\x8d\x0d\x0e\x5a\x3d\xdb\x2c\x4d\xe7\x69\x02\x3f\x71\x3e\xc8\xe4\xc8\x14\xfc\x39\x04\xc6\x0c\x9d\x81\x19\x64\x83\x05\xa3\x4b\x15\xcc\xc4\x1c\x7e\xb2\xe8\x79\xd2\xe8\x0f\x66\xd9\x16\x21\x92\x84\x4a\x60\xff\x00\x3c\xaf\xe1\xf0\x69\xb9\xb9\x91\xe3\x04\xe6\x41\xbc\x98\x77\x9c\x31\x53\xd8\x51\x4f\x70\xcc\x84\xff\xde\x4c\xf8\xc0\x6c\x94\xe1\x3b\xae\xc8\x6a\xd8\x89\x06\x71\x17\x94\xfe\x34\x2c\xa5\x84\x1f\xc2\xa6\x28\x08\x0f\x07\x4e\xa9\xf1\x27\x11\x32\x5e\x13\x7e\x13\x70\xba\xbc\x1f\x46\x05\x63\x0e\x13\xb0\xff\x10\x40\xa7\x39\xd7\x83\xc1\xf3\x27\x5d\x22\x67\xe9\x4f\x02\x24\xe4\x94\x70\x57\xe2\x8d\x9b\xc1\xd1\x34\x11\x13\x18\x0b\xa2\xdf\xee\x07\x58\x4d\xd0\xfd\x50\x7b\x53\x61\x7d\x50\xe8\xaa\x36\xff\x1f\xf8\x95\xd8\xfd\x67\xe8\x22\xaa\x09\x4b\xee\x8d\xde\x4b\xcb\xf6\x60\x18\x56\x55\xa3\xdc\xe4\xfb\xc2\x68\xbf\x0b\x2c\x99\xa2\xa6\xb8\xf7\x4c\xc5\xe5\xf7\x10\x6f\xfd\xf9\x03\xc6\x31\xf7\xc1\xe4\xce\x31\x0c\x7e\xd1\x7e\xa9\xf6\x67\x95\xf3\xbb\x6f\x0c\x5d\xfd\xd3\xcc\x59\x09\x83\x5f\xf7\x9c\x4a\x68\x99\x6b\x56\xfb\x31\x63\x84\xc2\x4c\x4a\xfb\x60\x3e\x6f\x30\x91\xa9\x4d\x03\x5b\x09\x98\x99\x3c\xcf\xf4\x27\xac\xd0\xdf\x22\x43\x6f\xec\x2f\x6d\xdc\x3d\x32\xd9\x51\x30\x57\xe2\x0f\xf2\xb6\x88\x1e\x3f\x46\x8d\x2d\xfb\x43\xbf\x6f\xdf\x2f\xf5\x6e\x1a\xf9\xaa\xc6\x6d\x96\x02\x23\x1f\x46\xdb\xf0\xf7\x64\xcd\xf4\xa3\xf3\x9e\x59\x45\xb8\x7a\xbe\xe1\x34\x97\x8b\x0e\x0c\xae\x5f\xb5\xb7\x76\xa5\x47\xe3\x08\xed\x8c\x50\x4c\x92\xd6\xd8\x66\xa9\x1d\x23\xf2\x3a\x91\xfc\x81\x80\x7b\x9c\xbb\x7c\x3a\x23\x7c\x4a\x2f\x32\x3b\xd2\xc2\x1d\x2e\x72\xe0\xee\x91\xa7\x31\xee\xac\xbf\xc6\xd5\x52\xb6\xe3\x87\x43\xc5\x10\xd3\x96\x21\x0c\xc5\x60\x6d\x5e\x39\x99\xd3\x42\xc5\x6a\xe8\x0d\x8f\xff\x05\xb3\xfc\x82\x15\x1e\x16\x00\x00")
 
 func appJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,7 +138,7 @@ func appJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "app.js", size: 5556, mode: os.FileMode(436), modTime: time.Unix(1464872764, 0)}
+	info := bindataFileInfo{name: "app.js", size: 5662, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -171,7 +183,7 @@ func companyServiceJs() (*asset, error) {
 	return a, nil
 }
 
-var _companyViewsDetailHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x8e\x31\xce\x83\x30\x0c\x85\xf7\xff\x14\xf9\xbd\x5b\xc0\x56\xb5\x84\xa5\x27\x89\x70\x42\x23\x19\x1c\x91\xd0\xc2\xed\x1b\xa1\x0c\x20\xd1\x2c\x79\xb6\x3f\xbf\xe7\x96\xfc\x5b\xf5\x6c\x62\xd4\xe0\x16\xe6\xd8\xcf\xd6\x4e\x4e\xe6\x11\xba\x3f\x95\xdf\x0e\x38\xb6\xab\x62\xb3\xc9\x92\x34\xcc\xf2\x81\x52\xa0\xf3\xcc\x2a\xa6\x8d\xad\x86\x60\x88\xfc\x34\x60\x92\x70\x57\x4d\x5d\x87\xf5\x51\x3c\x4e\x3e\x5d\x5b\x65\x79\x31\xd0\x90\x97\x60\x97\x38\x24\x1c\x49\xc3\xad\x3e\x38\xec\xf0\xab\xe9\x9e\x32\x06\x33\x6d\x8a\x6c\x32\x9e\x63\x5b\xe5\xde\x19\xfa\xc7\x7c\x04\x09\xe2\x21\xe5\x47\xe8\x25\x51\x64\xf9\xbe\x01\x00\x00\xff\xff\x15\x0c\xfa\xfd\x23\x01\x00\x00")
+var _companyViewsDetailHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x8e\xc1\x0e\x83\x20\x0c\x86\xef\x7b\x0a\xd6\x7b\x23\xde\x96\x0d\xb8\xec\x49\x88\x80\x23\xa9\x42\x04\x37\x7d\xfb\x31\xc3\x41\x13\xd7\x4b\xff\xb6\x5f\xff\x56\x18\xff\x66\x1d\xe9\x94\x24\xb8\x99\x28\x75\x93\xb5\xa3\x0b\xd3\x00\xea\xc2\x4a\x88\x1f\xe0\xc8\x2e\x8c\xf4\x1a\xe6\x2c\x61\x0a\x1f\xa8\x05\x3a\x4f\xc4\x52\x5e\xc9\x4a\x88\xda\x18\x3f\xf6\x98\x43\xbc\xb3\x96\xf3\xb8\x3c\xaa\xc7\xc1\x47\x89\xa6\xc8\x93\x81\x84\xb2\x04\x9b\xc4\x3e\x63\x1a\x24\xdc\xf8\xce\x61\x83\x5f\xad\x7a\x86\x21\xea\x71\x65\xc6\x66\xed\x29\x89\xa6\xf4\x8e\xd0\x15\xcb\x13\x26\x20\xee\xae\xfc\x39\x7a\x4a\x54\x59\xd3\x17\xb8\xd1\x4a\xa5\x23\x01\x00\x00")
 
 func companyViewsDetailHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -186,7 +198,7 @@ func companyViewsDetailHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "company/views/detail.html", size: 291, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "company/views/detail.html", size: 291, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -211,7 +223,27 @@ func companyViewsNewHtml() (*asset, error) {
 	return a, nil
 }
 
-var _loginLoginappJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x54\x5d\x6f\x9b\x3c\x18\xbd\xef\xaf\xb0\xf4\xbe\x92\x41\x8a\xc8\x57\x69\x68\x73\x85\xb2\xdb\x49\xd5\xb4\x5d\x55\xbb\x30\xf0\x00\xd6\xfc\x81\x6c\x93\xa8\x9a\xf2\xdf\x67\x3b\x90\x15\xc8\x50\xa5\x6e\xbe\x8a\x39\xe7\xf9\x38\x27\x07\x82\xb2\x15\xb9\xa1\x52\xa0\x20\x44\x3f\xef\x90\x3d\xb8\xd5\x80\xb4\x51\x34\x37\x78\xef\x9f\x10\x51\xb5\x8c\xa8\x88\xcb\xa2\x65\x10\x60\x26\x2b\x2a\xd2\xa6\xc1\x0b\xf4\x82\x45\xf5\x99\x18\x50\x94\x30\x7b\x75\x37\xd0\x9a\x54\xa0\x2f\xb7\x2f\xb2\x35\xe0\x7e\x52\xa3\x5f\x65\x2b\x05\xa3\x02\xa2\x1a\x48\x01\x0a\x7f\x0f\x7d\x7b\x77\xa2\x5c\x8a\x92\x56\xc1\x0b\xfe\x9f\x17\x5f\x6b\xe0\x54\x54\xcf\x4a\x1e\xa9\xe3\x2d\x90\xb9\x3c\x39\x78\xd2\xed\x32\xe5\x26\xbd\x29\xf1\xf7\xbe\x60\x7f\xe7\x4b\xae\x62\x07\xfd\x82\xe9\xc8\xde\x0b\x77\xa6\x68\x54\x40\x69\x65\x3c\x13\x06\xc6\x58\x3f\x32\xd6\x02\xd5\xb5\x1d\xfa\xbb\xca\x3b\x19\xaf\xf0\x13\xc2\xff\x95\xbb\x32\x2b\x0b\xbc\x18\x82\xeb\xd5\x05\xcd\xac\x17\x30\x41\x37\x1d\x9a\x40\x9e\xc0\x66\x8c\x6e\x3b\x34\x8e\x49\x51\x6c\xc7\xe8\x7d\x87\x6e\x73\xb2\xce\x27\x9d\xe3\x1e\x5d\x3d\xae\xb3\x6c\x8c\x3e\x74\xe8\x86\xec\x80\x4c\x3a\xef\x7a\x74\xfb\x90\x25\x64\x8c\x26\x1d\xba\x2e\xe2\x64\x37\xd9\xf9\xb1\x47\x77\xf7\x76\xed\x31\x9a\xf6\x76\xdc\x36\x2b\xdd\xcc\xba\x95\xce\x4b\x4e\xe7\xf7\xb6\x21\x32\x8a\x68\xf3\x09\x4a\xd2\x32\x73\x90\x4c\x2a\x47\x67\xb4\xaa\xcd\x1f\xc9\x44\xfd\xf0\x4c\xed\xa8\xf1\x0a\x59\x01\xc8\x6e\x89\xec\x7f\x83\xec\x3a\xc8\x29\x42\x6e\x6f\xe4\xb7\xbb\x76\x39\x87\xfb\x99\x6c\x0d\x86\x45\x2e\xa7\x36\x60\xc5\x65\x31\x1c\x0e\xd1\x46\x51\x4e\xd4\xeb\x24\x87\xdd\x80\xf3\x28\xf4\x6f\xde\x88\x60\xf8\xba\x0c\xe2\x3e\x40\x86\x03\x4f\x35\x88\x00\x2f\x27\x41\x77\xc7\x00\x6f\x98\xfd\x12\x7c\x53\xec\xc9\xb9\xc4\x1b\x29\x40\x18\xbd\xf4\x5f\x8b\xe5\x91\xc2\xa9\xfb\x5d\x4a\xc5\xa3\xda\x70\x36\xb2\xd6\x1d\xef\xae\x64\x0c\x94\xf3\xdf\xb1\x0f\xd7\x27\xb3\xf4\x54\xdb\x82\x23\xc7\x03\xca\x39\xbc\xb9\xbf\x91\xa6\xf9\xa0\x06\xd7\xe2\xdd\x3a\x1c\xf9\x1f\xc8\xd0\x5c\x7f\x50\x85\xed\xf0\x6e\x11\x96\xfb\x37\x35\x48\x1b\x6d\x75\xa2\x1a\x5c\x9e\xae\x81\x3d\x87\x41\xb8\xff\x15\x00\x00\xff\xff\x0f\x78\x5d\xde\x94\x06\x00\x00")
+var _loginForgotpasswordcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x53\xc1\x6a\xdc\x30\x10\xbd\xef\x57\x88\xb0\x20\x9b\x1a\x6d\x09\xb9\x34\x26\x87\xd2\x1f\x28\x14\x7a\x29\xc5\x28\xf2\x78\x2d\x22\x6b\x8c\x34\xde\x65\x29\xfe\xf7\xca\xf2\x66\x77\xbd\x8e\x93\x92\x74\x4e\x63\xcd\xf3\xcc\xd3\xd3\x9b\xa4\xea\xac\x22\x8d\x96\x25\x29\xfb\xb3\x62\x21\x78\xe7\x81\x79\x72\x5a\x11\xcf\xe3\x89\xb4\xdb\xce\x48\x27\x1a\x2c\x3b\x03\x09\x37\xb8\xd5\xf6\x6b\xdb\xf2\x34\x96\x87\x10\x0a\x2d\x39\x34\x06\x5c\xc2\x2b\x74\x5b\xa4\xef\xd2\xfb\x3d\xba\xf2\xdb\xa9\xc2\x33\xf6\x8b\xaf\x6b\xa2\x36\x64\x7c\xbd\xd7\xb6\xc4\x7d\x4c\xbd\xc2\x16\x42\xb6\xf4\xe7\xef\x34\x5f\xc5\x59\x27\xba\x4b\xc8\x24\xf6\xcf\xd8\xb1\x7b\x48\x62\xef\xe7\xcb\x0d\xb1\x93\x8e\xed\x1a\xf6\xc0\xa8\xd6\x3e\x3f\x1f\x37\xc2\x77\x8f\x8d\xa6\x50\x19\x93\x49\x4d\x19\x90\xee\xa7\x34\xba\x94\x91\xc0\x03\xbb\x3a\x99\xa0\xa1\x91\xda\xfc\x00\x5b\x06\x5c\x25\x8d\x87\x73\xf5\x74\x85\x71\x48\x72\x49\xed\x99\x5e\xe8\x28\xc3\x9f\xd3\xc2\x10\x51\xfa\xfb\x61\x42\xcc\x26\xf5\x3e\x9f\x7c\x46\x21\x44\x8b\x9e\x12\xbe\x89\xe8\xcd\x28\x5a\x7b\x14\x2d\xe8\x3d\xcc\x49\x05\xd5\x60\x93\xd9\xa8\xb3\x33\x1c\xf8\x16\xad\x87\x6b\xa6\x0b\xf7\x25\xd7\x41\x3e\x03\xf6\xd9\x07\x26\xf8\xbd\x26\x55\x9f\x71\xc2\x93\xa4\xce\x2f\xc1\x87\x50\x32\xd8\xf8\xee\xf3\xdd\xfd\x22\x22\x8a\x14\xdd\x21\x82\x30\x47\x45\xc5\xda\x03\xc5\x47\xd5\x74\x48\x6e\xb4\xdd\x0d\xf9\x4d\x36\xbe\x62\x3a\xbf\xd7\x65\x3c\x3a\x90\x4f\xcb\x90\x91\xd2\xed\xed\x32\xa5\x23\xe2\xcb\xeb\xa4\x37\x1b\x66\xb1\x70\xa0\x70\x07\xee\x50\xa8\x5a\x5a\x0b\x26\x63\x52\x29\xec\x2c\x15\x06\xd5\x13\x94\x0c\x1d\x23\xc4\xa2\x91\xf6\x50\x48\x22\x68\x5a\xf2\x1f\x11\xe3\x24\xfe\xe0\x1a\x01\xce\xa1\xfb\x2f\xb2\x94\x50\xc9\xce\xd0\x1b\x0f\x35\xee\x73\xe0\xa5\xe2\xb6\x89\xda\x41\x15\xcc\xc6\x23\x0f\xce\x3e\xb1\x2b\x6f\xbc\x3c\xaf\x9f\xfb\x72\x72\x72\x71\x93\x7e\x35\x5f\xd9\xab\x95\x9f\xed\xee\xbf\xdb\x69\x58\x92\x2b\xd9\xde\xfa\xf9\x85\x37\x7f\x5f\xa3\xa9\x4f\xde\xd7\x63\xe6\xac\x79\x9b\x51\xd9\x7e\xd5\xa7\x49\x9a\xff\x05\x81\x87\x0f\x66\x69\x06\x00\x00")
+
+func loginForgotpasswordcontrollerJsBytes() ([]byte, error) {
+	return bindataRead(
+		_loginForgotpasswordcontrollerJs,
+		"login/forgotPasswordController.js",
+	)
+}
+
+func loginForgotpasswordcontrollerJs() (*asset, error) {
+	bytes, err := loginForgotpasswordcontrollerJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "login/forgotPasswordController.js", size: 1641, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _loginLoginappJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x54\xcb\x8e\x9b\x30\x14\xdd\xcf\x57\x58\x6a\x25\x83\x14\x91\xd7\x30\xc9\x24\x2b\x94\x6e\x2b\x45\x55\xbb\x1a\x75\x61\xe0\x02\xd6\x18\x1b\xd9\x26\xd1\xa8\xca\xbf\xd7\x26\x40\xc7\x90\x46\xd1\x8c\xe2\x95\xed\x73\xee\xe3\x1c\x2e\xf6\xb2\x9a\x27\x9a\x0a\x8e\x3c\x1f\xfd\x79\x40\x66\xe1\x5a\x01\x52\x5a\xd2\x44\xe3\x6d\x73\x43\x78\x5e\x33\x22\x83\x52\xa4\x35\x03\x0f\x33\x91\x53\x1e\x55\x15\x9e\xa0\x17\xcc\xf3\xef\x44\x83\xa4\x84\x99\xa3\x3d\x81\x52\x24\x07\x75\x3e\xfd\x10\xb5\x06\xbb\xa5\x5a\xbd\x89\x5a\x70\x46\x39\x04\x05\x90\x14\x24\xfe\xed\x37\xe9\xed\x0a\x12\xc1\x33\x9a\x7b\x2f\xf8\x6b\x99\xfe\x2c\xa0\xa4\x3c\xdf\x4b\x71\xa0\x96\x37\x41\xfa\x7c\xb3\x6b\x48\x97\xc3\xa4\xad\xf4\x2e\xa4\x39\x77\x01\xdb\x87\x26\xa4\x17\xeb\xe4\xf3\xc6\x25\x3b\x2f\xec\x1a\xa3\x41\x0a\x99\x91\xb1\x27\x0c\xb4\x36\x7e\xc4\xac\x06\xaa\x0a\x53\xf4\x5f\x54\xe3\x64\x38\xc3\x1b\x84\xbf\x64\xab\x2c\xce\x52\x3c\x71\xc1\xf9\xec\x8c\xc6\xc6\x0b\x18\xa1\x8b\x16\x5d\x43\xb2\x86\xc5\x10\x5d\xb6\x68\x18\x92\x34\x5d\x0e\xd1\xc7\x16\x5d\x26\x64\x9e\x8c\x32\x87\x1d\x3a\x7b\x9e\xc7\xf1\x10\x7d\x6a\xd1\x05\x59\x01\x19\x65\x5e\x75\xe8\xf2\x29\x5e\x93\x21\xba\x6e\xd1\x79\x1a\xae\x57\xa3\x9e\x9f\x3b\x74\xf5\x68\xda\x1e\xa2\x51\x67\xc7\x65\xb3\xa2\xc5\x55\xb7\xa2\xeb\x92\xa3\xeb\x7d\x9b\x21\xd2\x92\x28\xfd\x0d\x32\x52\x33\xbd\x13\x4c\x48\x4b\x67\x34\x2f\xf4\x7f\xc9\x44\xbe\x36\x4c\x65\xa9\xe1\x0c\x19\x01\xc8\x74\x89\xcc\xb7\x41\xa6\x1d\x64\x15\x21\xdb\x37\x6a\xba\xeb\xb3\x9c\xfc\xed\x95\xd9\x72\x8a\x05\x76\x4e\xcd\x80\xa5\xe7\xc6\xb0\xef\xa2\x95\xa4\x25\x91\x6f\xa3\x39\x6c\x0b\x9c\x06\x43\xff\xee\x8f\xf0\xdc\xdf\xc5\x19\x77\x07\x71\x0b\x1e\x0b\xe0\x1e\x9e\x8e\x06\xdd\x2e\x0d\x65\xc5\xcc\x4b\xf0\x4b\xb2\x8d\x75\xa9\xac\x04\x07\xae\xd5\xb4\x79\x2d\xa6\x07\x0a\xc7\x76\x9f\x09\x59\x06\x85\x2e\xd9\xc0\x5a\xbb\x1a\x77\x05\x63\x20\xad\xff\x96\xbd\xeb\x6f\xae\xd2\x23\x65\x02\x0e\x25\x76\x28\x27\xff\x62\xff\x5a\xe8\xea\x93\x1a\x6c\x8a\x9b\x75\x58\xf2\x1d\x64\xa8\x52\x7d\x52\x85\xc9\x70\xb3\x08\xc3\xbd\x83\x06\x53\x3e\x37\xee\x10\xa5\x8e\x42\xa6\x1f\x97\xe3\xe6\xb9\x49\xd0\x39\x64\xdf\x86\xdc\x41\x9b\x04\x05\x7d\x4b\xd3\x8d\x16\xaf\xc0\x3f\xae\xd0\xc9\x76\x93\xc0\x26\xe2\x1e\xfa\x84\x79\x96\xe4\x91\x2a\xb0\x6f\x41\xff\xd8\x9c\x7c\xcf\xdf\xfe\x05\xeb\xc4\xb5\xe9\x50\x08\x00\x00")
 
 func loginLoginappJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -226,12 +258,12 @@ func loginLoginappJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/loginApp.js", size: 1684, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/loginApp.js", size: 2128, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginLogincontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x52\xcd\x8a\xdb\x30\x10\xbe\xef\x53\x0c\xdb\x80\x64\x1a\x14\x58\x7a\xaa\xf1\x61\x29\xf4\xd6\x4b\x0f\xbd\x94\x1e\x54\x79\x12\x0b\x14\x49\x48\xe3\x84\x52\xf2\xee\x95\x27\x71\xbc\xb6\x53\x28\x74\x0e\xf6\x68\x7e\xbe\xf9\xe6\x47\xee\x7b\x6f\xc8\x06\x0f\xb2\x82\xdf\x4f\x50\x44\xf4\x19\x21\x53\xb2\x86\x44\xcd\x16\xed\x0f\xbd\xd3\x49\x1d\x43\xdb\x3b\x94\xc2\x85\x83\xf5\xaf\x31\x8a\x8a\xdd\x83\x28\x13\x3c\xa5\xe0\x1c\xa6\x9b\xff\xd3\xdd\x20\xb6\xf0\x5d\x6c\x3a\xa2\x58\x34\xb1\x39\x5b\xdf\x86\x33\xab\xd9\x84\x88\x45\x5b\x24\xfc\xa8\xea\x27\x46\xbe\x93\x5b\x04\x48\x46\xdb\xc2\x0d\xab\x28\x8c\x34\x76\x30\xc8\x49\x27\x38\x1d\xa1\x01\xea\x6c\xae\x27\xf3\x51\xe5\xfe\xe7\xd1\x52\xf1\x5c\x95\x99\xcf\x38\xd4\xe9\x9b\x76\xb6\xd5\x5c\xb7\x81\x85\xa5\x10\xbb\xc7\xdf\xd9\x5d\x81\xe4\xdb\xf2\x23\x85\x92\xa5\x0b\xca\xdc\x31\x08\x77\xf4\x71\xa8\xc9\xda\x76\x15\x10\x75\xce\xe7\x90\x5a\x8e\x19\x1f\xb3\xa8\x4b\x3d\x7b\xf2\x4c\x54\x0c\x99\xa4\xd8\x31\x68\x99\xec\x50\xbf\x52\xd4\xa1\x97\xab\x0a\xd3\xea\x13\xe6\x18\x7c\xc6\x65\x07\xa3\xec\x76\xf0\x15\x5b\x9b\xd0\x10\x50\x00\x1d\x63\x0a\x31\x59\x4d\x58\x68\x1e\xf0\x61\xce\x6d\x37\xa5\x3d\xc3\x93\x53\x9d\xce\x5d\x19\x85\x78\xb7\x13\xf0\x1e\xc6\x92\x6a\x60\xa8\xe8\x1c\x3e\xbf\x7e\x41\xea\x42\x5b\xaf\xd0\x2e\xeb\xe1\xfc\x3b\x75\xbb\x9f\x62\x54\x26\x4d\x7d\x86\xa6\x69\xe0\xc3\xcb\xcb\xdf\x52\x98\x3d\x1f\xd4\x75\x37\xfb\x90\xa6\x0d\xa8\x4d\x46\xe2\x7b\xb0\xf4\x4b\x3e\x5b\x7f\x1a\x74\x93\xb0\x45\x4f\x56\xbb\xfc\xbc\x85\x7d\xf9\x61\xb5\x6e\x84\x9b\x59\xb7\x37\xb3\xbc\x49\xbb\x3c\x38\xb5\xc5\x39\xae\x6e\xee\xbf\x78\x53\xea\x71\x56\xff\xfa\xbd\x54\xb2\xaa\xff\x04\x00\x00\xff\xff\xb4\x01\xe7\x52\x29\x04\x00\x00")
+var _loginLogincontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa5\x53\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x10\x5d\x00\xcb\x58\xa0\x00\xc5\x2e\x9b\x91\x43\x31\x60\xc0\x0e\xbb\x74\xd8\x2e\xc3\x10\xa8\x32\x53\x0b\x93\x25\x41\xa2\x93\x15\x43\xfe\xfb\x68\x25\x6e\xea\xb8\x1b\xd6\x85\x17\xd3\xfc\x78\x24\x1f\x45\xb1\xe9\x9c\x26\xe3\x1d\x88\x12\x7e\xcd\x80\xa5\xe8\x12\x42\xa2\x68\x34\x15\x55\xb6\x28\x77\xdf\x59\x15\x65\xeb\xeb\xce\xa2\x28\xac\xbf\x37\xee\x26\x84\xa2\xcc\xee\x5e\xa4\xf6\x8e\xa2\xb7\x16\xe3\xd1\xff\xfe\xd1\x50\x2c\xe0\x5b\x31\x6f\x88\x02\x6b\xc5\x7c\x67\x5c\xed\x77\x59\x4d\xda\x07\x64\xed\x2c\xe1\x7b\x59\xcd\x32\xf2\x63\x73\x67\x01\x22\xa3\x2d\xe0\x88\xc5\x4a\x46\x1a\x26\xe8\x65\xab\x22\x6c\x5b\x58\x01\x35\x26\x55\x27\x73\x2b\x53\x77\xd7\x1a\x62\xcf\x41\x19\xf9\xb4\x45\x15\xbf\x2a\x6b\x6a\x95\xeb\xae\xe0\xcc\x32\x8a\xc6\x9f\x84\xd1\x29\xfb\xd9\x10\x72\xe8\x97\xdb\x8f\xe2\xd8\x91\xb4\x5e\xe7\x78\xd9\x44\xdc\x94\x32\x31\x88\x6e\x04\xc5\x0e\x4b\x2e\x62\xd0\xd1\xda\xd4\xc7\x29\x47\x93\x1e\x9a\x12\x4f\x47\x19\xc6\xe1\x0e\x14\x97\x19\x3b\x7a\xc9\xec\xbc\xeb\x3b\xca\xda\x62\x12\x10\x54\x4a\x3b\x1f\xeb\x1c\x33\xfc\x8c\xa2\xf6\xd5\xe8\x37\xf3\x2b\x83\x4f\x24\x8a\x65\x06\xe5\x2d\xf5\xf5\x4b\x49\x0d\x3a\x31\xa9\x70\x7a\x46\x11\x53\xf0\x2e\xe1\xf9\x04\x83\x2c\x97\x70\x8b\xb5\x89\xa8\x09\xc8\x83\x0a\x21\xfa\x10\x8d\x62\x0a\x83\xba\xc7\x67\x73\xa6\xac\xaa\xd4\x30\x15\xc5\xab\x65\x01\xaf\x61\x28\x29\xfb\x0e\x25\xed\xfc\x87\x9b\x4f\x48\x8d\xaf\xab\x09\xda\x7e\x4a\xce\xbf\xb7\x6e\x36\xa7\x18\x99\x48\x51\x97\x60\xb5\x5a\xc1\x9b\xeb\xeb\x3f\xa5\xe4\xee\xf3\xe3\x3c\xec\x66\xe3\xe3\x69\x03\x72\x9e\x90\xf2\xdb\x32\xf4\x20\xae\x8c\xdb\xf6\xba\x8e\x58\xf3\xfb\x30\xca\xa6\xab\x05\x6c\xf8\x83\xe5\x74\x90\x3c\xcc\x0b\xbb\x7c\xfb\xb7\x2e\x79\x2f\xe4\x3d\xb4\xca\x3d\x70\x51\x63\xb1\x06\x45\x84\x6d\xa0\xb4\xe0\x23\x42\xc0\x18\x7d\x04\x93\x40\x69\xed\x3b\x7e\xc0\xbc\x8d\x1f\x1c\xc5\x46\x4e\x5c\xf7\x89\xeb\x21\xe3\x52\x2e\xc6\x1b\xcd\x95\x5f\x4a\xc5\xd8\xf2\x24\x6d\xff\xcc\xd5\x9d\x5d\xf9\xe4\xfc\x2e\x5a\x61\xbe\xfa\xea\xbf\xf0\xc6\x54\x5f\x86\x35\x59\xd2\x14\xee\xc0\xd9\x7e\xb6\x2f\x45\x59\xfd\x06\x28\x1b\x43\xb0\x1b\x06\x00\x00")
 
 func loginLogincontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -246,12 +278,12 @@ func loginLogincontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/loginController.js", size: 1065, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/loginController.js", size: 1563, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginLoginsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x55\x3d\x6f\xdb\x3c\x10\xde\xf3\x2b\xf8\xfa\x0d\x40\x1a\x15\x28\x27\xe8\x14\x23\x43\xd1\xa1\x4b\xa6\x02\xed\x52\x74\x60\xc8\x53\x44\x44\x12\x05\x92\x8a\x5b\x04\xfe\xef\x3d\x52\x96\x6c\x7d\xd9\x69\xca\xc1\x3e\x91\xf7\xfd\x3c\x3c\xb2\xac\xa9\xa4\xd7\xa6\x22\x6c\x4d\x5e\xaf\x08\x2e\xda\x38\x20\xce\x5b\x2d\x3d\xdd\xc6\x1d\x51\x3d\x35\x85\xb0\x51\x0e\x8b\x97\x46\x35\x05\x30\x5a\x98\x27\x5d\x7d\xaa\x6b\xba\x3e\x9e\x49\x53\x79\x6b\x8a\x02\x2c\xa3\xae\x74\x9f\xfb\x4f\x9a\x90\x1f\xf4\xda\x49\x53\x03\x8a\xf4\x3a\xf7\xbe\x8e\x82\xd7\x25\x98\xc6\x47\x79\xa7\x2b\x65\x76\x28\x0e\x4c\x7f\xae\xb7\x57\x31\x42\x9f\xed\xe0\x98\xb5\x5e\x13\x12\x7d\xe2\xdf\xc1\x23\x4a\xad\xbf\xae\xb4\xb0\x5e\x84\x25\x2f\x25\xb9\x27\x3e\xd7\x6e\x7b\xdc\x2e\xb9\x6b\x1e\x4b\xed\xf1\xa4\x15\x86\x67\x25\x86\xa8\x32\x6d\x4b\x11\xe3\xdf\x93\xd7\xc3\x37\xa8\x3b\x92\x89\xc2\xc1\x7e\x60\x60\xc1\x81\xff\x2e\x0a\xad\x3a\x83\xd1\xce\xa1\xa2\xb0\xba\x7c\x99\xcc\x41\x3e\x9f\xc6\x49\xc8\xcd\x66\xb3\x59\x9f\xe8\xf6\x1d\x98\xe8\xb2\xd3\x2a\xa3\xdb\xd0\x0d\xfe\x04\x9e\xad\x22\x50\xe9\xb1\x08\x50\xab\x35\xf7\x39\x54\x6c\x60\x31\x6c\x71\x23\x25\x38\xc7\x30\xef\xda\x54\x0e\xc6\xee\xcf\x76\xa7\x33\xe2\x58\xac\xd8\xce\xda\xe9\x8c\xb0\xff\x06\x7a\xbc\xcf\x6e\x29\xd6\x1b\xbb\x35\x67\xb6\x27\x80\x20\x9d\xf1\xdb\xa2\xce\x96\xcc\x27\xbb\xfb\x64\xb9\x77\x99\xd0\x05\xa8\x8b\xad\x7b\x47\x29\xc3\x3c\x4e\x14\xf6\x33\x1c\xe9\x4a\x1a\x85\x0f\x57\x20\xf4\x3b\xb0\x78\x12\x20\x62\xa9\xe0\xae\xc7\x55\xc1\x40\x67\x3f\xcc\x09\x93\x76\xa6\x00\x8e\x0c\x63\xc1\xe7\x28\xe5\xc8\xc1\x49\x0c\x5e\x1b\xe7\x19\x4d\xc7\xb4\x8c\xa5\xe3\xe5\x8f\x8e\xa6\x56\x91\xb0\xc7\x81\x75\xa9\xbb\x69\xda\x71\x38\x41\x3e\x2a\x6d\x41\x7a\xe2\x0d\x5e\x7c\x9c\x6f\x35\x48\x9d\x69\x50\xc7\x93\x6f\x5f\x1f\xf8\x3c\x4a\xed\x10\xc1\x12\x65\x4c\x90\xe7\x16\xb2\x31\xc7\x79\xe7\xa7\xb1\xc5\x0c\x6a\x09\x79\x7b\xde\x6e\xa7\xbd\xcc\x8f\x7a\xdc\x79\xe1\x1b\x77\xee\x4e\x48\x81\xd4\xfe\x78\x7b\x7b\xb7\xa8\x11\x0b\x89\x63\x32\xa0\x9a\x19\xdb\xa3\xcb\xaf\xbb\xb9\xa4\xfd\x6f\xb6\xd2\xd5\x4b\x90\xc3\xc9\x2a\x69\x27\xdb\xc2\x95\xe8\xd6\xa3\x05\xf1\xbc\xac\xd2\xe6\xb6\xb9\x39\x9f\x1b\x82\xf5\x10\xd8\x40\x1c\xe2\x15\xda\x04\xbf\x6a\xec\xa7\xe2\xe4\x8b\x21\x8f\x42\x3e\x07\xe4\xf0\x65\xb2\x95\x28\x21\xad\x85\x73\x3b\x63\x15\x71\xd2\x02\x54\xf3\xb8\xf5\x65\x4f\xf0\x13\x2e\x47\xfc\xe8\xff\x29\xfd\xa7\xca\x14\x64\xa2\x29\xfc\x85\xa6\x2f\xb0\x87\xa6\x60\xad\xb1\x94\x7c\x20\x23\xa0\xdf\x9d\xd3\xcc\x8c\xba\x30\x20\x46\x6f\xd2\xf4\x09\xf9\x7b\xc6\x78\xdb\xc0\x20\x6a\xfb\xbb\x5f\xe3\x64\xfd\x13\x00\x00\xff\xff\x39\x6e\xf2\x4c\x6e\x08\x00\x00")
+var _loginLoginsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x55\x3b\x6f\xdb\x30\x10\xde\xf3\x2b\x58\x37\x80\x28\x54\xa0\x9c\xa0\x4b\x6d\x64\x28\x3a\x74\xc9\x54\xa0\x5d\x8a\xc2\xa0\xa9\x93\x45\x58\x22\x05\x92\x8a\x1b\x04\xfa\xef\x25\x29\x4b\xb6\x5e\x76\x90\x94\x8b\xcf\xe4\xbd\xbf\xef\x4e\x38\xad\x04\x33\x5c\x0a\x84\x43\xf4\x72\x83\xec\x09\x2a\x0d\x48\x1b\xc5\x99\x09\xd6\xfe\x86\x8a\x5d\x95\x53\xe5\x65\x77\x48\x21\x93\x2a\x07\x1c\xe4\x72\xc7\xc5\xd7\xb2\x0c\xc2\xd3\x1b\x93\xc2\x28\x99\xe7\xa0\x70\xa0\x0b\xfd\xad\xfb\x1b\x44\xe8\x77\x70\xab\x99\x2c\xc1\x8a\xc1\x6d\x66\x4c\xe9\x05\xc3\x0b\x90\x95\xf1\xf2\x81\x8b\x44\x1e\xac\xd8\x33\xfd\x13\xae\x6f\x7c\x84\x2e\xdb\xde\x33\x6e\xbc\x46\xc8\xfb\xb4\x3f\x47\x8f\x56\x6a\xfc\xb5\xa5\xb9\xf3\x44\x15\x7a\x2a\xd0\x03\x32\x19\xd7\xeb\xd3\x75\x41\x74\xb5\x2d\xb8\xb1\x2f\x8d\xd0\x7f\x2b\x6c\x08\x91\x72\x55\x50\x1f\xff\x01\xbd\x1c\xff\x43\xb2\x42\x29\xcd\x35\xd4\x3d\x03\x05\x1a\xcc\x2f\x9a\xf3\xa4\x35\x18\xdc\x1c\x2b\x72\xa7\xcd\x17\xb3\x0c\xd8\xfe\x3c\x4e\x84\xee\x96\xcb\x65\x78\xa6\xdb\x75\x60\xa4\x8b\xcf\xab\xf4\x6e\x5d\x37\xc8\x0e\x0c\x5e\x78\xa0\xe2\x53\x11\x90\x2c\x42\x62\x32\x10\xb8\x67\xd1\x6f\x71\xc5\x18\x68\x8d\x6d\xde\xa5\x14\x1a\x86\xee\x2f\x76\xa7\x35\x22\xb6\x58\xba\x9e\xb4\xe3\x29\xc2\x1f\x7a\x7a\xa4\xcb\x6e\x2e\xd6\x2b\xbb\x35\x65\x56\x23\xb0\x20\x5d\xf0\xdb\xa0\x8e\xe7\xcc\x47\xb7\x75\x34\xdf\xbb\x94\xf2\x1c\x92\xab\xad\x7b\x43\x29\xfd\x3c\xce\x14\xea\x09\x8e\xb4\x25\x0d\xc2\xbb\x11\x70\xfd\x76\x2c\x1e\x05\xf0\x58\x26\xb0\xea\x70\x4d\xa0\xa7\x53\xaf\xc7\x24\x1b\x39\x21\xa5\xd4\x06\x07\xf1\x90\x77\xbe\x36\x3b\xdd\x2e\x7a\x38\xb6\xf2\x8c\x3c\x6d\xa4\x6b\xed\x8b\xe3\x96\xa4\x91\x25\x5c\xc2\x15\x30\x83\x8c\xb4\x93\x6d\x17\x58\x09\x8c\xa7\x1c\x92\xd3\xcb\xcf\x1f\x8f\x64\x1a\x86\x66\x4b\x90\x5c\x32\x9f\x20\xc9\x14\xa4\x43\x12\x93\xd6\x4f\xa5\xf2\x09\x58\x22\xf4\xfa\xbc\xf5\x81\x1b\x96\x9d\xf4\x88\x36\xd4\x54\xfa\x12\xe9\x19\xb5\xdc\xfd\x7c\x7f\xbf\x9a\xd5\xf0\x85\xf8\x3d\xe8\x60\x4b\xa5\xea\xe0\x23\xb7\xed\xe2\xe1\xe6\x19\x2f\xb8\x78\x72\xf2\xc6\x3d\x2d\xa2\x66\x77\xcd\x90\xbe\x3d\x5b\x05\x74\x3f\xaf\x72\x4c\xee\xcb\xe5\xe4\x2c\x5a\x46\x4a\x54\x50\xf1\x7c\x9c\x0f\x44\x8d\x81\xa2\x34\x16\x3e\x07\x19\x28\x25\x15\xe2\x1a\x51\xc6\x64\x25\xcc\xc6\xe2\xb1\xb7\x5a\xf6\xd2\x1a\x6e\x9c\xe1\xa6\xb5\x78\x77\x1b\xfa\xd8\xfa\xd0\xff\xb1\x17\xcb\xbb\xab\xbd\x78\x74\xa3\x81\xb4\x25\xaf\xe3\x0c\xfc\x2d\x2d\xb9\x12\x82\xbe\x4b\xb4\xa5\x6c\xef\x68\x6c\xbf\xc3\x4a\xd0\x02\xe2\x92\x6a\x7d\x90\x2a\x41\x9a\x29\x00\x31\x4d\xe2\xae\xf8\x11\x99\xa9\xce\x2c\x99\x83\x8f\x71\xf0\xae\xca\x12\x48\x69\x95\x9b\x2b\x0c\x9c\x19\xa5\x20\xf6\x2d\x0e\xd0\x27\x34\x60\xfd\x9b\x73\x9a\xd8\xc8\x57\xd6\xe1\xe0\x0b\x3c\xfe\x60\xbe\x61\x7c\x8c\xaa\x86\x8c\x79\x95\x9b\x3e\xc7\xdf\xe1\x68\x34\x1a\x63\x5f\x4d\xa7\xea\x9b\x3a\xb4\x5f\xb7\x7f\x00\xae\x6c\x51\xf2\x09\x00\x00")
 
 func loginLoginsmscontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -266,12 +298,12 @@ func loginLoginsmscontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/loginSmsController.js", size: 2158, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/loginSmsController.js", size: 2546, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginLogintotpcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x54\x41\x6f\xdb\x3c\x0c\xbd\xf7\x57\x08\xfd\x02\xc8\xc6\x17\xc8\x5b\xb1\x53\x8d\x1d\x86\x1d\x76\xe9\x69\xc0\x76\x19\x76\x50\x64\xba\x16\x6a\x4b\x82\x44\x25\x1b\x86\xfc\xf7\x51\x72\x9d\xc4\x71\xd2\x0d\x1d\x0f\x09\x25\x52\x4f\x8f\x7c\xb4\x8a\x36\x1a\x85\xda\x1a\x56\x94\xec\xd7\x0d\x23\xe3\x31\x00\x0b\xe8\xb5\x42\x5e\xe7\x1d\x69\x1e\x63\x2f\xbd\x18\x6c\x13\x7b\x28\x78\x6f\x1f\xb5\xf9\xe0\x1c\x2f\x73\x38\x99\x50\xd6\xa0\xb7\x7d\x0f\xbe\xe0\x68\xd1\x7d\x3c\xac\xf9\x9a\x7d\xe3\xab\xa0\xac\x03\x72\xf9\xaa\x43\x74\xd9\xd9\x69\xd3\xd8\x5d\x76\x7b\xab\x64\x62\x41\x8b\xf9\xe1\xef\x65\x7d\x93\x2f\x39\xf0\x9c\xc7\x8b\x11\x78\xcd\x32\x2c\xfd\x8d\xa0\xe4\x4c\x90\x53\x59\xc9\xb6\xd2\xb3\xed\xc0\xde\x33\xec\x74\xa8\x8f\xdb\x83\x08\x71\x33\x68\xa4\xc8\xe8\xcc\x62\x1e\x02\xe0\x57\xd9\xeb\x26\x03\x52\xd2\xd9\xce\x33\xc5\x19\xcd\x11\xa7\x38\xbd\x3d\x59\x55\x51\x01\x8d\x9d\xed\x25\x56\x04\x24\x09\x78\x9e\x9c\x2c\x95\xab\x6c\x03\xf7\x89\xc9\xb4\x98\x65\xed\xeb\xd9\x32\x37\x62\x01\x23\x9c\x0d\x58\xf0\x2a\x4b\x57\x8d\x38\xa6\xd5\x7e\x98\xda\x9e\x08\x94\xcb\x63\xd8\x81\x39\x99\x11\x2a\xdc\x59\x13\xe0\xbc\xac\x93\xf2\x42\x54\x0a\x42\x58\x53\x93\x1a\xed\x41\x21\x95\x40\xfd\xa6\x91\x72\xa0\x74\xab\xa1\x39\x46\xbe\x7c\x7e\x10\x17\x71\x9e\x65\x14\x93\x88\xa2\xf3\xd0\x8e\x8d\xcf\xf7\x8b\x44\x57\x4c\x38\xd1\xf7\x27\x12\x1c\xfa\xb2\x66\x7f\x4f\x3c\xec\x34\xaa\xee\x98\x27\x02\x4a\x8c\xe1\x5a\x7a\x32\x25\xe9\x3b\x79\x77\x77\x77\x7f\x35\x23\x57\x92\xe7\x33\x2b\xd7\x5a\x7f\x94\x50\xac\xa6\x11\xd2\xf8\xb3\xb8\xd5\x66\x9b\xfc\x14\xb9\x25\xde\xb2\x27\xaa\xf5\x8b\xc0\x1b\x0f\xf2\xe9\x7a\xca\xc8\xee\xcd\xdb\x97\xd9\x91\x5e\x0f\x69\x22\x58\x20\xc9\x52\xa3\xe0\x87\xa3\x96\x36\x82\x7d\xb2\x6c\x23\xd5\x53\x12\x8f\xde\x03\x6f\xe4\x00\x95\x93\x21\xec\xac\x6f\x58\x50\x1e\xc0\x5c\x96\xee\x50\xf8\x42\x42\x19\x3a\x92\x90\xff\x57\xf1\x7f\xaa\xac\x81\x56\xc6\x1e\xff\xd0\xf6\x2b\x03\xc4\x2b\xf0\xde\x7a\xce\xfe\x67\x67\x52\xbf\x9a\xd3\x7e\x39\x79\x27\xd2\xed\x2f\xbc\x0d\x67\xcf\xc7\xe2\x91\x78\xd5\xcc\xa0\x8f\x30\xbb\x77\xfc\xdd\x97\x45\x59\xff\x0e\x00\x00\xff\xff\x1d\x04\x96\x3b\xe6\x05\x00\x00")
+var _loginLogintotpcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x84\x2e\x80\x6c\x2c\x90\xb7\x62\x97\xd5\xd8\x61\xd8\x61\x97\x9e\x06\x6c\x97\x61\x08\x14\x59\xae\x85\xc8\x92\x20\xd1\xc9\x8a\x21\xff\x3e\x4a\xae\x93\x38\x4e\xda\xa2\x2d\x2f\x96\x45\xea\xe9\x91\x7c\x54\x56\x77\x46\x80\xb2\x86\x64\x39\xf9\x37\x23\x68\xb4\x0b\x92\x04\xf0\x4a\x00\x2d\xd3\x0e\x37\x77\x9d\xe6\x9e\xb5\xb6\xea\xb4\xcc\xa8\xb6\x77\xca\x7c\x75\x8e\xe6\xc9\x1d\x8d\x09\x6b\xc0\x5b\xad\xa5\xcf\x28\x58\x70\xdf\xf6\xff\x74\x41\x7e\xd3\x79\x10\xd6\x49\x5c\xd2\x79\x03\xe0\xd2\x62\xab\x4c\x65\xb7\x69\xa9\xad\xe0\x91\x05\xfe\x8c\x0f\xff\xc9\xcb\x59\xba\x64\xcf\x73\xec\xcf\x7a\xe0\x05\x49\xb0\xf8\xe9\x41\x71\x31\x40\x0e\x69\x45\xdb\x70\x4f\x36\x2d\xf9\x42\xa0\x51\xa1\x3c\x6c\xb7\x2c\x74\xab\x56\x01\x7a\xfa\xc5\xc8\xe7\x65\x90\xf0\x8b\x6b\x55\x25\x40\x0c\x3a\xd9\x79\xa0\x38\xa2\xd9\xe3\x64\xc7\xb7\x47\x2b\x0a\x4c\xa0\xb2\xa3\xbd\xc8\x0a\x81\x38\x02\x8f\x83\xa3\xc5\x74\x85\xad\xe4\x4d\x64\x32\xfc\x8c\xa2\x76\xe5\xe8\x37\x15\x62\x02\xc3\x9c\x0d\x90\xd1\x22\xb5\xae\xe8\x71\x4c\xad\x7c\x3b\x94\x3d\x12\xc8\xa7\xc7\xa0\x91\x26\x3b\x68\x04\x13\x77\xd6\x04\x79\x9a\xd6\x51\x7a\xa1\x13\x42\x86\xb0\xc0\x22\x55\xca\x4b\x01\x98\x02\xd6\x1b\x25\xe5\xa4\x50\xb5\x92\xd5\xc1\xf3\xf3\xc7\x2d\x3b\x8b\xf3\xd0\x46\x36\x34\x91\x35\x5e\xd6\x7d\xe1\xd3\xfd\x2c\xd2\x65\x03\x4e\xe7\xf5\x51\x0b\xf6\x75\x59\x90\xe7\x13\x0f\x5b\x05\xa2\x39\xc4\xb1\x00\x1c\xba\x70\x29\x3c\x9a\xe0\x38\x27\x9f\xae\xaf\x6f\x2e\x46\xa4\x4c\x92\x3e\x53\xe7\x6a\xeb\x0f\x2d\x64\xf3\x41\x42\x0a\xee\xb3\x2b\x65\x36\x71\xbd\x8c\xae\x2b\x24\xce\x35\x72\x2d\x1f\x45\x5e\x79\xc9\xd7\x97\x43\x1e\xe8\x7d\x7e\x9c\x5e\xd2\xa3\x25\x2d\x37\xf7\x78\xa9\xd2\xd8\x1d\x0e\x20\x5b\x07\xd8\xc1\xd8\x35\xe9\xbd\xf5\x44\x05\xc2\x85\xb0\x9d\x81\x25\xb6\x64\x8d\x51\xb8\x89\x07\x97\xf1\xe0\x72\x38\xf1\x06\x85\x18\x37\x38\x5d\xfe\x86\xd5\xf8\xf0\xf1\xc9\x6a\xdc\xc6\x01\x21\x01\x15\x1c\x75\x23\xff\x3a\x54\x58\xc5\xc8\x77\x4b\x56\x5c\xac\xa3\x96\xf1\x79\xf4\x86\xb7\xb2\x70\x3c\x84\xad\xf5\x15\x09\xc2\x4b\x69\xce\x2b\x79\x9f\xfe\x44\xd1\x3c\x34\xa8\x68\xfa\xae\xa0\xaf\xca\xac\x92\x35\xef\x34\x3c\xa1\xc2\x0b\xf3\x44\x8b\x54\x62\x4a\xde\x93\x13\xe5\xbf\x98\xd3\x6e\x3a\x88\x47\xad\xdb\x9d\x79\x2a\x4f\x5e\xd3\xc9\x9b\xf9\xb2\x11\x02\xdf\x9d\x6a\xe6\x99\x40\x63\xa5\xbf\x0a\x6a\x32\x22\x53\xb4\xbe\x5e\xbb\xd9\x2e\xcf\xf2\xf2\x3f\x4e\x74\x0b\x47\x8f\x07\x00\x00")
 
 func loginLogintotpcontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -286,12 +318,52 @@ func loginLogintotpcontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/loginTotpController.js", size: 1510, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/loginTotpController.js", size: 1935, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _loginResetpasswordcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdd\x56\x4b\x8b\xdb\x30\x10\xbe\xef\xaf\x10\x21\x20\x9b\x0d\xce\xb2\xec\xa5\x09\x39\x2c\x85\x9e\x5a\xba\xf4\xd0\x4b\x29\x41\x6b\x8f\xd7\x22\xb6\x64\xa4\x71\xd2\x65\xf1\x7f\xaf\xa4\xbc\xfc\x8c\xb3\x4d\x0a\xa5\x73\x89\xa2\x19\xcd\x4b\xdf\x37\x96\x17\x17\x22\x44\x2e\x05\xf1\x7c\xf2\x76\x43\x8c\xd0\x42\x03\xd1\xa8\x78\x88\x74\xee\x76\x98\x78\x29\x52\xa6\x82\x4c\x46\x45\x0a\x1e\x4d\xe5\x0b\x17\x8f\x79\x4e\x7d\xa7\xb6\x12\x84\x52\xa0\x92\x69\x0a\xca\xa3\x0a\x34\xe0\x13\xd3\x7a\x23\x55\xf4\xf1\xa0\xa0\x13\xf2\x83\x8e\x13\xc4\xdc\xac\xe8\x78\xc3\x45\x24\x37\x6e\xa9\x43\x99\x83\x5b\x29\x59\x20\x3c\x31\xc5\x32\x6d\xfe\xf7\x38\xfa\xe9\xcf\x6f\x5c\xe4\x43\xf2\x3d\x86\x9e\x8b\x36\x21\xbb\x58\x66\xe1\x22\x99\xdf\x4a\x9c\x7d\xdd\x56\xd6\x4c\x91\x75\x46\x16\x04\x13\xae\xe7\xc7\xed\x2c\xd0\xc5\x73\xc6\xd1\x68\xb6\x8b\x9a\x2e\x4c\x81\xa9\xef\x2c\xe5\x11\x73\xd9\x2c\x48\x63\xa7\x66\x8d\x1b\xf9\xe9\xf1\x0b\x60\x22\x23\x63\x49\x69\x4d\xc9\xc5\xda\x9e\xfa\xcc\xc5\xca\x28\x63\x96\x6a\xa8\xe9\xf3\x5d\x8d\xdf\x6c\xc1\x47\x8b\x83\x89\x46\xa6\xd0\xf3\x2b\x3b\x87\x1e\xed\x54\x95\x6a\xad\xb8\x0e\x05\xb9\xd4\xe8\xd1\xa9\xbb\xd8\xa9\x6b\xe6\x3e\xd0\xd4\x1d\x33\x77\xf1\x86\x72\x05\x62\x56\x6b\x5d\xe0\xf6\x4a\x3f\xc0\x04\x84\x57\xf3\x5b\x0b\xed\x19\x97\xb9\x14\x1a\x9a\xd1\x7b\xdb\xb2\x3f\x10\x98\x06\xb2\xaa\x6e\xde\x3a\x5f\x4e\x2e\x08\xac\x37\x1c\xc3\xe4\x68\x17\x98\x72\xb1\xd0\x7d\xe6\x56\x42\x66\xf8\xf1\x70\xf7\x30\x1b\xb0\xb8\xbf\x1f\xb4\xf8\xd0\x6f\x61\xa5\x85\x07\x54\x05\xb4\x1b\x50\x95\x67\x05\x6c\xd5\x6f\x12\x41\xcc\x8a\x14\x4f\x87\xdd\xb1\x25\x48\x65\xe8\xd0\x1b\x24\x0a\x62\x0b\x55\x50\x4a\x2a\x4a\x6e\x49\xa3\x5b\xdd\xf1\xca\xf6\x4d\xd5\x76\xfc\xe3\xb1\xb2\x0b\xae\x8e\x67\x2d\xbc\x5a\x86\x5a\x4c\x98\x7c\xda\x57\xd4\x0b\xd1\x36\x44\x42\x19\xc1\xcc\x91\xd7\x2c\xda\xea\x3d\xfc\x67\x55\xd2\xd5\xac\xca\xf9\xbb\x78\x64\x18\x64\xd3\xbe\x0a\x55\x9a\x43\xa0\x1b\x16\xff\x1e\x2f\x76\xe9\x5f\x19\xd3\xc3\x6c\xb3\xc2\xe3\x4a\x31\x6e\xa8\x38\x34\x9b\x64\x22\xf8\xf5\x35\xf6\xe8\xbe\xa9\x4b\xea\x93\xc5\x62\x41\xee\x4e\xd5\xba\x97\xed\xf7\x24\x88\xa5\x3a\xde\x4a\x30\x36\xb7\xe2\x66\x3f\xc7\xd7\xae\x98\x93\xed\xd4\xf6\x4f\x57\x5d\x12\x30\x46\xef\xcc\xc1\x82\xf9\x5a\xf1\xaf\x70\x27\x03\xf3\x6d\x3a\x25\x2c\x0c\x65\x21\x70\x69\x66\xcd\x0a\x22\x22\x95\xe1\xb0\x5c\x66\x4c\xbc\x2e\x19\x22\x64\x39\xea\xd3\xb3\xea\xef\x14\xff\xff\x8c\xd1\xc6\x4b\xa4\xfd\xfd\x3f\xd9\xc0\xd1\x8e\xab\x4b\xab\x1a\x4d\x1c\x59\x1b\xad\x1b\x70\x50\xbf\xe0\x3f\x72\xd1\x42\xc4\xb0\x97\x6e\x2e\x8e\x0e\x1c\xb7\x2e\x75\x22\x15\x5e\xee\xca\x3e\x7c\x19\x17\x7a\x69\x9e\xcd\x4a\xb0\xec\x8c\x36\x9d\xed\x12\x32\xc6\xd3\xcb\xfd\x29\x30\xb9\x9d\xd1\xfb\x21\x3f\x96\x15\x61\xd2\xe5\x69\x0b\xc9\xf2\xa6\xf4\xed\xdb\xf3\x37\xb8\xe1\xfb\xf7\x55\x0c\x00\x00")
+
+func loginResetpasswordcontrollerJsBytes() ([]byte, error) {
+	return bindataRead(
+		_loginResetpasswordcontrollerJs,
+		"login/resetPasswordController.js",
+	)
+}
+
+func loginResetpasswordcontrollerJs() (*asset, error) {
+	bytes, err := loginResetpasswordcontrollerJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "login/resetPasswordController.js", size: 3157, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _loginViewsForgotpasswordHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\xc9\x92\x9b\x30\x10\xbd\xe7\x2b\x3a\x4a\x0e\x49\xd5\x78\x98\x99\xca\x11\xa8\x4a\x0e\xf9\x81\x2c\x55\x39\xb9\xda\xd0\x80\x6a\xb4\x10\x49\xd8\xe3\xbf\x4f\x0b\x30\xb1\x31\x24\x63\x4e\x82\x6e\xbd\x7e\xfd\x7a\x21\xad\xac\xd3\xa0\xf0\x68\xbb\x90\x89\xc2\xaa\x4e\x1b\x01\x06\x35\x65\x22\x9a\xf8\x5c\x6f\x7c\xb7\xd3\x92\xcd\x7b\x7d\x3f\x1c\x3f\x7c\x14\xf9\x1b\xe0\x27\x2d\xe5\x1e\x2a\x45\x2f\x13\x84\xb3\x87\xd1\x76\x61\xcf\xd3\x84\x8f\x33\xc3\x3c\x6c\x74\xcc\xc4\xe3\xc3\xc3\x70\xdc\xd4\x61\xe3\x75\x26\x3e\x3d\x9c\x21\xf6\x97\x9b\xc7\xfc\xab\x75\xb5\x0d\xd0\xa2\xf7\x07\xeb\xca\x34\xe1\x6f\x97\x4e\xba\xdc\x48\xd3\x76\x61\x53\x58\x13\x50\x1a\x72\x31\x97\x46\x96\xd4\x67\x42\x1a\xa5\xfa\x46\xa6\x9c\x81\xf7\x77\x15\xee\x48\xe5\x3f\x3c\xb9\x28\x05\x58\x07\xbd\x7b\x9a\x0c\x86\xeb\x0b\x7d\xa4\x88\xaf\x6d\x49\xaa\x0f\xa0\x6c\x2d\x4d\xaf\x9f\x96\x46\x91\xa9\x43\x93\x89\x27\x01\x8e\x7e\x77\xd2\x51\x39\xaa\x3c\xba\x85\x63\xcb\x2f\x81\x5e\x82\x00\xec\x82\xad\x6c\xd1\xf9\xab\x38\xe3\xc3\x98\x45\x83\xa6\x1e\x32\x29\x14\xa1\xfb\x89\x4a\x96\x18\xa4\x35\x53\x71\x2e\x08\x46\xbd\x23\x15\xf2\x1e\x6b\xf2\x43\x75\x07\x8e\xf7\xef\xc9\x39\xeb\x04\x14\x8a\xd5\xcc\xc4\xf0\x76\x8d\xb1\x80\x93\x89\x29\x37\x91\x7f\x0e\xc0\x4c\x7c\x80\x27\x60\x76\x0e\x8b\x40\xce\x03\x3a\x9a\x52\x9e\x35\xc1\x3f\x81\xa5\xd9\xc7\x94\x18\x16\x3a\xae\x03\x1c\x64\x68\x20\x34\xd2\xf7\xaf\x17\x65\x01\x2c\x4b\xc7\xf7\xa0\xb0\x9d\x62\x5d\xb9\x2f\x76\x04\x95\xed\xcc\x4d\x11\x8d\xdd\x3a\x2a\xec\x9e\xdc\x71\x1b\xe5\x35\xa4\x44\xfe\xbd\x21\x4e\x80\xa3\x1a\x0b\x6c\x91\x95\xe4\xca\x5d\x46\x65\x1a\x6d\x63\x0d\x81\xe9\xf4\x8e\x99\x06\x0b\x9e\xfb\x0a\x10\x4e\x70\xa0\xa4\x79\xe6\xef\x77\x80\x05\x73\x34\xc1\x73\x26\x18\xc0\xcb\xda\x80\x34\x43\x6e\x68\xc0\xeb\x98\x43\xc9\x48\x44\xf1\xfe\x72\xc0\x5b\x72\x1a\xe3\x6d\x95\x2d\x9e\x89\xc5\xfc\x65\x3b\x77\x22\x11\xb3\x0a\xa4\x5b\xeb\xd0\x49\xc5\x24\x7b\x1f\xc0\x2a\xf4\x49\x58\xd0\x68\x8e\x50\x71\xe4\xf8\x35\x44\xd7\x70\x53\x70\xc6\xd8\x46\x8c\xed\xe9\x32\xcb\xb9\x02\x7b\x07\x07\x94\x81\x53\xd6\x56\x93\x89\xf5\xe3\xfe\x24\x08\xee\x28\x4d\x0d\x58\xf3\xfc\xae\x44\x5e\xf8\x9c\x26\xd7\xb3\x3f\xf3\x38\x5f\x3f\x71\x65\xbd\x7e\x35\xf8\x96\x0b\x35\xae\xb4\x78\x5e\x99\xb5\xd9\x6e\x5b\x51\x8c\x79\xee\xba\x10\xac\x19\xe7\x7f\x58\xaf\xd3\x24\xb2\xd9\xa1\xf4\xac\x13\x9f\x5a\x27\x35\xba\x63\x4f\xb5\x94\x1e\x77\xac\x5f\x26\xde\x9e\xcf\xf1\x38\x31\x91\xfa\xda\xea\x98\x7a\x72\xd5\x21\xf6\xea\x32\xdb\x64\xa2\xfb\xaa\x32\xbc\x42\xae\xa5\xe2\x8d\x5d\xe4\x1b\x7b\xf8\x7f\x35\x5a\x5e\x0f\x97\x53\xd6\xa0\xe7\xee\x21\x13\x67\x30\xc4\x59\x3c\xc6\x96\x5f\x9e\xd8\xfb\x34\x69\x17\x40\xff\x56\xa5\x71\x54\x65\xe2\x5d\x72\x5e\x91\xb1\x0e\x30\x15\x47\xe4\xa9\x3c\xd9\x2b\xe4\xce\xde\x20\x6f\xd0\xc3\x46\x51\x15\xd8\x96\xc8\x1c\xbe\x60\x11\xe7\x1f\xfa\x3a\x2d\x68\xb7\x22\xec\xfc\xa7\xb9\xf0\x0f\x9d\xff\x5c\xc7\x63\x9a\xc4\xbe\xc8\xff\x00\x81\x91\x12\x39\xdf\x07\x00\x00")
+
+func loginViewsForgotpasswordHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_loginViewsForgotpasswordHtml,
+		"login/views/forgotpassword.html",
+	)
+}
+
+func loginViewsForgotpasswordHtml() (*asset, error) {
+	bytes, err := loginViewsForgotpasswordHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "login/views/forgotpassword.html", size: 2015, mode: os.FileMode(436), modTime: time.Unix(1792322467, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginViewsLoginformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xb9\x8e\xdb\x30\x10\xed\xf3\x15\x04\x91\x22\x29\x64\x39\x41\x4a\x49\x7d\x80\x14\x69\x76\xfb\xb1\x34\x96\x09\x90\x43\x2d\x0f\x1f\x7f\xbf\x3c\xb4\xb2\x2c\x79\xbd\xb0\xaa\xb9\x39\x4f\xef\x4d\xb5\xd7\x46\x31\x09\x17\xed\x5d\xcd\x5b\x2d\xbd\x22\xce\x08\x14\xd6\x5c\xea\x5e\x50\xcc\x87\x40\x5f\x58\xbf\x53\x22\xd4\x1c\xd5\x26\x9b\x3f\x7e\xf2\xe6\x1b\x0b\x5f\xd5\x89\x23\xdb\x4b\x3c\x4f\x73\x8c\x3e\x8d\xb9\x9b\x7c\x53\x95\xc1\x5c\x24\x96\x6f\xc7\xc2\x9a\xff\xda\x6e\xb3\x59\xf4\xae\xb0\xaa\xe6\x7f\xb6\xb3\x89\xa9\x59\x75\x85\xa0\xc1\xbb\xa2\xd5\xe4\x40\x10\x9a\xdb\x82\x54\x24\x61\x87\xb2\x79\xb1\x68\x22\xa6\xaa\xcc\xfe\xba\x2e\x4d\x8a\x30\x95\xee\x50\x26\x94\x09\x7e\x82\xae\x04\x49\xa4\xde\x1d\x6a\xfe\x9b\x33\x83\x6f\x5e\x18\xec\xe6\x7f\x89\x33\x77\x19\x82\xe3\xf0\xec\x38\x03\xef\xf4\x5e\xb7\xde\x2e\x36\x2e\xbf\x5a\xf9\x19\x4c\xff\xc1\xda\x93\x36\xdd\x53\x98\x86\xb1\x69\x85\xe2\x9a\xc8\x40\xae\x7e\xe8\x6f\x0f\x40\x3d\xa6\x01\xad\x44\x30\xaf\x20\x45\x07\x4e\x68\x9a\x34\x70\xf3\x70\xa4\x35\x3e\x8b\xd6\x42\x8f\x76\xa6\xa4\x69\x81\xcd\x77\x34\x46\x1b\xce\x5a\x19\x22\x35\xcf\xde\x7a\xd6\x9d\x79\x35\x17\x74\x8c\x1b\xb4\x61\x7d\x24\x27\x40\x5a\xde\xfc\xcd\x31\x36\x0b\x2e\xd4\x76\xa5\x61\x15\x7e\xcc\xcc\x3d\xd5\x2e\xe5\x3c\x37\xe7\xaa\x5e\x5c\x82\x1d\x80\x3e\x7a\xa3\xfd\xf8\x16\xd6\xe2\xd8\x79\xe7\x34\x8d\x1c\xe5\x33\x9c\x7e\x61\x48\x1b\x10\x36\x50\x1a\xac\xc1\x08\x05\xe6\xc2\x9b\x7f\xba\x67\x82\x12\xc0\xdc\xfc\x39\xae\xfb\xcb\x8d\x55\x55\x19\xf9\x6b\xde\x03\x00\x00\xff\xff\x53\xe0\x6c\x6a\x30\x04\x00\x00")
+var _loginViewsLoginformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\x4b\x8f\x9b\x30\x10\xbe\xf7\x57\x8c\xbc\x3d\xb4\x52\x13\xd2\xaa\x47\x60\x6f\x95\x2a\xf5\x50\xa9\x0f\xa9\xa7\x68\x80\x81\x58\xf5\x83\xda\x26\x59\xfe\x7d\x6d\xc3\xb2\x84\x90\xac\x92\xd3\x78\x1e\xdf\x7c\x33\xf9\x98\xb4\xd6\x46\x82\xc0\x5e\x77\x2e\x63\xa5\x16\x9d\x54\x0c\x14\x4a\xca\x98\xd0\x0d\x57\x21\xee\x1d\xcd\xc6\x76\x85\xe4\x3e\xe7\x28\xb7\x83\xf9\xee\x3d\xcb\xdf\x80\xff\xa5\x15\x3f\x42\x2d\xe8\x69\xc2\x31\xfa\x34\xc6\xce\xe2\x79\x9a\x78\x73\x11\x58\xf6\x0e\x89\x19\xfb\xb8\xdb\x0d\xe6\xa6\x71\x1b\x2b\x33\xf6\x79\x37\x43\x8c\xc5\x6d\x60\xc5\xeb\xc8\x88\x9e\x1c\x19\x85\xe2\x07\x77\xc4\xf2\x3f\xba\x03\x34\x04\x58\x78\x64\x70\x1a\xe2\x24\xc1\x48\x6d\x8b\x2a\xd4\x15\x5c\x55\x2b\x95\x69\x12\x12\xf2\x6d\x9a\xb4\x8b\x6e\xb2\xda\x70\xd5\x76\x6e\x53\x6a\xe5\x90\x2b\x32\xe7\x09\x31\x49\x60\x41\x22\xff\x65\x03\xa2\xa4\x34\x19\xde\x97\x79\x11\x29\xd0\x90\xba\x22\x11\x79\x44\x8a\x71\xd1\x92\x2b\x41\xaa\x71\x87\x8c\x7d\x62\x60\xe8\x5f\xc7\x0d\x55\xf3\xff\x84\x81\xeb\x5b\xff\x70\x9e\x3c\x03\xec\x9c\xae\x75\xd9\xd9\x05\xe3\xe4\x35\xca\xf7\xcc\xf4\x1d\xad\x3d\x69\x53\xdd\x35\x53\x3b\x16\x5d\x4c\xf1\x12\x18\x06\x79\x79\xfb\xfa\xf2\x80\xaa\xa1\x08\x50\x0a\x42\xf3\x1b\x05\xaf\xd0\x71\xad\x26\xc5\x9d\x35\x0e\x22\x0a\x6d\xc9\x5a\x6c\xc8\xce\x74\x3b\x11\xd8\xbe\x25\x63\xb4\x61\x50\x0a\xef\xc9\xd8\xf0\xba\xc4\x5a\xc1\xcb\x18\x57\xc7\xc0\xa0\xf4\xf4\x49\x39\x8e\xc2\xb2\xfc\xeb\xe0\x83\x99\x73\xa1\xed\x9b\x90\x58\x96\xba\x53\x6e\x2f\x74\xf9\x97\xaa\x28\x58\x03\xa3\x13\xb8\x05\x47\xb2\xd5\x06\x0d\x17\x3d\x0c\x39\x80\xb5\xd7\xa9\x97\xb0\x06\x89\xaa\x87\x1a\xb9\x08\x5e\x17\x52\xdd\x5d\xcd\x3d\xc6\x3e\x60\xec\x9f\x8b\x59\xfe\xf3\x0a\xec\x07\x38\x21\x77\x80\x20\xb5\xf4\x63\x42\x41\x7e\xad\x04\xce\xf4\x5c\x35\x80\x8d\xd7\xcc\x95\xce\x2b\xee\xdb\x82\x5c\x3b\x0d\xcb\x9b\x31\x37\xe7\xa7\x63\x71\x6e\xe2\x37\x3e\xd6\xc6\xcf\xf9\xe6\xc1\xb9\xfc\x26\x8a\xce\x39\xad\x46\x69\x0e\xb7\x6e\x52\x8e\x0f\x1b\xe4\xd6\xef\xc8\x5b\xad\xe1\x12\x4d\xcf\xf2\x6f\xba\x81\xb0\x8a\xa9\xf8\x2a\xe8\xc1\x90\xbf\x58\x0f\x89\xdf\x63\xa3\xdd\xa4\xfb\xfc\x4b\x7c\x43\x1f\x84\xf0\xec\x7d\x5c\x05\x5c\x2e\x6a\x7d\xda\x31\x2b\x0d\x8d\x64\xfe\x1f\x57\x7a\x93\x7b\xe6\x05\x00\x00")
 
 func loginViewsLoginformHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -306,12 +378,12 @@ func loginViewsLoginformHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/views/loginform.html", size: 1072, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/views/loginform.html", size: 1510, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginViewsLoginsmsformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x53\x31\xb3\x9b\x30\x0c\xde\xfb\x2b\x54\x5f\x87\x76\x20\x49\x87\x76\x4a\x58\xde\xd4\xb9\x77\xdd\x0d\x08\xf0\xc5\x96\xa9\x6c\xd2\xe4\xdf\x57\x36\x84\x23\x24\xef\x79\x92\xe4\xef\xd3\x27\x59\xf2\xb1\xf5\xec\x80\xba\xa2\xb6\x5e\x9f\xc1\xea\x9b\x1f\xe3\x49\xd5\xde\x8e\x8e\x14\x90\x76\x78\x52\xc1\x85\x04\x53\x60\x9a\x95\x23\xa4\x30\x56\xce\x08\xfc\xe2\x76\x93\xf9\xf5\x9b\x2a\x3f\x81\x9c\x63\x63\x2e\xd0\x5a\xbc\x2e\x29\xd9\xff\x9b\xef\x1e\xee\xcb\xe3\x5e\xcc\xcd\xc5\xb6\x8c\x04\x2c\xba\x58\x5c\xc3\x49\xfd\x38\xcc\x7e\x72\xbe\x1f\x0e\xab\xa4\x77\x7e\xf9\x66\x4d\x7d\x86\xd8\x23\x58\x43\x67\x30\x94\x6d\xa9\x1c\x02\x52\x84\xe8\x41\xf2\x33\x0c\xbd\x27\x04\xcf\x20\x41\xe4\x8c\xa9\x7d\x83\xd0\xb2\x77\x0b\xa3\x47\x46\x30\x6d\x62\x80\x16\x73\x24\x5d\x59\x94\x1c\x8f\xb2\x8f\x5d\xe4\x90\x6b\x0a\x43\xc3\x18\x8b\xda\x53\xd4\x86\x90\x1f\x01\x19\x64\x75\x85\xb6\x7c\x4b\xb2\xb9\xb6\xea\x96\x54\x8f\xfb\x29\xfe\x8c\xcf\x19\xd3\xdb\x3b\xa1\xd8\xe9\xe9\x5d\x48\x65\x2b\x10\x41\xa7\xaf\x16\xa9\x8b\xfd\x49\xfd\x94\x80\xa1\x95\x27\x24\xc6\xbf\xa3\x61\x94\x31\x7e\xbe\x13\xa9\x35\xec\x74\x34\x9e\x76\xb3\x83\x8d\x7a\xd2\x9d\xcf\xb2\x0f\x93\xa0\x1e\xa3\xaf\xbd\x1b\x2c\x46\x09\xfb\xb6\xcd\x22\x75\xaf\xa9\xc3\x5c\x1a\x63\xc0\xf8\x47\x5b\xd3\x64\x85\x65\x3d\xb6\x13\xcb\x0d\x61\x08\xba\xc3\xb0\xac\xd8\xbd\xb1\xdd\x17\x64\xf6\x9c\xfb\x4b\x8a\x45\x6f\x1a\x49\xdf\x6a\x1b\xa4\x86\xda\xea\x20\x9c\x09\xf2\x9c\xfd\x85\xc2\x49\x19\xba\xa4\x9a\x72\x13\xe5\xaf\xc9\xc9\xa3\x7f\x31\xc6\xf7\xa6\xbb\xff\x68\xbc\xaf\x96\x7a\xbb\xed\x6b\x73\xbd\xf4\x9b\x8f\x12\x06\x4d\x77\x6e\xb2\x3f\xfe\x2a\xcf\x2b\x58\x8d\x31\x7a\xf9\x00\xb7\x21\x4d\x2e\xff\xd2\xe5\xd1\xe4\x9a\xb5\x09\xd8\xa4\xa7\x1d\xd8\x38\xcd\x37\x55\xfe\xce\xa0\xdc\xe0\x44\x7e\xbf\xaf\xd7\xc5\xcd\xa8\xe3\x3e\x4d\xb1\xfc\x1f\x00\x00\xff\xff\xeb\x53\x9b\x48\x63\x04\x00\x00")
+var _loginViewsLoginsmsformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\xbb\xae\xdb\x30\x0c\xdd\xfb\x15\xac\xd0\xa1\x05\xea\x24\x1d\xda\x29\xf1\x72\xa7\xce\x2d\x0a\x74\x0a\x18\x99\xb6\x85\xe8\xe1\x4a\x72\x6e\xfc\xf7\xa5\x64\xc7\xc8\xc3\xb9\xc0\xf5\x44\x51\xe7\xf0\x90\xf2\x91\xb6\xb5\xf3\x06\x6c\x53\x48\xed\xf0\x08\x1a\x07\xd7\xc7\x9d\x90\x4e\xf7\xc6\x0a\xb0\x68\x68\x27\x82\x09\x09\x26\x40\x55\x57\x0b\x26\x85\xfe\x60\x14\xc3\x4f\x66\x35\x86\x9f\xbf\x88\xf2\x03\xf0\xb7\xad\xd4\x09\x6a\x4d\xe7\xb9\xa4\x77\xaf\xd3\xde\xcd\x7e\xb9\x5d\x73\x78\xb7\x71\xdf\x46\x02\x16\x4d\x2c\xce\x61\x27\xbe\x6f\xa6\x75\x5a\x7c\xdb\x6c\xae\x8a\x5e\xf8\xe5\x8b\x56\xf2\x08\xb1\x25\xd0\xca\x1e\x41\xd9\x1c\x73\xe7\x10\xc8\x46\x88\x0e\xb8\xbe\x87\xae\x75\x96\xc0\x79\xe0\x24\xf9\x8c\x91\xae\x22\xa8\xbd\x33\x33\xa3\x25\x4f\xa0\xea\xc4\x00\xe4\xb0\xb7\x78\xd0\xc4\x35\x6e\x65\x6f\xa7\xc8\x29\x53\x15\xca\x76\x7d\x2c\xa4\xb3\x11\x95\x25\x7f\x0b\xc8\x20\x8d\x07\xd2\xe5\x4b\x92\xcd\xbd\x1d\x86\xa4\xba\x5d\x8f\xf9\x47\x7c\xae\x98\xce\xde\x30\x45\x8f\x47\x6f\x42\x6a\x5b\x00\x0b\x1a\x3c\x6b\xb2\x4d\x6c\x77\xe2\x07\x27\x94\xbd\x5a\x31\xc9\xd3\xbf\x5e\x79\xe2\xdf\xf8\xf1\x42\xb4\xb5\xf2\x06\xa3\x72\x76\x35\x2d\xa8\x12\x0f\xba\xd3\x37\xfb\x61\x14\xc4\x3e\x3a\xe9\x4c\xa7\x29\x72\xda\xd5\x75\x16\x91\x2d\xda\x86\x72\x6b\x9e\x02\xc5\x3f\xa8\x55\x95\x15\xd8\x1e\xa9\xc9\x44\xab\x9d\xec\x03\xcc\xd1\xc2\xa4\xc9\x08\x69\x4e\x0a\x01\x1b\x0a\xb3\xf3\x2e\xf3\xae\x3e\x91\xf7\xce\xcf\x15\x8b\x56\x55\xac\x5a\xa3\x0e\xdc\x9a\xd4\x18\x98\x33\x42\x1e\xab\x2f\x28\xec\x84\xb2\xa7\xd4\xea\x3e\x0f\x57\xfe\x1c\x57\xd9\x12\x0b\xbf\xf7\x69\x15\x94\xd2\xf5\x36\xee\xb5\x93\x47\x3e\xca\xf2\x6f\xb2\xda\x94\x04\x15\x20\x92\xe9\x9c\x47\xaf\xf4\x00\x23\x06\xb0\xce\xfe\x73\x0e\x0c\xda\x01\x6a\x54\x3a\x65\x63\x82\xc6\xf0\x1e\x71\xae\xb1\x4f\x35\xf6\x17\xb2\x28\x7f\x3f\x29\xfb\x15\x5e\x51\x45\x40\x30\xce\x64\xe7\x11\x9f\x2e\x1b\xdb\x0f\xca\x36\x80\x0d\x3b\xf6\x89\xf2\x92\xd9\xd7\x6f\xb9\x7d\xe9\x8e\xdf\x5f\xfe\xeb\xf0\xfa\x0d\xb8\x7b\x37\x42\x87\xf6\xc2\x4d\xf1\xdb\x2f\xc7\xe3\x8d\x3c\xf4\x31\x3a\x7e\x0f\x86\x2e\x19\x39\x3f\x5a\xb3\x59\x78\xdb\xa3\x0a\x7c\x46\x1c\x75\x5e\x19\xf4\x83\x28\x7f\x65\x50\x1e\x70\x24\x3f\x9f\x6b\xb9\xb9\x09\xb5\x5d\x27\xf7\x96\xff\x01\x77\xd2\xee\x4b\x72\x05\x00\x00")
 
 func loginViewsLoginsmsformHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -326,12 +398,12 @@ func loginViewsLoginsmsformHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/views/loginsmsform.html", size: 1123, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/views/loginsmsform.html", size: 1394, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginViewsLogintotpformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x53\x4d\x8f\x9b\x30\x10\xbd\xf7\x57\xb8\xd6\x1e\xda\x03\x49\xba\x52\xf7\x04\x1c\x57\xea\xb9\x52\xef\x13\x18\x60\x24\x7f\x50\xdb\xa4\xc9\xbf\xef\xd8\xce\xb2\x04\x92\xa0\x28\x1a\xdb\x33\xef\xbd\xf9\x2a\x3b\xeb\xb4\x50\x70\xb1\x53\xa8\x64\x63\xd5\xa4\x8d\x14\x06\x34\x56\x32\xd8\x30\xc6\x67\x3e\xf7\x85\x9f\x8e\x9a\xd8\xe5\xa4\x77\xd9\xfc\xf6\x5d\xd6\x5f\x04\x7f\x65\x4b\x27\xd1\x29\x3c\xcf\x30\xce\xfe\xbb\xbe\xdd\xbc\xd7\xe5\x9e\xcd\xd5\xc3\x9a\x3a\x3a\x16\x7d\x28\xce\xbe\x92\x3f\x0f\xd7\x73\x3c\xfc\x38\x1c\x16\xa0\x1f\xf1\xf5\x3b\x29\x25\xc8\x88\x30\xa0\x78\x13\x2d\xf5\x14\x44\x63\x5b\x14\x9d\xb3\x3a\xdd\xc2\xc4\xff\x26\x50\x03\xc1\x3a\x01\xe3\xa8\xa2\x49\xd6\x08\xfe\x31\xb9\x13\xe3\x60\x0d\xee\x56\xea\x12\x83\x6e\x0b\x32\xe3\x14\x8a\xc6\x9a\x00\x64\xd0\xdd\x3a\x24\x27\x05\x47\x54\xf5\x6b\xf1\x0e\x4d\x62\xf8\xe4\x8b\x24\x51\x4c\xb9\xcf\x3e\xdb\xd8\x84\x2e\xc2\x65\x8c\xf5\xc6\x73\x48\xb5\xd6\x1c\xa2\x52\xa9\x63\x0b\x22\x80\x14\xac\x44\xc3\x59\xa1\xe9\xc3\x50\xc9\xb7\xec\x47\x66\x75\xb1\xf4\xd8\x90\x5d\xbf\x5b\x18\x87\x7f\x27\x72\xd8\x2e\x7a\x9e\x09\x39\x0d\xdb\x58\x3d\x2a\x0c\x7c\x6f\xbb\x2e\x69\x88\xb7\x9d\x6d\x26\xff\x08\x9d\x55\x34\x03\x98\x1e\x93\x7e\x87\x1e\xc3\x1f\x50\xd4\xa6\x62\xcc\x33\xb3\x6e\x63\x12\x8f\xde\x43\x8f\xfe\x73\xf0\xe6\xf4\x77\x2f\xe8\x9c\x75\xb3\x82\x62\xa0\x96\x09\x3a\x50\x9e\x95\x36\x0a\x3c\x47\x65\x97\x2d\xfe\x1d\x8e\x4a\x92\x39\x45\x55\x29\xd5\xfa\x57\x3e\x88\x57\xd1\x3d\x69\xe1\x66\x3a\x12\xf0\x9d\xa1\xd9\x3f\x9b\x9a\x7b\x3b\xb0\x5e\x8e\xa5\xb9\xdc\x91\xd5\x5e\xf9\x11\xcc\x47\x6c\xb4\x9f\x6f\xd6\x76\xb2\x8f\x53\x08\x9c\x5d\x1e\xbe\xbc\xd4\x73\x31\xf9\xd9\x01\x79\x9e\x0b\xb6\x46\x47\x1a\xdc\x25\x8d\x58\x4b\x1e\x8e\x0a\xdb\x4a\x7e\x9d\xdb\xf4\x92\xca\x27\xeb\xdf\x09\x23\xe5\x9f\xb1\x1f\xa7\x7d\x5f\xfb\xd5\xab\xdc\x47\xd8\xfa\x7f\x00\x00\x00\xff\xff\x92\xbd\x84\x16\x9c\x04\x00\x00")
+var _loginViewsLogintotpformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\xc9\x8e\xdb\x30\x0c\xbd\xf7\x2b\x58\x61\x0e\x2d\x50\x27\xe9\x00\x9d\x53\xec\xe3\x00\x3d\xb7\x28\xd0\x53\xc0\xc8\xb2\x23\x54\x8b\x2b\xd1\x99\xf8\xef\x4b\xc9\x89\x27\x71\x16\xa0\x46\x10\x50\x14\xf9\x1e\x49\x91\x5c\x37\x3e\x58\x30\x38\xf8\x9e\x4a\x21\xbd\xe9\xad\x13\xe0\xd0\xaa\x52\x90\xa7\x2e\x5d\xf3\xb9\x2d\x62\xbf\xb5\x9a\x4d\xf6\x76\x31\x8a\x9f\x3e\x8b\xea\x03\xf0\xb7\xae\xf5\x1e\x1a\xa3\x0e\x13\x4c\xf0\x6f\xc7\xbb\x8b\xfb\x6a\xbd\x64\x71\x76\x31\xa7\x4e\x86\x45\x4b\xc5\x21\x96\xe2\xdb\xea\x78\x4e\x87\xaf\xab\xd5\x19\xe8\xc9\xbf\x7a\xd5\xc6\x80\x76\x40\x3b\x05\x2f\x50\xeb\x56\x13\x48\x5f\x2b\x68\x82\xb7\x59\x8b\x3d\xff\x3b\xd2\x12\xc9\x07\xc0\xae\x33\x49\xd4\xde\x01\xff\x98\x3c\x40\xb7\xf3\x4e\x2d\x66\xd1\x65\x06\x5b\x17\xda\x75\x3d\x15\xd2\x3b\x42\xed\x54\xb8\x34\xc8\x46\x06\xb7\xca\x54\xcf\xc5\x2b\xca\xcc\xf0\xce\x97\x48\x52\x30\xeb\xe5\x68\x73\xed\x9b\xd1\x81\x86\x2e\xd5\x5b\x1d\x28\xd7\xda\xb2\x8b\xc9\xa5\x4e\x4f\x90\x00\x04\x70\x24\x16\x0f\x46\xb9\x96\x76\xa5\x78\x19\xed\xb4\x9b\x29\xce\x2d\xae\xc8\x8e\xdf\x25\x4c\x50\x7f\x7b\x1d\x54\x7d\xf6\xe6\x23\x21\xa7\xe1\xa5\xb7\x9d\x51\xc4\x7a\xdf\x34\x39\x86\xa4\x6d\xbc\xec\x23\x4c\xd2\x3d\x1e\x8e\x47\xee\xd0\xb5\x2a\x67\x12\x54\x54\xf4\x0b\x8d\xae\x73\x59\xa6\xee\x99\x3f\x68\x4e\x43\xc5\x88\xad\x8a\xef\x2d\x38\x15\x62\xf1\xa4\x42\xf0\x61\x8a\xa5\xd8\xe9\x9a\x09\x1a\x34\x91\x63\x96\x06\x23\x7b\x8d\x26\xd7\xf8\x37\x38\x4a\xa1\xdd\x3e\x45\xb5\xc9\x59\x57\xdf\xc7\x13\x3c\x43\xf3\xe0\x35\xaf\x1a\xe5\x2e\x3a\x4a\xe9\x7b\x47\x1b\xe3\xe5\x1f\x55\x8b\xea\x77\xea\xb7\xa3\x12\x74\x04\x52\xb6\xf3\x01\x83\x36\x03\x8c\x36\x80\x0d\xa9\x00\xe4\x3d\xbf\x94\x1b\x38\x0e\x6d\x92\x96\x92\x29\xc5\xff\x21\x67\x8c\x4d\xc2\xd8\x9c\x9c\x45\xf5\xf3\x0e\xec\x17\x78\x43\x9e\x1c\x04\xeb\x2d\xa7\x0b\x5b\xc5\x65\x57\x40\x61\xd0\xae\x05\x6c\xb9\xf7\xef\x30\xdf\x1a\x9b\xe5\xa3\xb9\xb9\xb5\x05\xe6\xeb\xe1\x5c\x3c\xdf\x12\xb3\xcd\x12\x3b\x74\x27\xdf\x24\x3f\xde\x2d\xd7\xb3\xbd\xed\x89\xf8\x51\xc7\xf1\x1b\xd7\xda\xd4\x44\x7c\x1d\x50\x47\xae\x11\x4b\x5d\xd0\x16\xc3\x90\x87\xac\xd6\x11\xb7\x5c\xbb\x52\x7c\x9c\xda\xf3\x29\x77\x8d\xa8\x7e\x64\x8c\x9c\xff\x88\x7d\x3f\xed\xdb\xb1\x1f\xad\xd6\xcb\x04\x5b\xfd\x03\x19\x89\xe9\xf5\x9e\x05\x00\x00")
 
 func loginViewsLogintotpformHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -346,7 +418,27 @@ func loginViewsLogintotpformHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login/views/logintotpform.html", size: 1180, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login/views/logintotpform.html", size: 1438, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _loginViewsResetpasswordHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x57\x4b\x6f\xdb\x38\x10\xbe\xf7\x57\x4c\xb5\x45\xdb\x05\xa2\x38\x2d\x8a\x9c\x24\x01\xdd\x43\x80\x05\xb6\x7b\x58\x04\x05\x7a\x32\x68\x69\x24\x11\xe1\x43\x4b\x52\x76\xfc\xef\x3b\xa4\x1e\xb6\x14\xd9\x71\xd2\x1a\x81\x43\x0f\x47\xf3\xe2\xf7\x0d\x47\x49\xa9\x8d\x04\xc1\xf6\xba\x75\x69\x94\x6b\xd1\x4a\x15\x81\x62\x12\xd3\xc8\x6f\xd1\xba\x8a\x6d\xbb\x91\x9c\xb6\xb7\xf2\xba\x5b\x7e\xfc\x33\xca\xde\x00\x7d\x92\x82\x6f\xa1\x14\xf8\x38\x9a\x30\x7a\xd7\xef\x4d\xf6\xb3\x64\x45\xcb\xd9\xc6\xdc\xad\x57\x4c\xa3\x4f\x37\x37\xdd\x32\xae\x5c\x6c\x65\x1a\x7d\xb9\x39\xb2\x18\x1e\xae\x3f\x65\xff\xa1\x45\x07\x0d\xb3\x76\xa7\x4d\x91\xac\x48\x34\xd5\xf1\x0e\x7c\xec\xb5\xde\x85\xc8\xb9\xda\x32\xc1\x8b\x7f\xb8\x7a\x98\x99\x0b\xea\x4d\x76\x5f\x73\x0b\x82\xb6\x81\xfe\xf7\xda\xa0\x0d\xe0\x63\xc3\x0d\x16\xd7\xc9\xaa\x59\x78\x4e\x16\xf1\xa6\x75\x4e\x2b\xa8\x0d\x96\x69\xf4\xc7\x8a\xca\x56\x69\x37\x44\x16\x41\x2e\x68\x99\x46\xa4\xd8\x18\x2e\x99\xd9\x03\x2d\x0d\xe3\x16\x8b\x88\xd2\xf8\xbf\x45\xeb\x80\x81\xc2\x5d\xf0\x9e\xac\x46\x93\xb3\x8c\xa6\x15\x3c\x59\xc5\xe3\xa4\xdd\x4e\xdf\x7d\xfd\x86\xae\xd6\x05\xbc\x7f\x0f\x6f\xa7\x75\x18\x44\x43\xac\xa1\xa6\x4b\xc5\x99\xd7\xf2\xd8\x6c\x9a\xc2\x07\x47\xf9\x7e\x88\xb2\x3b\x2e\x04\x55\x0e\x5c\x8d\x70\x0b\x05\xaf\xb8\x83\x5c\x17\x08\xa5\xd1\x32\x48\x59\x4b\xdf\xca\xf1\x9c\x39\xaa\x2c\x6b\x1a\xe1\x97\x9c\xaa\x47\x7f\x94\x86\x81\xa6\xd6\x0a\xaf\x17\x72\xbd\x28\x10\x2b\xed\xb9\x38\x5c\xcd\x1c\xec\x98\x05\x4b\x41\x80\xd3\x97\xb8\xa4\xd3\xe0\xaa\x69\x5d\x9c\x6b\xe5\x18\x57\x68\x9e\x2a\x05\x45\xc1\x36\x28\xb2\xcf\xf1\x1d\xcb\x43\x72\x87\x54\x7d\x7e\xde\x7f\xb2\xea\x74\x96\x9f\x0f\x5e\xc0\xed\x1b\xa2\x9e\xc3\x47\x17\x4e\x52\xd2\x63\x22\xa4\xea\x0d\x44\x1e\x3a\x92\x3d\x0a\x54\x95\xab\xd3\xe8\xb6\xd3\xe1\x6a\x26\x38\xd6\x58\x74\xd6\x7f\xa6\xa6\x0c\x61\xd1\x23\xbd\xa7\x7f\xe7\x90\xd2\xd0\xb9\x96\x8d\x40\x47\x32\x5d\x96\x9d\xa8\xd4\x79\x6b\xcf\x99\xa6\x30\xf2\x9a\xa9\x0a\xbb\xe0\x05\x32\xf3\xdd\xe3\x2e\x54\x63\xec\x1f\xa7\x0e\x58\xa2\xb5\xac\x42\xdb\x35\xa1\x90\xfb\xf5\x3b\x34\x46\x9b\x50\x02\x1f\x41\x5c\xf3\xc2\x37\x29\x26\x2c\x8e\x24\xeb\x54\x96\x6d\x2f\xd8\x4f\xa3\x9e\x0d\xeb\x90\x6c\xf6\x77\xcf\xfa\xcf\x50\x9e\x39\xc4\x45\x9c\x9c\xf4\xc0\xf2\x5c\xb7\xca\xad\x85\xce\x1f\x3c\xe3\x7f\x78\xc8\xf5\x42\xdf\x69\x1c\xca\x46\x1b\x66\xb8\xd8\x43\xa7\x03\xac\x74\x68\x08\x9e\x9a\x0e\x48\xed\x29\x16\x2e\xbc\xd4\x79\x55\x67\x5f\x1a\x00\xd9\x59\x7b\x3b\xeb\xc1\x40\x94\xdd\x9f\x30\x7d\x45\xe4\xe0\xbe\x19\x49\x2d\x3d\x43\x36\x48\xe5\x27\xda\x98\x3d\x57\x15\xb0\x8a\xe0\x7f\xc6\xfb\x29\x06\xad\x2e\xa1\xd0\x4b\x79\xf6\x2f\xb5\xcb\x43\xf7\x7f\x9e\x57\x13\x2e\x1d\x9a\xf3\x13\xfa\xcc\x48\x70\xd0\xec\x88\x39\xfe\x3e\x87\xfd\x05\xce\xfc\x56\x3a\x0c\x41\x8c\x94\x78\x2d\xfa\xc7\xd4\xa3\xec\xab\x03\x0a\x8a\x6e\xa2\x5b\xa0\x40\x0d\xc1\x1f\x8d\x05\x46\xa7\x3f\x54\xe4\xa5\xb8\x1b\xa2\x5c\x7b\x00\x52\xc7\x36\x74\xb5\xdc\x53\x47\x1e\xe4\x01\xfc\x04\xc4\xb0\xf5\x6a\xe3\x3d\x5a\xec\xba\xb5\x68\xfc\x99\xcd\x9c\xe4\x4c\x81\xd2\xbe\xff\x07\xbd\xae\xe3\x0f\xba\xbf\xee\x15\x25\x31\xe8\x12\x97\x41\x11\x58\x51\x18\x32\xf6\x6a\xbf\x06\x5b\xdb\x37\x11\x9f\x44\x41\x37\x1a\x55\x71\xf4\x6c\x30\x27\xda\x8a\xfd\x15\x9d\xa1\xd6\x96\xee\x5b\x8a\xa3\xa6\x5e\x42\x17\xdc\xab\x7d\x6e\x0c\xb2\xbc\xf6\x5e\xef\x27\xce\xe8\xf6\x26\x14\x53\x0c\x94\x22\x03\x42\x32\x83\x4e\xf5\x85\xde\x7f\xad\x6d\x1c\x4f\x40\xd3\xd9\x73\xa2\x66\x1b\x3a\x94\x7e\x10\xf5\xeb\x33\x7c\x9b\xcd\x53\x67\x4a\x76\x98\xfd\xba\xe6\xd0\x0d\xc7\xc7\x13\x5f\x37\xe6\xc1\x61\xf6\x0b\x9d\xa0\xe0\x96\x6d\xa8\xef\xa6\xd1\xdb\xc0\xe7\x77\xe1\xd6\x89\x9e\xcc\xb4\x27\x06\xc1\x67\x0a\x77\x61\xbe\x4b\xe3\xe4\x89\x09\xf3\x78\xe2\x7a\x76\x56\x6c\xba\x1b\x6e\x84\x49\x4d\xe3\xd6\x06\x51\x11\x38\xe9\x89\x2b\xcf\x06\x92\x6d\xb1\x13\x0a\x5d\x55\x54\x20\x2a\x38\xe0\x16\xcd\x7e\x47\x78\xc1\x8b\x47\xed\x67\x86\xeb\x84\x0f\xfb\x25\xa3\xab\x2e\x66\xd4\x1f\x77\xb1\xc0\x92\x02\x4f\x56\x3c\x83\xbf\x58\xfe\xe0\xc7\x40\x8a\x82\xab\x65\x04\x5e\x30\x89\x2f\xbd\xda\xcc\xdf\x79\xfa\x65\xe2\xdf\x0e\x64\xf6\xe6\x27\xad\x9f\x0a\x32\x77\x0d\x00\x00")
+
+func loginViewsResetpasswordHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_loginViewsResetpasswordHtml,
+		"login/views/resetpassword.html",
+	)
+}
+
+func loginViewsResetpasswordHtml() (*asset, error) {
+	bytes, err := loginViewsResetpasswordHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "login/views/resetpassword.html", size: 3447, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -391,7 +483,7 @@ func organizationServiceJs() (*asset, error) {
 	return a, nil
 }
 
-var _organizationViewsApikeydialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x57\x51\x6f\xdb\x36\x10\x7e\xcf\xaf\xb8\x12\x43\x93\x02\x71\x54\xb8\xeb\xcb\x66\x0b\x08\x32\x60\x08\xda\xad\xc3\x9a\x3e\x17\x67\x89\x96\x88\xd0\xa4\x46\x52\xb1\xbd\xb6\xff\x7d\x47\x52\x96\x25\x5b\x72\xbc\x02\xcd\x43\xec\x90\x77\x1f\xbf\x3b\x7e\x77\xbc\xcc\x56\xf9\x24\x17\x28\x75\x01\xaa\x98\x64\x52\xe3\x63\x7a\x01\x30\x5b\x6a\xb3\x02\x85\x2b\x3e\x67\x58\x89\x47\xbe\xf5\x0b\xcc\x6f\xd1\x26\xf9\x38\xad\xe5\x02\x4d\x5c\x08\x8b\xb9\x78\x82\x4c\xa2\xb5\x73\xb6\xdf\x0f\x9f\x96\xed\xcd\xc8\xb0\x9c\xa6\x33\x5b\xa1\xf2\x07\x8a\xe5\x9c\xbd\x88\x07\xdc\x58\x9e\x19\xee\x58\xfa\x27\x5f\xc3\x2c\xf1\x16\xe9\xed\x5f\xf7\xf0\x8e\x6f\x67\x09\xf9\x74\x21\x82\xfb\x52\xf2\x4d\xda\x18\x76\x37\xe9\xf4\x45\xed\x9c\x56\x1d\x3a\x22\xd3\xaa\x59\x65\x31\x50\x91\x3d\xce\x59\x86\x2a\xe3\xf2\xea\x55\x8f\x60\x84\xf0\x1e\x40\x9f\xf6\xa9\x98\x58\x93\x51\x1a\xac\xe5\xce\x26\x62\x55\x24\x22\xfb\x4c\x99\xb2\xfc\xf3\xf4\xe7\x6a\x73\x43\x16\x0c\xd0\x08\x9c\x48\x5c\x70\x39\x67\x77\x7e\x0f\x62\x56\x19\x31\x6c\xd0\x7a\x24\x93\x96\x65\x27\x85\x09\xe5\xb0\x49\x71\x72\x98\xe3\xfd\x45\x4d\x08\xcb\x71\xe5\x46\x73\xdf\x37\x63\x20\x71\xab\x6b\x47\xd1\x6a\x59\xaf\xd4\x61\xac\xde\x37\x5a\xf4\x37\xda\x44\xa8\xaa\x76\x01\x0c\x85\xe2\xe6\xd8\x28\x18\x86\xd0\xd3\xf7\xfe\xf7\x2c\x89\x7f\x0c\x1b\x06\x38\x7f\x05\x2b\x9d\xfb\x64\x05\x5b\x06\x2b\xa1\x24\x57\x85\x2b\xe7\x6c\xca\xc0\xf0\x7f\x6a\x61\x78\x0e\x6e\x5b\x91\x02\x1d\xdf\x50\x18\xc4\x05\x6b\xa7\x97\x3a\xab\x29\x4e\x67\x6a\xce\x1a\x85\x06\x8c\x00\xcc\x46\x4e\xf5\x41\xfa\x33\xb9\xb5\x58\x70\xdb\x15\xf5\xcd\xde\xfb\xe6\x27\x6e\x8c\x36\x23\x20\x03\x40\x94\xee\x1d\x6f\x96\xde\x3a\x90\x1c\xad\x83\x29\x64\x25\x1a\xcc\x1c\x37\xb6\x0d\xa5\x73\xb9\x47\xa0\x27\xb6\x8e\x88\x3f\xa1\x14\x39\x3a\xa1\x55\xe0\x6a\xdb\xbc\x4c\x4a\x91\x13\xa1\x25\x4a\xcb\xff\x4f\x04\x79\x5d\x51\x31\xa0\x23\xa7\x87\x52\x58\x08\xe9\x00\xfa\x82\xd2\x70\xcc\xb7\x50\xdb\xef\x61\x1f\x65\x7f\xa8\x9d\x63\xb3\xb6\x94\xe7\xec\x2d\x1b\x28\xe7\x0e\x6b\x6f\x75\x5a\xcc\xad\xf9\xf1\xd9\xb1\x5d\x8c\xa7\x25\x6a\xf6\x0e\x25\x95\x5c\xf6\x08\x9f\xfe\x7e\x7f\x52\xc7\xc1\xe7\x50\xcb\x4d\x23\xcb\x1a\x10\xc2\x60\x7d\x05\xe3\xa6\x15\xf9\xdb\xd7\x3b\xf5\xee\xcc\x6b\x23\x59\x32\x96\xe3\xb3\x0b\xf1\x5c\xc3\x9d\xb1\x5d\x0b\x97\x95\x03\x41\x48\x41\xcd\xe3\x8e\x94\x4b\x1f\xd4\x50\xec\xef\x06\x95\x7b\xa0\x68\x58\xfa\x07\x6e\x61\xc1\x83\x30\x40\xf8\x36\xeb\x4d\x21\xdb\xdb\x42\xe1\x8d\x43\xec\x36\x70\x8f\xa7\x9c\x66\xe2\xdb\x9d\x13\xd5\xb8\x91\xff\xb9\x55\x80\x55\x14\x2c\x95\x00\x10\x6c\x49\x72\x00\x84\x4f\xf7\x40\xdd\xdc\x73\x02\xe7\x65\x4c\x31\x80\xd3\x80\x59\x46\x42\xa7\x25\x4e\x4c\x7d\xb9\x47\x3f\xbd\x8c\x56\xda\x14\xa8\xc4\xbf\x87\x60\x84\x62\x62\x0c\x42\x15\x0d\xc6\x38\xf7\xe4\x39\xf2\xe7\x5d\xdf\x40\x21\x0d\x2d\x9d\x2d\xed\x46\xd2\x1f\xc3\xb3\x3a\x2a\xe6\x31\x11\x37\xaf\xf1\x39\x8d\x39\x17\x16\x17\x92\xe7\xbb\xa6\x5c\x49\xcc\x78\xa9\x65\xce\xcd\x9c\x4d\xa0\xe0\xc4\x91\xfa\x4b\x0e\xeb\x92\x2b\xb0\xf8\x44\x5f\x27\x87\x52\x7f\x3e\x47\xa1\x07\x58\xb7\x95\xbe\xed\xa2\x29\x84\x9a\x18\x51\x94\xee\x97\xe9\xeb\x6a\xf3\x6b\xb3\x22\xf9\xb2\x59\x18\x68\x0d\xb3\xf2\x4d\x7a\xaf\xe0\xc3\x6d\xed\xca\x29\x50\x7b\xa6\xe0\x34\x3d\x95\x5b\xaf\x0f\xc3\xe9\x11\xe7\x8d\x98\x45\x7e\x49\x0d\x50\xe5\x3b\x6d\xc7\x6c\xd8\x1b\x9a\x44\xde\xa4\x1e\xe6\x41\xef\xa5\xe6\xa7\x94\x68\x70\x1d\x16\x2f\xbf\x7c\xe9\xea\xea\xdb\xb7\x4b\x40\xdb\x02\x07\xd8\x03\xbf\xfd\x7e\xb3\x10\x0f\x3a\xa5\x85\xc3\x61\x61\x68\x36\xe8\xcc\x0c\xf4\x1a\x11\x15\xdb\xb6\x4f\xa3\xd7\x9d\x04\x0d\xce\x4c\x6b\x34\xbd\x61\x89\xd4\xc1\x1d\x27\xd2\x34\x91\x5d\x85\x5b\x0c\xcf\xfd\x2b\xb6\x1b\xe4\x0e\xe6\xb8\x16\xfd\xb7\xe0\x78\xd1\xbb\xe9\xa3\xd9\x67\x7c\xa4\xeb\x90\x1b\x98\xdc\x86\xa7\x48\xf8\xfa\x95\x6a\x5b\x90\x22\x50\x06\x96\x1d\x3a\x77\xc1\xf5\x19\x3a\x43\x09\xa9\x8c\x20\x95\x6d\x77\x3d\xdd\xd6\x8b\x95\x70\xbd\x71\x92\x1e\x4c\xc7\xaf\x42\xa5\x5d\x43\x24\x44\x0c\x47\x35\x3b\x42\xbe\xcb\x35\x20\xfe\x08\xae\x75\x95\x7b\xae\xfb\x7b\xbc\x8e\x2f\xff\x59\x7c\xfb\xb9\x7e\xf9\x72\x34\xd7\x1f\x09\xfe\x87\x64\xba\xbd\xfe\xef\x20\x7b\xf5\xa2\x47\xb7\x3b\xfd\x7f\x78\x37\xce\xb6\x5b\x66\x4d\x39\x85\x7f\x95\x12\xff\xac\xa4\x17\x9d\xed\xf4\xe2\xbf\x00\x00\x00\xff\xff\xc3\x2f\x3e\xaa\x57\x0d\x00\x00")
+var _organizationViewsApikeydialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x5a\x6d\x6f\xdb\x36\x10\xfe\xde\x5f\xc1\x09\x45\xed\x00\x7e\x69\xbc\x16\x03\x52\xcb\x40\xea\x66\x43\x9a\x97\x06\x49\x8a\x7e\x28\x8a\x80\x96\x68\x89\x0d\x4d\x6a\x24\x15\xc7\x6b\xfb\xdf\x77\x24\x25\x5b\x56\x24\xd9\x49\x9b\x05\x6b\xd0\x58\x26\x8f\xc7\xbb\xe7\x5e\x29\x66\x38\x0b\xbb\x21\xc5\x4c\x44\x88\x47\xdd\x80\x09\x7c\x3d\x7a\x86\xd0\x70\x2a\xe4\x0c\x71\x3c\x23\xbe\x87\x13\x7a\x4d\x16\x66\xc0\x33\x53\x30\x09\x6b\xb4\x10\x6c\x82\xa5\x1b\xb0\x83\x21\xbd\x41\x01\xc3\x4a\xf9\xde\x6a\xde\x7e\x2a\x6f\x45\x06\x84\xf1\x60\x34\x54\x09\xe6\x66\x43\x3a\xf5\xbd\xdf\xdc\x06\x3d\x45\x02\x49\xb4\x37\x3a\x25\x73\x34\xec\x1b\x8a\xd1\xfe\xd9\x21\x3a\x22\x8b\x61\x1f\xd6\x14\x59\xd8\xe5\x53\x46\x6e\x47\x19\x61\x71\x12\x76\x9f\xa4\x5a\x0b\x5e\x10\x87\x06\x82\x67\xa3\x9e\x53\x94\x06\xd7\xbe\x17\x60\x1e\x10\xd6\xde\x59\x13\xd0\xb1\x30\x2b\x10\x7c\xaa\x9b\xa8\xab\x64\x00\x30\x28\x45\xb4\xea\xd3\x59\xd4\xa7\xc1\x15\x20\xa5\xc8\xd5\xe0\x55\x72\xdb\x03\x0a\x0f\x61\x49\x71\x97\xe1\x09\x61\xbe\x37\x36\x73\xc8\xa1\xea\x81\x84\x19\xb7\x35\x21\xfb\x4b\x29\x0b\x10\xf6\x01\xc3\x0c\xe2\x7e\x19\xe3\xe1\xd2\x50\x5d\xe0\xa5\x09\xd7\xb5\xd8\xaf\x93\x79\x88\xe1\x85\x48\x35\x68\x2b\x58\x3a\xe3\x65\x5d\xcd\x5a\x47\xb1\x3e\xb1\x04\x82\x27\xa9\xb6\xcc\x30\xe5\x44\xde\x25\xb2\x84\x56\xf5\xd1\xb1\xf9\x3d\xec\xbb\x2f\xd5\x84\x96\x9d\x31\xc1\x4c\x84\x06\x2c\x4b\xeb\xa1\x19\xe5\x8c\xf0\x48\xc7\xbe\x37\xf0\x90\x24\x7f\xa7\x54\x92\x10\xe9\x45\x02\x1e\xa8\xc9\x2d\xa8\x01\xb2\xe0\x54\x8b\xa9\x08\x52\xd0\x53\xcb\x94\x78\x99\x87\x5a\x1e\x96\xb1\x57\xb3\xab\x51\xd2\xec\x49\x94\xc2\x11\x51\x45\xa7\xee\xad\x56\xf7\x9e\x13\x29\x85\xac\x61\x52\xc1\x08\xe0\xce\xe5\xf6\x46\xfb\x1a\x31\x82\x95\x46\x03\x14\xc4\x58\xe2\x40\x13\xa9\x96\xaa\x14\x8c\x7b\x87\x69\xc3\xd4\x1d\xc1\x6f\x30\xa3\x21\xd6\x54\x70\x2b\xab\x5a\xe2\xd2\x8d\x69\x08\x02\x4d\x31\x53\xe4\x3e\x1a\x84\x69\x02\xc1\x80\x35\x2c\xba\x8c\xa9\x42\x16\x0e\x04\x0f\x98\x49\x82\xc3\x05\x4a\xd5\x43\xa4\x77\x6e\x5f\xf6\x9d\xbb\x64\xcb\x50\xf6\xbd\xd7\x5e\x45\x38\x17\xa4\x36\x54\xcd\xce\xbc\x24\xbf\xbb\xb7\x4b\x17\xf5\xb0\x38\x9f\x1d\x63\x06\x21\x17\x5c\xa3\x8f\xe7\xc7\x8d\x7e\x6c\xd7\x94\x7d\x39\x4b\x64\x41\xc6\x04\x78\x78\xeb\x1e\x8c\x6f\x97\x4e\xfe\xfa\x65\xee\xbd\x39\x79\x2a\x99\xd7\xaf\xc3\x78\xeb\x40\x5c\x85\xb3\xef\x49\x31\xcf\xa3\xbf\x0b\x6e\x13\x71\xdf\x53\x1a\x4b\x8d\x02\xc8\x0b\x44\xda\x4c\x28\x49\x42\xb0\xa1\x25\x21\xb8\x69\xa0\x3f\x9e\x1f\x22\xca\x51\xa6\x4a\x61\x54\x35\x39\xd5\xbd\xf1\x2e\x60\x7e\x9e\xed\x01\x98\x1f\x6e\xc4\xbc\x12\xf7\x82\x94\xbd\x54\xd2\x66\xd0\xf3\x80\xac\x81\xfa\x7e\x70\xd7\x28\xbf\x41\x7e\x53\x56\xe6\x54\x07\x71\x8d\x0e\x98\x2f\xce\x84\x84\x64\xb6\xcf\x17\x28\x81\x27\x2b\x90\x5b\xb2\x99\xb5\x29\x1b\x9a\x26\xcd\x84\xe6\xdf\x29\xa4\x91\x1b\x02\xa6\x76\x09\x00\x52\x8a\x42\x50\x11\x11\xa3\x0a\x0a\x07\x82\xfa\x87\xb3\xfd\x91\x98\x22\x8c\x98\x10\x89\x0d\x0e\x1c\x86\x12\xd2\x07\x6a\xc7\x5a\x27\x7b\xfd\xfe\xee\xe0\x8f\xde\x4b\xf8\xd9\xed\xa0\x6c\xe4\xf3\xde\xde\xee\x17\x24\x64\xfe\x9d\x09\xf0\xf2\x58\x28\xbd\xd3\x2c\x7e\x7f\x1b\xf9\xef\x6f\x9e\x6d\xfb\x81\x0a\xaf\xef\x29\x83\x0e\x69\x3f\xa7\x3c\x24\xb7\x1d\xb4\x5b\x6e\x16\xaa\xf6\xfb\x89\xe6\xe1\x9c\xcc\x04\x18\x45\x16\x82\xa2\xa6\x87\xa8\x00\xa5\xdc\x51\xac\x13\xd4\xa7\xf0\x15\x44\x8d\x58\x20\xbf\x2a\x2f\xa0\xef\xdf\xd1\xe7\x2f\x6f\xaa\xa6\x7a\x49\xaa\xe2\xf6\x37\x88\xc9\x3d\xd4\x6a\xfd\x68\x82\x6e\x3f\x0c\xd7\x94\xae\xcf\x83\x8d\x4a\x3e\x34\xf1\xbf\x05\xbf\xee\x42\xd9\xe6\x1c\x8a\x1f\x74\x4f\x90\x32\xb7\xca\x47\x75\x35\xc0\xc4\xc9\xd8\xb1\x3b\xb6\xdc\x8c\x1d\x9b\xf2\x52\x53\x3a\xda\x36\xa8\xf7\x91\x82\x24\x0f\x6d\x53\xa6\x80\x16\xd7\x10\xc7\x50\xca\x13\x88\x3c\xd3\x4d\x09\xa4\x4d\x89\x37\x39\x7e\x1e\xc3\x14\x36\xc5\x5d\xc2\x20\xd6\x66\x4d\x04\x34\x90\xfb\x81\x0c\x4a\x86\x2c\x26\x06\x33\xab\x90\xe1\x09\x99\x80\x6a\x05\xf3\x3d\xc1\xa1\x6b\x22\xcd\x0e\xd9\x28\xf6\x3d\xaa\xda\x43\xcd\xfa\xa7\x04\xfa\x5f\x69\xd7\xa9\x61\xf8\x9f\x1b\xf6\x32\xb7\x9a\xe9\xcf\x04\x0e\x9d\x9d\x30\x82\x96\x2f\x34\x16\x9e\x4a\xe8\x24\xfe\x67\x26\x7d\x68\xa3\x62\x7c\xd9\x21\x7f\x5e\xd9\xb2\x54\xce\x2b\xa4\xa5\xa9\x5c\x93\x05\x72\x89\xfc\xb1\x9a\x99\x33\xd8\x3d\xf7\x33\xf9\xd3\x8d\x4d\xa3\x4a\x9f\x9d\x26\x5f\x9e\xa0\xdd\xb9\x67\x3d\xad\x96\xff\x89\x2a\x6b\x52\x63\xa1\x27\xad\xb2\x35\x4e\xeb\x6f\x70\xea\xf5\xca\x5b\x03\xb3\xad\xc1\xad\xd6\xa6\xe2\x5b\x87\xcb\x2f\x2b\xc4\xcd\x2e\x75\xa7\x21\xce\x0f\x53\x8c\x42\x0e\x18\x83\x44\xf0\x41\xe1\x70\xfb\x97\xc4\x5c\x5f\x82\xc7\x7b\xa3\x13\xbc\x40\x13\x62\x0f\xa8\x26\x01\x38\x52\x14\xac\x68\x51\x64\x88\x6d\x7c\xa8\xad\x7a\xe8\xed\x4b\x2d\x5f\xcb\xa5\xc0\x36\x36\xc0\x61\xf4\xf1\xd0\xf6\xd0\x20\x93\xab\xb5\xa0\x83\xc9\xbe\x38\x08\x4c\xc7\xac\x63\x02\x92\x9a\xd7\x0e\x6e\x1d\xa4\x5e\x4b\x25\x64\x84\x39\xfd\xa7\xcc\xcc\xa6\x72\xab\x03\xe5\x51\xc6\xe3\xa9\x0a\xee\xc3\xcc\x97\xa4\x13\x00\xc9\xe5\x04\xa8\x9a\x11\x29\xcd\xa0\x17\x2f\x50\x3b\x1b\xb1\xed\xca\x01\x0f\x13\x41\xb9\xde\x4f\x75\x7c\x42\x00\x88\x10\xa2\xc0\x7a\xef\x99\x5b\xe0\xac\xfc\x6b\xad\x79\x22\x26\x94\xc1\x49\x88\x87\xd0\x41\xf1\x08\x1e\x13\x1c\x55\x9c\x8c\xb8\xd0\x60\x50\x92\x58\x3b\xba\xf7\x95\x08\x00\x9a\xd2\xcc\xe1\x3a\x66\xc2\xbe\x31\x41\x67\x47\xe3\x03\x30\x35\xf4\x5d\x38\x74\x66\x5e\xae\x30\xf5\x18\xbe\x61\xd0\x50\xc8\xdc\xe8\x01\xc0\x06\x65\x46\xcc\x9f\xac\xa1\x2a\xbd\x8e\xcd\x2c\xb7\xf9\x95\x89\x8b\x3a\xa3\x8d\x01\xc1\xc1\xb5\xb9\xbf\x32\xe6\x23\xcc\xe4\x98\x3b\x3e\x53\xe3\x07\x5b\x54\x07\x91\x58\x2c\x6f\x30\x4b\xc1\xd1\xbc\xd1\x85\x05\xdc\xc2\xe2\xa6\xee\xcb\x22\x91\xf4\x06\x6b\x72\x05\x62\x5d\x7d\x9d\xc3\x89\xfc\xc2\x35\xd8\xef\x3f\x5d\xa2\x76\x69\x72\xe7\x27\xf6\xd1\x4c\x5d\x39\xc7\xbe\x32\x40\x7a\xa3\xcb\xe3\x8b\x65\x3e\x23\x52\xd3\xa9\x7d\x45\x87\xda\x25\xc2\xed\xf6\x74\xc1\x62\xd1\x7e\x44\xc7\xd9\x14\xc5\x3e\xc4\x71\x09\xb2\xd6\x66\xf7\xca\xa2\xde\xa4\xd1\xf6\xd9\xc1\x89\x79\xa7\xf0\xfe\xd3\xd1\xce\x66\x07\x33\xed\x10\x96\x04\xd7\xe5\xa4\x23\xb2\x80\xee\x48\xcc\xa1\x81\x79\xb5\x6a\x93\xa0\x09\xc8\x17\x3e\x31\x54\x25\x4b\x6f\x01\xd5\xb8\xe0\x28\x2a\x9d\x7c\x05\x73\x3f\xf8\x9c\x03\xbb\xbb\xc0\x36\x62\x5d\x38\x66\xef\x4e\xd7\x5b\xcd\x84\xe1\x80\xc4\x82\x85\x44\xfa\xde\xf8\xd4\x77\xd2\x76\x3e\xf8\x07\xb7\x78\x96\x30\xd2\x19\xfb\x6f\x0f\x36\x76\xa0\xdb\x01\x5a\xd1\x57\x55\x0d\x6d\xdd\xba\x67\x90\xe5\x09\xa2\x06\xa5\x3a\x74\xb2\xab\xaa\x6d\x6e\x2d\x42\xaa\xf0\x84\x91\x30\xbf\xb1\x58\x03\xad\x8b\x22\x02\x32\x62\x73\x38\xb7\xa7\x37\x85\x6f\xe0\xb1\x5b\x3e\x34\x6e\xc6\xc8\x1e\xa7\x94\x5e\x30\x73\x27\x81\x65\x44\x79\x57\xd2\x28\xd6\x7b\x83\x97\xc9\xed\x9b\x6c\x84\x91\x69\x36\x50\xe1\x4c\xc3\xf8\xf7\xd1\x21\x47\x1f\x8c\xc1\x07\x08\x4e\x5d\xa0\x9c\x80\x7e\x70\x61\x8a\x95\x84\x72\x05\xff\x9d\x85\x69\xd8\x52\xb6\x56\x66\x09\xca\xa1\xa1\x7a\xc3\x3e\xb0\x30\x6c\x2e\xc5\xaa\xff\x31\x57\x78\x8e\xa0\x63\x07\x5b\xdf\xbe\x15\x9b\x9d\x1f\x3f\x5a\x08\xab\x25\x63\xcb\xb6\xb4\x6e\x35\x9f\x0d\xb8\x8d\x9a\x7c\xa1\x7c\x93\x56\x75\x71\x56\xb8\x50\xc3\x81\x2b\xf1\xc5\xe3\x68\x81\x59\xd5\x81\x67\x8e\xe5\xda\x49\x07\xbc\x83\x68\x02\x42\x43\x5a\x69\x5b\x2b\xda\xbb\xb0\x1d\xaf\x14\xf2\xf9\x25\xe7\x92\xfb\x3b\xbb\xb0\x20\x79\xd5\xc5\x60\xfd\x7d\x67\xe5\xa1\x62\x79\xad\x59\x7d\xc5\x6a\x8e\x0d\xd0\x78\x80\x47\x60\x66\xa5\x2c\x88\x33\xb6\x4b\x37\x88\x53\x05\x08\x64\x76\xf0\xb2\x45\x9e\x21\x20\xff\xcc\xa8\x5e\xbb\x6b\x85\x94\xaa\x49\xdb\x46\x5a\x27\x3b\xb2\x80\x84\xb5\x3e\x5b\x23\x7c\x51\x56\xcb\xf1\x31\x64\x4d\x93\xd0\xc8\xba\xb2\x63\xc7\x5d\x8b\x6d\x25\xef\x3a\xd6\xd0\xdf\xd6\x61\x7d\x01\xec\x1f\x05\xe9\xa5\xf9\x1f\x20\x6c\xfb\xb7\x35\x71\x8b\xa7\xc6\x0f\x47\xf5\xd2\x16\xc3\x2c\x0b\x27\xfb\x77\x04\x7d\x73\xd6\x19\x3d\x2b\x4c\x8f\x9e\xfd\x0b\xac\x59\x5e\x04\x74\x20\x00\x00")
 
 func organizationViewsApikeydialogHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -406,12 +498,12 @@ func organizationViewsApikeydialogHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "organization/views/apikeydialog.html", size: 3415, mode: os.FileMode(436), modTime: time.Unix(1461766537, 0)}
+	info := bindataFileInfo{name: "organization/views/apikeydialog.html", size: 8308, mode: os.FileMode(436), modTime: time.Unix(1792323594, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _organizationViewsDetailHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5a\x41\x6f\xdb\x36\x14\xbe\xef\x57\xb0\x5c\x91\x34\x40\x65\x37\x6b\x0f\x83\x6b\x1b\x28\x9a\x1d\x82\x6d\x5d\xb0\x1e\x87\x1d\x28\x89\xb6\xb8\x50\xa4\x46\x52\x49\xbd\xc0\xff\x7d\x8f\x94\x64\x53\x8e\xe5\x88\x72\x5a\x04\xcb\x7c\xb1\x2d\x92\xdf\x7b\x24\x3f\x7e\xef\xf1\x41\xd3\x94\xdd\xa0\x84\x13\xad\x67\x78\x51\x72\xae\x13\x45\xa9\x58\x48\x95\xe3\xf9\x77\x08\x3e\xae\xc3\x82\xd3\x2f\x88\x93\x95\x2c\xcd\x0c\x2b\x79\x8b\xeb\x3f\xd1\x82\x71\x8e\xb4\x59\x71\x3a\xc3\x05\x49\x53\x26\x96\x91\x91\xc5\x04\x9d\xbf\x79\x53\x7c\x79\x5f\x63\xb4\x70\xe6\xd3\x31\xfc\xdc\x69\x68\xb0\x13\xc9\xcb\x5c\xb4\xe1\xed\xa0\x19\x06\x40\xec\x7e\x46\x4b\x13\xe5\xe9\x0c\xff\xf8\xc6\x43\xef\x02\x6a\xf7\x68\xf9\xb1\x07\x61\xd3\xa7\x40\x30\x0f\xb6\x98\xe1\x9b\x7c\x94\x64\x8c\xa7\xbf\xa9\x25\x11\xec\x1f\x62\x98\x14\x9f\x48\x4e\xf5\x88\x53\xb1\x34\x19\x9a\xcd\x66\x08\x60\xfc\xf6\xe9\xb8\x38\x1a\xf6\x45\x05\xbb\x1f\x46\x17\x44\x58\x24\x45\x0b\x4a\x60\xae\x52\x2d\x11\x13\xa8\x13\xb4\x03\xc7\x61\x91\x66\xf3\x63\x45\x49\x9a\xa8\x32\x8f\x23\xce\xc4\x35\xb6\x06\x32\x45\xc1\xd9\xbb\x3b\x34\x99\x80\x8d\x51\xa9\x38\x5a\xaf\xf1\x7c\xf3\x40\x00\x3a\x3c\x99\x8e\xc9\x01\x0b\x8d\xb7\x76\xde\x2f\x5e\x82\x31\x83\xe7\x27\x8a\xfc\x5d\xca\xf7\x68\x3a\xb6\xad\x1d\xd3\x3c\xd8\xd6\xb5\xc4\xd9\xb9\xb5\x15\x33\x01\x0c\x99\x4c\x60\x49\x96\xd4\xf8\x0b\x72\xc1\x74\x01\x1c\xb1\x9e\xbf\x82\x56\xe9\x35\x8d\x96\x5c\xc6\x84\xb3\xf4\x0c\x03\x47\xb3\xf3\x3d\xdc\x69\x33\x77\xf3\xb8\x8b\xda\x1d\x63\xee\x1f\xa9\x6e\xb6\xe6\x69\x94\x10\x95\x7a\x87\xa0\x63\xe2\x75\xc7\x28\x91\xc2\x50\x61\xfc\x53\xf3\xa0\x15\x1f\xc4\x90\x58\x3f\x68\x6d\x67\x00\x82\x2f\x29\x22\x4d\x39\x4d\x8c\x63\xf7\x82\x9a\x24\xbb\x14\x37\xcc\xb8\xa5\xd5\xaf\xce\x1e\x40\xf2\xd0\x22\x4e\x62\xca\xe7\x53\xb6\xd1\x25\x82\x16\x24\x2a\x35\x55\x76\x63\xd8\xfc\x44\xc4\xba\x78\x7f\x45\x65\xc1\xe9\x74\xdc\x1a\xd4\xdb\x46\x2c\xd3\x55\x4b\xd1\x1e\x1e\xda\x0c\xe7\x4c\x9b\xc6\x35\xf8\x9b\x52\xa1\x69\xcf\xe1\x0d\x84\x2e\xe3\x0c\x8e\x1b\x55\x1e\x8e\x90\x91\x36\x2c\xb9\x5e\x81\x9a\xdc\x0a\xaa\xb4\x9b\xda\xa6\x67\x98\x01\xeb\x63\xc4\x0c\xcd\x3d\x03\xe7\xf6\x60\x53\xdc\x52\x0e\x6b\xa8\xd6\x8e\xd6\x51\x70\x0d\x87\x84\x63\xaf\x61\x2f\x96\xf8\x3e\x44\x86\x7e\x31\x81\x58\x0e\x2f\x7b\x37\x47\x20\x36\x95\x97\x56\x67\xe0\x41\x98\x47\xfb\x0f\x6c\x77\x77\xdf\xed\x9e\x8c\x68\xc6\x1c\x49\x20\x2f\x34\xb4\x76\x22\xa7\x79\x0c\x5b\xd1\x04\x86\x79\x67\x58\xe8\xb2\xf6\x00\xd7\x7e\xad\xf0\xbf\x01\xd9\xaa\x99\xec\x63\x5b\x3d\xc7\x27\x40\x37\x60\x5b\xed\xe6\xf3\xa1\x5b\x46\xf4\x4f\x29\x33\x57\x54\xe5\x4c\x6b\xd8\x0f\x74\x72\x62\xb7\x88\x6d\xe5\xfb\x11\xd8\x37\xbf\xa2\xc2\xa6\x87\xc8\x83\xfd\x06\xa4\x73\xd6\x68\x4d\x3a\xcf\x74\x28\xd7\xb2\x77\x75\x50\x7c\x0b\x2b\x60\x45\xa9\x02\x1e\xd9\xa8\xd4\x70\xa5\xe9\xe4\x79\x54\x4f\xce\xe6\x4c\x41\xf6\xee\x2a\x16\x4e\x70\x75\x3c\xf1\x6b\xa7\x81\x13\xec\x22\x03\x5e\xff\x51\x5b\x57\x92\xd3\x3f\x83\xa9\x7a\x24\xef\x7a\x13\x2f\x2e\x8d\x01\x36\x6d\x57\xa3\x50\x2c\x27\x6a\xe5\x36\x28\xe1\xa0\x3f\x8e\x7f\x3a\x93\xb7\xdb\x54\xe1\x82\x11\x2e\x97\xaf\x5e\xd2\x1b\xc8\x62\xce\x70\xe8\x29\x3e\x40\xeb\x10\xe6\xee\xc9\x3d\xa2\x82\x97\xba\x4a\x40\xd0\x65\xc5\x2a\x2d\x73\x2a\x05\x0d\xe2\xad\x91\x92\x1b\x56\x54\x4b\x20\xc9\xf5\xbc\x8d\x85\x8c\x44\x7f\x49\x26\xc2\xd8\x72\x37\x38\xd5\x5d\xaf\xc3\x68\x53\xbb\x1f\x40\x9a\x8a\x05\x3d\x68\xb3\x49\xe5\x6c\x6e\xf6\x40\xde\x59\x77\xed\x95\x9d\x1e\x9b\x78\xc2\x25\x94\xba\x98\x12\x49\x3f\xff\xfc\x08\x89\xb6\x22\x89\xd1\xc7\xa4\xa0\x3d\x7a\x3f\xd1\x55\xd1\x56\x3b\x48\xe1\xaf\xc8\x67\xa3\xca\xc4\x94\xea\xa8\xa4\xbc\x27\xb3\xfc\xbb\xbe\x5f\x90\x00\x52\x2f\xc5\x0c\x43\xac\x41\xda\x10\x15\x92\x07\x1c\x94\xac\xe0\x6c\x02\xa1\xed\x0d\xfa\xfb\xb1\x7f\xf8\xf4\x18\xc2\x47\xd7\x81\x04\x31\x1f\x0b\x7a\x0b\x71\xc3\x6f\x0e\x8d\x56\x3b\x7b\xe5\x29\xd7\x47\xb8\xe6\x5b\xb5\x69\xe3\x87\x69\x40\x73\xa2\xfb\x0d\xe8\x9d\x12\xf9\xf9\x9c\x51\x34\xe8\x4e\x55\x72\x3f\xe8\xdb\xd1\x7b\xf2\xcc\xdf\xa5\x34\x55\x89\x44\xd1\xe0\x25\xe5\xac\x92\x6c\xe7\xde\xdd\x29\x1c\x7c\x76\x43\x23\x1f\xfe\x74\xd2\xbd\xab\xb6\x4c\x64\xbd\xda\x3c\x59\x0f\x49\x50\xc9\x41\x4a\x4d\x26\x2d\x0b\xb6\x54\x33\x90\xb5\xfd\xca\x27\x2d\x6b\xae\x66\x72\xa0\x0c\xd4\x39\x27\x1b\xb0\x45\xc2\xcb\xb4\x7f\x18\xdd\xfd\x68\x95\xcc\xf0\xe9\x38\x91\x79\x01\x11\x54\x18\xdd\x5a\x9b\xf1\x0d\x83\xf3\x34\xb6\xde\x5e\x82\x64\x8d\x32\x93\xf3\x53\xeb\xed\xd6\x72\x68\x96\xcf\x59\x48\xb2\x55\xf6\x50\xc0\xaa\x67\xaf\xa3\xf2\xb5\x02\x82\x5f\x31\x3a\x94\x45\x75\x14\x7b\x3e\x5c\x5d\xfe\x4c\x57\xbf\x58\xc1\x7f\x8c\x6a\x4f\x22\x97\xad\xd0\x42\x8d\x81\xcb\xc3\x51\xb1\xb6\x55\xd5\x7a\x42\xd5\x9e\xf0\x4b\x6f\x15\xeb\x06\x1c\xb6\xa2\x5e\x03\x26\x98\x81\x3c\x1b\xcf\x2f\x64\x4e\x40\x28\xed\x79\xd6\x9d\x45\xd5\x83\x90\xae\xbc\x5b\x17\x3f\xbb\x0b\xb6\x9d\xc3\x07\xdc\x98\xbf\xee\x85\x31\x15\x7a\x5f\x89\x02\x1e\x0f\x52\x53\x84\xee\x5f\x76\x2e\x3e\x7d\x6e\xdd\x72\x5e\x23\x40\x3f\xc3\x88\x28\x46\x2a\x62\xd7\x45\x77\xeb\xcb\x3a\x34\x52\x1c\x2e\x8b\xf4\x2f\x06\x77\xe2\x67\x6f\xb7\x31\xc2\x2e\x8b\x2d\x96\xbf\x7d\xca\x85\x92\xaf\x71\x61\xdd\xdd\xc3\x3e\x8a\xb7\x31\xd5\x9d\x9e\x7d\x48\x53\x94\x6e\xcf\xe4\x90\x9b\xe5\xdc\x62\x10\x1f\xc5\x15\x50\x53\x14\xaf\x9e\xe1\xa5\xd2\x8d\xf8\xcf\xcb\x38\x04\x5f\xf4\x21\x49\xa8\xd6\xe8\x9a\xae\xf4\x20\xa1\x6a\x51\xa8\x8d\x07\xca\x44\x91\xa0\x34\x05\x16\x19\x89\x48\xd5\x62\x32\x8a\x2e\x8d\x86\x39\x8c\xa4\xb0\x3a\x3a\x38\x81\x23\x05\x1b\x3c\xb6\xd4\xb6\xa8\x28\x49\x69\xb2\x1f\x86\xcd\x3b\x98\x81\xed\xd1\xff\x07\xcd\x19\x76\x41\xab\x0e\x9b\xb0\x99\xc0\x19\xf7\xe4\xf1\x42\x66\x95\x5d\xee\x44\x4d\x67\x23\xbc\x44\x58\x7f\xee\x45\xdb\x6a\x12\x4f\x3b\xde\x3a\x1f\x9f\x67\xc4\xad\x28\xe0\xea\x17\xf7\xcb\xc4\x8f\x16\x7c\xad\xf2\x91\x8d\xf2\x0d\x8b\xbf\x83\xc4\xd3\x8a\x60\x25\x66\x41\xfb\x1a\x28\x7c\x47\x86\xdb\x7e\xf0\x8f\x74\x37\x6d\x7a\xe8\xae\x77\x40\x76\x5e\x79\xd8\xf7\xbe\x46\xdd\xe5\xe0\x2b\x19\xfb\xde\x47\xda\x7d\x9b\xa3\xfe\x59\x7f\xfd\x1b\x00\x00\xff\xff\xd2\x54\xec\xc0\x35\x25\x00\x00")
+var _organizationViewsDetailHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe5\x5a\x5d\x6f\xdb\x36\x14\x7d\xef\xaf\x60\xb9\x22\x69\x80\xca\x4e\xd6\x3e\x14\xae\x6d\x20\x68\xf6\x10\xac\xeb\x82\xf5\x71\xd8\x03\x25\xd1\x16\x17\x8a\xd4\x48\xca\x89\x17\xe4\xbf\xef\x92\x92\x6d\xca\x96\x64\xc9\x4e\xb1\xa0\xf1\x4b\x12\x7e\x1c\x5e\x92\x87\xe7\x7e\x38\xe3\x98\x2d\x50\xc4\x89\xd6\x13\x3c\xcb\x39\xd7\x91\xa2\x54\xcc\xa4\x4a\x31\x9a\x71\x7a\x8f\x38\x59\xca\xdc\x4c\xb0\x92\x77\x18\x69\xb3\xe4\x74\x82\x33\x12\xc7\x4c\xcc\x03\x23\xb3\x11\xfa\x78\x9e\xdd\x7f\xc2\xd3\x57\x08\x3e\x63\x8b\x66\xa7\x4d\xc7\x43\xf8\xd5\x6b\x5c\xc1\x44\x92\xe7\xa9\xc0\xe5\xdf\xc1\x8c\x71\xee\x26\x4c\xf0\xc5\xf9\x79\xb1\x64\x30\x37\x81\x4e\x27\xf8\xe3\x79\x89\xda\x04\xb2\xe9\xad\xac\xbd\x35\x73\xdd\x9f\x21\x30\x99\xcd\x26\x78\x91\x0e\xa2\x84\xf1\xf8\x77\x35\x27\x82\xfd\x4b\x0c\x93\xe2\x2b\x49\xa9\x1e\x70\x2a\xe6\x26\x41\x93\xc9\x04\x01\x84\xdf\x3f\x1e\x66\x47\x41\xbe\x2e\x20\x77\x20\x1c\x8c\xce\x88\xb0\x48\x8a\x66\x94\xc0\xfe\xa4\x9a\x23\x26\x50\x23\x68\x03\x8e\xc3\x22\xab\xdb\x0c\x15\x25\x71\xa4\xf2\x34\x0c\x38\x13\xb7\xd8\x2e\x90\x28\x0a\xc6\x3e\x3c\xa0\xd1\x08\xd6\x18\xe4\x8a\xa3\xc7\x47\x3c\x5d\x37\x08\x40\x87\x96\xf1\x90\xb4\xac\xb0\xb2\xd6\xee\xfb\xf5\x1b\x58\xcc\xe0\xe9\x89\x22\xff\xe4\xf2\x13\x1a\x0f\x6d\x6f\xc3\x36\x1b\xfa\xea\x8f\x36\xb9\xb0\x6b\x84\x4c\xc4\x13\x3c\x1a\xc1\x51\xcc\xa9\xf1\x0f\xe2\x8a\xe9\x0c\xf8\x60\x2d\x7e\x0b\xbd\xd2\xeb\x1a\xcc\xb9\x0c\x09\x67\xf1\x19\x06\x1e\x26\x17\x5b\x3c\xd9\x30\x73\xdd\x54\x47\xdb\x9a\xb1\xeb\x71\x7b\x98\x98\xc6\x41\x44\x54\xec\x11\xbb\x66\x83\xe5\xa0\x20\x92\xc2\x50\x61\xfc\x57\xd0\x8a\xee\x03\x18\x12\x6a\x7f\x22\x34\xc5\xf6\x48\x58\x14\x24\x94\xcd\x13\xd3\x72\x8d\xc5\x74\x3b\x45\x8a\x40\x53\x4e\x23\xe3\x78\x3c\xa3\x26\x4a\xae\xc5\x82\x19\x77\x98\xfa\xed\x59\x0b\xdd\x3c\xa4\x80\x93\x90\xf2\xe9\x98\xad\xe5\x84\xa0\x19\x09\x72\x4d\x95\xbd\x06\x36\x3d\x11\xa1\xce\x3e\xdd\x50\x99\x71\x3a\x1e\x56\x26\x75\xc2\x0f\x65\xbc\xac\xc8\x51\xfb\xb4\xd5\x54\xce\xb4\x59\x99\x64\xcf\x87\x0a\x4d\x3b\x4c\x5d\x4d\xd7\x79\x98\xc0\x43\xa2\xca\xc3\x10\x32\xd0\x86\x45\xb7\x4b\xd0\x88\x3b\x41\x95\x76\xdb\x59\x8f\xec\x0e\x6e\x6d\x0b\x98\xa1\xa9\x07\x7e\x61\x9f\x2b\xc5\x15\x3d\xb0\x8b\x94\x8a\x50\x21\xba\xeb\x68\x93\x83\x9d\x45\x3d\xb9\xf7\xd7\x0f\x0c\xbd\x37\x3d\x70\x1c\x56\xf2\x61\x8a\x40\x3a\x0a\xeb\xac\x6a\x40\x43\x77\x4b\x76\x9f\x61\xf3\x50\xdf\xd4\x0e\xb7\xbe\x1a\x7f\x04\x41\x3c\x61\xaf\x9c\x78\x4a\xd3\x10\x8e\x7c\x25\xeb\xd3\x46\x51\xaf\x5b\x69\x0f\x97\x7e\x2b\xb0\xbf\x33\x99\x8a\x1d\xd4\xb1\xa9\xdc\xdb\xff\x48\x27\x60\x53\x69\xde\x8f\x4b\xa7\x84\xe8\x5f\x62\x66\x6e\xa8\x4a\x99\xd6\x70\xee\xe8\xe4\xc4\x5e\x05\xdb\x48\xee\x91\xec\x9a\xde\x50\x61\xe3\x33\xe4\x41\x7e\x67\x52\xb9\x95\x68\x49\x2a\x6f\xd9\x3e\x5c\x4a\x3e\x94\xae\xec\x3d\xec\xda\x0a\x4b\x01\x3a\xb0\x1e\x64\xc5\x87\xd5\x20\xcf\x9a\x72\x53\x36\x8a\xe9\xbc\xd6\x43\xc1\xb2\x11\x2e\x9e\x1c\x7e\xe7\x34\x6c\x84\x9d\x9a\xe3\xc7\x3f\xcb\x95\x95\xe4\xf4\xaf\x5e\x54\x3c\x82\x5b\x9d\xc8\x15\xe6\xc6\x00\x63\x36\xbb\xcf\x14\x4b\x89\x5a\xba\xcb\x88\x38\xe8\x88\xe3\x98\x4e\xe4\xdd\xc6\x85\x5f\x31\xc2\xe5\xfc\xed\x1b\xba\x80\x38\xe3\x0c\xf7\x79\x95\x2d\xb4\xed\xca\xcc\x9a\x78\x20\xc8\x78\xae\x8b\xa0\x00\x5d\x17\xcc\xd1\x32\xa5\x52\xd0\xce\xbc\x34\x52\x72\xc3\xb2\x62\xdb\x92\xdc\x4e\xab\x38\xc8\x48\xf4\xb7\x64\xa2\x3b\x23\x1e\x0e\x0e\x32\x1f\x1f\xbb\x53\xa3\x34\xbb\x23\x31\x8a\xdb\xde\x43\x8d\x75\x28\x65\xe3\xa3\x96\x78\xaf\x1c\xb6\x37\x22\x3c\x26\xd8\x83\x74\x8e\x3a\xed\x0f\xa4\x1f\xf3\x7d\x86\x10\x57\x91\xc8\xe8\x43\xc3\xbe\x3d\x23\x9f\xd1\x09\x68\xfb\xee\x49\xe6\xef\xfe\x9b\x51\x79\x64\x72\x75\x70\xd0\xdb\x81\x2d\x7e\x76\xec\x32\xf5\x32\xbf\x06\x82\xce\xc5\x04\x83\x3f\x80\xdc\x9d\xa8\xae\x3e\xb9\x55\x6a\x7a\x79\x75\x84\x36\x79\xe7\x4f\x43\xff\x01\x0d\x05\xbd\x1b\x82\xca\x37\xbd\x2b\x97\x99\x76\xf7\x1e\x5b\xf7\xe0\x29\xcc\x67\x48\x84\xad\x32\xe4\xa1\xbf\x4e\xf7\x37\xbb\x7a\x85\xfb\x07\x77\x0a\x41\xfc\x98\xc9\x28\xda\x39\x17\xc9\xb9\xef\x70\xed\xcc\x9a\x18\xee\x0f\x29\x4d\x51\x34\x50\xb4\xab\x42\x3b\x74\xce\x0a\x29\x75\x66\x3d\x9c\xc2\x63\x65\x0b\x1a\xf8\xd0\xa7\xa3\xe6\xab\xb2\x05\x13\x6b\xd1\xba\xa5\xcf\xd5\xb9\xf5\x49\x23\x4d\x5c\x71\xa2\x02\x6e\x99\x71\x00\x09\xbb\xd5\x12\x2a\x2b\xb9\x02\x42\x4b\x2d\xa4\x76\x2b\xd6\x67\x8a\x88\xe7\x71\x37\x6f\xb6\xfd\xd1\x2a\x9a\xe0\xd3\x61\x24\xd3\x0c\x1c\x99\x30\xba\x7a\x1c\x0b\x46\xef\xf4\xd0\x5a\x79\x0d\x4a\x33\x48\x4c\xca\x4f\xad\x95\x9b\x55\xfb\x04\xcd\x9c\x75\x8d\x6b\xf2\x3d\x82\x55\x8c\xda\xfb\x02\x9e\x5a\xab\xfd\xea\x47\x5b\xb0\xd2\x50\xe7\xb8\xbc\xb9\xfe\x95\x2e\xbf\x58\x3d\x3e\xb6\xd0\x11\xc9\x79\x45\xf5\xa9\x31\x10\x83\x1f\xec\xf2\x2a\xc5\xa3\x67\x50\xe8\xe8\x97\x13\x16\xee\xa7\xe7\xc3\xc9\xca\x3d\x33\xc1\x0c\x84\xac\x78\x7a\x25\x53\x02\x1a\x67\xdf\xa5\xae\xad\x12\xb6\xc2\xb9\x3a\x65\x59\xd5\x6b\xae\x4a\xd6\x4e\xed\x99\x4c\x7e\xbf\xbc\x2a\x16\xba\x2e\x53\x87\xe6\xde\x0a\x88\xd0\x6e\x8e\x70\xf5\xf5\x5b\x25\x39\x78\x87\x00\xf9\x0c\x23\xa2\x18\x29\x08\x5b\x56\x8b\xad\x1d\xfd\xfc\x71\x6b\x65\xa0\x5b\x85\xb3\x11\x3b\x79\xbf\xd1\x73\x7b\x14\xb6\xca\xfb\xfe\xb9\xd5\x0a\x9e\x3a\x9f\xdb\xbe\xab\x7d\x6a\xb5\x5e\xa6\x39\x32\xba\x8c\x63\x14\x6f\xde\x58\xdf\xe4\x6b\x6a\xe7\x13\x1f\xc1\xd5\x02\x63\x14\x2e\x5f\x40\xde\xe5\x46\xff\x90\xb2\x0b\x4e\x11\x5d\x46\x11\xd5\x1a\xdd\xd2\xa5\xee\x2d\x34\x15\x8a\x54\xb1\x40\x59\x28\x12\x94\xc6\xc0\x12\x48\xd1\x49\xd1\x63\x12\x8a\xae\x8d\x06\xdb\x07\x52\x58\x0d\x3c\x28\x70\x22\x19\x3b\x68\x5e\xae\x6d\xad\x4c\x92\xdc\x24\x3f\xf7\xdf\x6b\x2f\x76\x55\x67\xbe\x44\x87\xe6\x9c\x4a\xe9\xd2\xe0\xc2\x80\x13\xae\xe5\x69\xdc\x59\x11\xcd\x6d\x79\x34\x87\xdf\xaf\xea\x55\x7e\x76\xbc\x60\x61\xfc\xf3\xf4\x83\xce\xb6\x97\xe1\x09\x8b\x6b\x76\x69\xfd\x6e\x75\xf3\x49\x9c\xa2\x55\x2d\xb2\x56\xad\xfe\x7e\xf1\x20\xd1\xb3\x02\x56\x88\x51\xe7\xfb\xeb\x21\x5a\x47\xb8\xc1\xfd\xb0\x47\xe6\x73\xab\x5e\x5d\xf7\xcf\x01\x5b\xdf\x93\x6f\x7f\x99\x5f\x76\x37\x7f\x67\xcf\x8c\x0e\xe0\x90\x83\xe2\x90\x83\x99\x94\x06\x74\x0d\xae\xba\xb6\xfd\xd5\x16\xc4\xce\xbf\x06\x94\x3f\xfe\x03\x51\xbc\xee\xbb\x38\x23\x00\x00")
 
 func organizationViewsDetailHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -426,7 +518,7 @@ func organizationViewsDetailHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "organization/views/detail.html", size: 9525, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "organization/views/detail.html", size: 9016, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -471,7 +563,7 @@ func organizationViewsInvitationdialogHtml() (*asset, error) {
 	return a, nil
 }
 
-var _organizationViewsNewHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x55\x61\x4f\xdb\x3c\x10\xfe\xfe\xfe\x8a\xc3\x42\xaf\x40\x2f\x69\x8a\xf8\xf4\x96\x26\xd2\x34\x36\x69\x5f\x60\xd2\xf6\x69\xd3\x36\x39\xf1\x35\xb1\x70\xec\x60\x3b\x40\x41\xfc\xf7\x9d\x9d\xb6\x4a\x4b\x0a\x6a\x25\x94\x70\x8e\x9f\x7b\xee\xf1\x3d\xbe\xb9\x90\xf7\x50\x2a\xee\x5c\xc6\x16\x9d\x52\xae\xb4\x88\x7a\x61\x6c\xc3\xf2\x7f\x80\x7e\xf1\x83\x85\xc2\x47\x50\x7c\x69\x3a\x9f\x31\x6b\x1e\xd8\xea\x9f\x64\x21\x95\x02\xe7\x97\x0a\x33\xd6\x72\x21\xa4\xae\x12\x6f\xda\x19\x9c\x4f\xa7\xed\xe3\xe5\x0a\x63\x0b\x27\x9f\xa7\xf4\x3a\x58\x08\xc9\x36\xe0\xa5\x51\x5d\xa3\x19\x10\x8e\xeb\x8a\x46\x52\xe8\xbe\x99\x10\x29\xee\xf1\xe4\x94\xe2\xbc\xa1\x54\x1a\x1f\x6e\x6c\xc5\xb5\x7c\xe2\x5e\x1a\xfd\x39\xd0\x5d\xd3\x68\xb8\xad\xa4\x9e\xc1\x74\x98\x3d\x26\x6a\x03\xaa\x5c\xf4\x88\xb5\x54\x62\x88\x71\x4d\xc0\x6e\xa2\x50\x57\xbe\x86\xa3\x2c\x83\xe9\xce\xf6\x08\xe1\x5a\xae\x03\x8a\xc5\x96\x18\x65\xcc\xd8\x0a\xa4\x86\xbd\x80\x23\x18\x11\x87\xaf\x35\x2f\xa8\x32\x51\xda\xae\x29\x12\x25\xf5\x2d\x83\xda\xe2\x22\xe2\x6e\x80\x26\x54\x2d\x69\x31\x0c\x9d\x3c\x57\xca\x14\x5c\x49\x31\xa3\xf0\x24\x1c\x5c\x48\xf7\x72\xca\xf2\xe7\x67\x98\xc5\x60\x50\x0a\x5e\x5e\xe6\x29\xdf\xc3\x61\x5d\x4b\x50\xe4\xe8\x98\xe8\x78\x96\xff\x6b\xf9\x5d\x67\x2e\x61\x9e\x86\xd5\x11\x01\x46\xe2\xf3\xb4\xdd\x09\xd4\xe7\x03\xa5\x5b\x6e\x51\xfb\xa1\x32\x2c\xff\x18\xcf\x13\x38\xec\xd4\x45\x5a\xbe\x4a\x19\x0b\x22\x9c\x0a\xb7\x40\xae\xa4\x6b\xa9\x69\x42\x95\x27\xa3\x59\x4e\x63\xf1\xf5\xf9\x5e\x6e\x47\xef\x91\x23\xdd\x61\xc8\x6e\x04\xad\xb0\xe9\x4e\xa4\x11\x89\xd4\x2d\x59\xa3\x34\xda\x73\xa9\xd1\x8e\xa8\xa8\x78\x81\x2a\x1f\xa6\x8d\x8d\x3d\x4f\xfb\x85\xd7\x1b\x22\x64\x20\xde\x18\x81\x2a\xea\x1a\x36\x30\xb0\x78\xd7\x49\x8b\x02\xfc\xb2\xa5\xe6\xf7\xf8\xe8\x19\x10\x07\xde\x79\xb3\x30\x65\x47\x2d\xe6\x6d\x87\x1b\xe3\x84\x4d\xa3\xed\x40\x3f\x82\x2f\x6b\xae\x2b\xec\x1d\xa2\x90\xdb\x4f\xd6\x1a\xeb\xc8\x78\x6f\xec\x69\xb9\xf7\x68\x75\xc6\xd2\xdf\x3f\x79\xf2\xf4\x21\xf9\x31\x4d\xfe\x87\xe4\xcf\xaf\xff\x8e\xd3\x68\xe4\x46\xea\xde\x57\x19\xbb\x18\x33\x55\xb8\x19\xc2\x67\xe8\x1c\xaf\xd0\x8d\xda\x3b\x96\x3b\x39\xc6\xc0\x67\x9f\xa9\xb6\x71\x32\x26\xba\x56\xc9\x92\xce\x92\xe5\xdf\x6b\xe9\xa2\x04\x40\x4f\xae\x82\xe9\x96\xe0\xf9\x2d\xea\x9d\xdb\xe8\x4d\xc0\x55\xa5\x2c\xff\xa2\xef\x83\xf9\xb6\xba\x23\xc2\x4f\xe0\x46\xab\x25\x90\x8c\x96\x97\xf4\x2d\x25\x4b\x9e\xce\x80\x04\x39\x03\xc1\x5d\x8d\xee\x0c\x3a\x2d\x68\xa1\x34\x16\xdd\x3e\x55\x81\x6b\x01\xe4\xb4\x92\xda\xd0\xd2\x9f\x52\xe6\x01\xc5\x38\xc9\x03\xf8\x6f\x0e\x22\x08\x82\xaf\xd9\x83\xab\x4d\xa7\x04\x14\x94\xd2\x03\x9d\xbf\xf3\x70\x31\x2c\x46\x19\x5d\xed\x49\x38\x12\x9e\xa7\xef\x99\xe1\x10\xb7\x7c\xb5\x92\xae\xf6\x25\x5c\x5d\x7f\x3b\xc8\x27\x42\x3b\x36\x74\xc7\xc1\x1c\x83\x8a\xc3\xd9\x37\x92\x96\x30\x8a\xce\x7b\x92\xb1\x4f\xd4\x8f\x2e\xb6\xbe\xe4\x69\xd9\x72\xe9\xc8\xa5\xf4\xd6\xf6\x75\xac\xef\x99\x48\xa0\xdf\xbc\x6f\xde\xac\xa6\xe6\xd8\xcd\xbb\x3d\x49\xd3\x30\x4a\xdf\x19\xb9\xab\xd7\xfe\xf1\x37\x00\x00\xff\xff\x88\x05\x54\xe8\xfe\x07\x00\x00")
+var _organizationViewsNewHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\xdb\x6e\xdb\x30\x0c\x7d\xdf\x57\xb0\x42\x31\xb4\x58\x1d\xa7\xe8\xd3\x52\xdb\xc0\xb0\x6e\xc0\x5e\xda\x01\xdb\xd3\xae\x50\x2c\xc5\x16\x2a\x4b\xae\x2e\x6d\x93\xa2\xff\x3e\x4a\x4e\x02\x27\xb5\x57\xc4\x40\x60\x87\x94\xce\x39\xa4\x48\x31\x63\xe2\x1e\x4a\x49\xad\xcd\xc9\xc2\x4b\x69\x4b\xc3\xb9\x5a\x68\xd3\x90\xe2\x0d\xe0\x93\x85\x05\x0b\xc9\x1f\x41\xd2\xa5\xf6\x2e\x27\x46\x3f\x90\xf5\x9f\x64\x21\xa4\x04\xeb\x96\x92\xe7\xa4\xa5\x8c\x09\x55\x25\x4e\xb7\x33\x38\x9f\x4e\xdb\xc7\xcb\x35\xc6\x0e\x4e\x91\xa5\xf8\xd9\x73\x04\xb2\x2d\x78\xa9\xa5\x6f\x14\x01\xc4\xb1\x7e\xde\x08\x34\xdd\x37\x13\x14\x45\x1d\x3f\x39\x45\x3b\x6d\x90\x4a\xf1\x87\x1b\x53\x51\x25\x56\xd4\x09\xad\x3e\x07\xb9\x1b\x19\x0d\x35\x95\x50\x33\x98\xf6\xd9\x23\x51\x1b\x50\xc5\xa2\x43\xac\x85\x64\x7d\x8c\x6b\x04\xb6\x13\xc9\x55\xe5\x6a\x38\xca\x73\x98\xee\x6d\x8f\x10\xb6\xa5\x2a\xa0\x18\xde\xa2\xa2\x9c\x68\x53\x81\x50\x30\x0a\x38\x80\x11\x71\xe8\x26\xe7\x73\x8c\x8c\x95\xc6\x37\xf3\x44\x0a\x75\x1b\x03\xaf\x0d\x47\x91\x4f\x4f\x30\x9b\x21\xfe\xc4\x1b\x09\xcf\xcf\xa4\xd8\x1a\x42\x0e\xd0\x92\xa5\x74\x04\x7d\xa3\x32\xc4\x7a\x74\x8c\x44\x8e\x14\x6f\x0d\xbd\xf3\xfa\x12\xb2\x34\x78\x07\x42\x1b\xb0\x67\x69\xbb\x67\xa8\xcf\x7b\x39\x6c\xa9\xe1\xca\xf5\x63\x26\xc5\xc7\x78\x52\x40\x01\x4f\x4f\xf7\x3c\x98\xa5\x17\x94\x31\x20\xc4\xa9\xf8\x0e\xc8\x95\xb0\x2d\x96\x43\x88\xf2\x64\x90\xe5\x34\x06\x5f\x9f\x8f\x6a\x3b\x7a\x4d\x1c\xd6\x0f\xf4\xd5\x0d\xa0\xcd\x4d\xba\x67\x69\x58\x22\x54\x8b\x45\x5f\x6a\xe5\xa8\x50\xdc\x0c\x64\x51\xd2\x39\x97\x45\x9f\x36\x96\x6c\x96\x76\x8e\x97\x1b\x22\x64\x10\xde\x68\xc6\x65\xcc\x6b\xd8\x40\xc0\xf0\x3b\x2f\x0c\x67\xe0\x96\x2d\x96\xb5\xe3\x8f\x8e\x00\x6a\xa0\xde\xe9\x85\x2e\x3d\x16\x8f\x33\x9e\x6f\x5b\x22\x6c\x1a\x2c\x07\x7c\x10\xbe\xac\xa9\xaa\x78\x57\xfb\x92\x53\xf3\xc9\x18\x6d\x6c\x6c\xa9\x2a\x69\xa9\x73\xdc\xa8\x9c\xa4\x7f\x7e\xd2\x64\xf5\x21\xf9\x31\x4d\xde\xc3\xaf\xe4\xef\xef\x77\xc7\x69\x5c\xd1\x08\xd5\xf5\x46\x4e\x2e\x86\x1a\x23\x74\x77\x58\xc6\xad\xa5\x15\xb7\x83\x2d\x1a\x03\x9b\x1c\xf3\xc0\x3c\xd6\x18\xbb\x38\x39\x61\xbe\x95\xa2\xc4\x53\x23\xc5\xf7\x5a\xd8\x18\x2c\xe0\x9b\xca\xd0\x38\x4b\x70\xf4\x96\xab\xbd\x1b\xe5\xbf\x80\xeb\x50\x49\xf1\x45\xdd\x53\x29\xd8\x4e\x1d\x44\xf8\x09\xdc\x28\xb9\x04\x4c\x98\xa1\x25\xae\x45\xb2\x64\x75\x06\x98\x91\x33\x60\xd4\xd6\xdc\x9e\x81\x57\x0c\x1d\xa5\x36\xdc\x8e\xe5\x1c\xa8\x62\x80\x3d\x55\x62\xc1\x19\xfc\x49\xa9\x1f\x38\x1b\x16\x79\x80\xfe\xed\x41\x84\x84\xf0\x97\xea\xc1\xd6\xda\x4b\x06\x73\xa4\x74\x80\x27\x6d\x1d\x5c\xf4\x83\x91\x5a\x55\x23\x84\x03\xe6\x2c\x7d\xad\xec\x0f\xe9\x8b\xaf\x46\xe0\xf5\xbc\x84\xab\xeb\x6f\x07\x75\x04\x53\x96\xf4\xfb\xe0\x60\x8d\x21\x8b\xfd\xf9\x35\x40\x8b\x18\x73\xef\x1c\xa6\xb1\x23\xea\xc6\x0f\xd9\x5c\xd4\xe8\x36\x54\x58\xec\x47\xfc\x6a\xbb\x38\x36\x37\x4a\x14\xd0\x6d\x1e\x9b\x19\xeb\xc9\x37\x74\xc7\xee\x4e\xc3\x34\x8c\xc3\x57\xc6\xe6\xfa\xb3\x7b\xfd\x03\xe5\xc0\x67\xe2\xc2\x07\x00\x00")
 
 func organizationViewsNewHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -486,12 +578,12 @@ func organizationViewsNewHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "organization/views/new.html", size: 2046, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "organization/views/new.html", size: 1986, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _organizationViewsTreeitemHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x91\xc1\x6e\x83\x30\x0c\x86\xef\x7d\x0a\x2b\x3b\xb0\x1d\x4a\xee\x88\x70\xda\x65\xa7\x3d\x83\x1b\x5c\xb0\x66\x02\x4a\x52\xa6\x0e\xf5\xdd\x97\xb4\x15\x05\x5f\xa2\xfc\xf2\xff\xfd\x96\x5d\x5f\xa4\x39\x40\xaa\x5a\x18\x5c\x77\xf4\x34\x11\x46\xa3\xa2\x27\x02\x76\x90\xdf\xd2\xf6\x2c\xad\xa7\xfc\x43\xfb\x03\xa7\xeb\x43\xee\x64\x3c\xa1\x70\xab\xee\x80\x5c\x09\x60\x05\x43\x30\x6a\x29\xd0\x46\x9e\xe9\x38\xfa\x0e\x1d\xff\x61\xe4\xd1\x15\x15\xcc\x43\xb9\x55\x56\x06\x18\x63\xf6\xd4\x9b\x6a\x56\x6e\x8d\x19\xdd\x7b\x3a\x1b\xf5\xa6\xb7\x80\xa0\x97\x05\xaa\x6a\xe7\x84\x5b\xf2\xde\xe5\x94\xd6\x51\xfc\xde\xf4\x7f\x72\x98\x04\xaf\x0e\x07\x7a\xdf\x99\x3e\x92\xeb\x95\xa7\x71\x13\x9e\xa2\xd9\x59\xb9\xb4\x04\xc1\x5b\xa3\x0a\x6d\xc7\x61\x1a\x1d\xb9\x18\x76\xc3\xe8\x99\xe9\x37\xe8\xcc\xfd\x8a\x34\x94\x7d\x1c\xa4\x50\x79\x74\x3e\x3f\x56\xba\xae\xb2\x14\x72\x5d\xec\x55\x53\xeb\x17\xfe\x79\x09\x2d\xdc\x1c\x6a\x9d\x2f\xf3\x1f\x00\x00\xff\xff\x7c\x93\x10\x44\x9f\x01\x00\x00")
+var _organizationViewsTreeitemHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x55\x91\x31\x6e\xc3\x30\x0c\x45\xf7\x9c\x42\x50\x07\xb7\x43\xac\xdd\x90\x3c\x75\xc9\xd4\x33\x30\x32\x23\x13\xa5\x65\x43\x52\x5c\xa4\x46\xee\x5e\x29\x29\x1c\x9b\x8b\xa0\x0f\xfe\xf7\x09\x52\x5f\xb9\x3d\x88\x5c\x9a\x49\x78\x77\x0c\x38\x21\x24\x23\x53\x40\x14\xe4\x45\x79\x6b\xdb\x13\x77\x01\xcb\x0f\xec\xb7\x38\xdf\x9e\xb2\xe3\xf1\x0c\x4c\x9d\x7c\x00\x4a\x65\x80\x65\x88\xd1\xc8\xa5\x02\x9b\x68\xc6\xe3\x18\x1c\x78\xfa\x85\x44\xa3\xaf\x1a\x31\x0f\xf5\x56\x59\x19\xc2\x18\xb3\xa7\xde\x65\xbb\x72\x35\x14\x74\x1f\xf0\x62\xe4\x9b\xda\x02\xd4\xb2\x88\xa6\xd9\x19\xc5\x3d\x5b\x1f\x72\x0e\x73\x98\xbe\x36\xed\x9f\x14\x27\x86\x9b\x87\x01\xdf\x77\xa6\x8f\xec\x7a\xc5\x29\xd8\x64\xe7\x64\xf2\x96\xaf\x1d\x8a\x18\xac\x91\x95\xb2\xe3\x30\x8d\x1e\x7d\x8a\xfb\x59\x66\xc2\x9f\xa8\x0a\xf7\x94\x70\xa8\xfb\x34\x70\x25\xcb\xe4\x74\x79\x6e\x74\xdd\x64\xcd\xe8\x5d\xea\x65\xab\xd5\x0b\xff\x7f\x08\xc5\xd4\x1e\xb4\x2a\x87\xf9\x03\x4e\x45\xda\x7a\x9e\x01\x00\x00")
 
 func organizationViewsTreeitemHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -506,12 +598,12 @@ func organizationViewsTreeitemHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "organization/views/treeItem.html", size: 415, mode: os.FileMode(436), modTime: time.Unix(1464694890, 0)}
+	info := bindataFileInfo{name: "organization/views/treeItem.html", size: 414, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationRegistrationappJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x94\xcb\x6e\x9c\x3c\x14\xc7\xf7\x79\x0a\x4b\xdf\x27\x19\xa4\x88\xcc\x25\x64\x26\x99\x15\x4a\xb7\x95\xa2\x5e\x56\x51\x17\x06\x1f\xc0\xaa\x2f\xd4\x36\x89\xa2\x68\xde\xbd\xb6\x07\xe8\x00\xd3\x51\x16\x53\xaf\xb0\x7f\xff\x73\xe5\xd8\x51\xd9\xca\xc2\x32\x25\x51\x14\xa3\xf7\x2b\xe4\x16\x6e\x0d\x20\x63\x35\x2b\x2c\xde\x85\x13\x22\xab\x96\x13\x1d\xbe\xfd\x4a\x84\xa2\x2d\x87\x08\x6b\xa8\x98\x53\x12\xef\x20\x6b\x1a\x7c\x8d\x9e\x07\x51\x70\x25\xab\xcf\xc4\x82\x66\x84\x3b\xe6\x77\x60\x0c\xa9\xc0\x1c\x76\x5f\x54\x6b\xc1\x7f\x0a\x25\x95\x69\x48\x01\x34\xf9\xa5\x0b\x45\xc3\x21\xb3\xe6\x4d\xb5\x4a\x72\x26\x21\x29\x94\x2c\x59\x35\x3b\xae\x81\x50\xd0\xf8\x47\xfc\x27\xb7\x83\x32\x7a\xc6\xff\x0b\xfa\xad\x06\xc1\x64\xf5\xa4\xd5\x0b\xf3\xba\x6b\x64\x0f\x27\x8f\x41\x74\xda\x4c\xfb\xac\x8e\x4c\xc2\xbe\x37\xd8\x5d\x05\x93\xa1\x6b\x23\x7f\xd1\x3c\x64\xdf\x54\xbf\xe6\x34\xa1\x50\xba\x32\x9e\x08\x07\x6b\x5d\x3f\x73\xde\x02\x33\xb5\x0b\xfa\x3e\xee\x63\xba\xc0\x0f\x08\xff\x57\x6e\xca\xbc\xa4\xf8\x7a\x0c\x97\x8b\x03\xcd\x5d\x2f\x60\x46\x57\x1d\xdd\x42\xb1\x85\xd5\x94\xae\x3b\x9a\xa6\x84\xd2\xf5\x94\xde\x76\x74\x5d\x90\x65\x31\xf3\x9c\xf6\x74\x71\xbf\xcc\xf3\x29\xbd\xeb\xe8\x8a\x6c\x80\xcc\x3c\x6f\x7a\xba\xbe\xcb\xb7\x64\x4a\xb7\x1d\x5d\xd2\x74\xbb\x99\xe5\x7c\xdf\xd3\xcd\xad\x4b\x7b\x4a\xb3\xbe\x1d\xa7\x9b\x95\xad\xce\x76\x2b\x3b\x5f\x72\x76\x3e\x6f\x37\x44\xee\x36\x18\xfb\x09\x4a\xd2\x72\xfb\xa8\xb8\xd2\x5e\xce\x59\x55\xdb\xbf\x8a\x89\xfe\x19\x94\xc6\x4b\xd3\x05\x72\x05\x20\x97\x25\x72\xff\x06\xb9\x74\x90\xaf\x08\xf9\xbc\x51\xc8\x6e\xf0\xb2\x8f\x77\x67\x66\x6b\x14\x2c\xf1\x73\xea\x06\x8c\x1e\x12\xc3\xf1\x98\x36\x9a\x09\xa2\xdf\x66\x73\xd8\x05\xd8\x4f\x86\xfe\xe8\x46\x44\xe3\xeb\x32\x1a\xf7\x11\x19\x07\x7c\xad\x41\x46\xf8\x66\x36\xe8\x7e\x59\x10\x0d\x77\xaf\xc6\x77\xcd\x1f\x7c\x97\x44\xa3\x24\x48\x6b\x6e\x8e\x5f\x9b\x9b\x17\x06\xaf\xe3\xa3\x52\x69\x91\xd4\x56\xf0\x49\xa3\xfd\x0a\xbd\x56\x9c\x83\x76\x2e\x8f\x8d\x1e\x07\x70\xd6\x2a\x33\xce\xee\x45\xe0\x91\x64\x1f\x9f\x2c\xca\x08\x13\x1e\x13\x2d\x42\x84\x8b\xd6\xe8\x7c\x7f\xb8\x4c\xa7\xfd\x07\xd5\x69\x30\x20\xa9\xf3\x7d\xd1\xba\x06\xaf\x1f\xfc\x81\x5e\xfd\xf5\xb2\xf5\x29\x77\x45\xf4\x2b\x33\xe0\xe7\x72\x18\xfc\x7d\x1c\xc5\xbb\xdf\x01\x00\x00\xff\xff\x4d\xbd\xb9\x4c\x25\x07\x00\x00")
+var _registrationRegistrationappJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x94\xc9\x6e\xdb\x30\x10\x86\xef\x79\x0a\x02\x2d\x40\x09\x08\xe4\x2d\x8a\x9d\xf8\x64\xb8\xd7\x02\x41\x97\x53\xd0\x03\x25\x8e\x24\xa2\x5c\x5c\x92\x72\x10\x04\x7e\xf7\x92\xb2\xa4\x98\x92\x6b\xe4\xe0\xf2\x24\xf2\x9b\xf5\xd7\x90\x51\x51\xcb\xdc\x32\x25\x51\x14\xa3\xb7\x1b\xe4\x16\xae\x0d\x20\x63\x35\xcb\x2d\x5e\x37\x27\x44\x96\x35\x27\xba\xf9\xf6\x2b\x11\x8a\xd6\x1c\x22\xcc\xac\x79\x55\xb5\x92\x9c\x49\x48\x34\x94\xcc\xb9\x11\x1f\x0d\xdf\xa2\xe7\xde\xbc\x09\x2a\xcb\xaf\xc4\x82\x66\x84\x3b\xe6\x77\x60\x0c\x29\xc1\x1c\x77\xdf\x54\x6d\xc1\x7f\x0a\x25\x95\xd9\x91\x1c\x68\xf2\x47\xe7\x8a\x36\x87\x41\x1a\x53\x11\x0d\x74\x74\x5c\x01\xa1\xa0\xf1\xaf\xf8\xbd\xca\x5c\xc9\x82\x95\xd1\x33\xfe\x2c\xe8\x8f\x0a\x04\x93\xe5\x93\x56\x7b\xe6\xed\x6e\x91\x3d\x9e\x6c\x1b\xa3\xf3\x6e\xda\x57\x75\xe2\xd2\xec\x3b\x87\xf5\x4d\xe3\xd2\xeb\x17\xc4\x8b\xc6\x29\x3b\x79\xfd\x1a\xd3\x84\x42\xe1\xda\x78\x22\x1c\xac\x75\xca\x66\xbc\x06\x66\x2a\x97\xf4\x2d\xd4\x31\x9d\xe2\x47\x84\x3f\x15\xcb\x22\x2b\x9c\x0a\x21\x9c\x4d\x8f\x34\x73\x5a\xc0\x88\xce\x5b\xba\x82\x7c\x05\xf3\x21\x5d\xb4\x34\x4d\x09\xa5\x8b\x21\xbd\x6b\xe9\x22\x27\xb3\x7c\x14\x39\xed\xe8\xf4\x61\x96\x65\x43\x7a\xdf\xd2\x39\x59\x02\x19\x45\x5e\x76\x74\x71\x9f\xad\xc8\x90\xae\x5a\x3a\xa3\xe9\x6a\x39\xaa\xf9\xa1\xa3\xcb\x3b\x57\xf6\x90\x6e\x3a\x39\xce\x8b\xb5\x99\x5f\x54\x6b\x73\xb9\xe5\xcd\xe5\xba\xdd\x10\xb9\xab\x60\xec\x17\x28\x48\xcd\xed\x56\x71\xa5\xbd\x39\x67\x65\x65\xff\x69\x4c\xf4\xef\xc6\xd2\x78\xd3\x74\x8a\x5c\x03\xc8\x55\x89\xdc\xbf\x41\xae\x1c\xe4\x3b\x42\xbe\x6e\xd4\x54\xd7\x47\x39\xc4\xeb\x0b\xb3\x15\x24\x4b\xfc\x9c\xba\x01\xa3\xc7\xc2\x70\x1c\xd2\x9d\x66\x82\xe8\xd7\xd1\x1c\xb6\x09\x0e\x83\xa1\x3f\xb9\x11\x51\x78\x5d\x82\x71\x0f\x48\x98\xf0\xa5\x02\x19\xe1\xc9\x68\xd0\xfd\xb2\x20\x76\xdc\xbd\x1a\x3f\x35\x7f\xf4\x2a\x89\x9d\x92\x20\xad\x99\x9c\x3e\x35\x93\x3d\x83\x97\xf0\xa8\x50\x5a\x24\x95\x15\x7c\x20\xb4\x5f\x8d\xd6\x8a\x73\xd0\x2e\xe4\xa9\xd3\xb6\x07\x17\xbd\x36\xc6\xf9\xed\x05\x0e\x4c\x0e\xf1\xd9\xa6\x8c\x30\xcd\x63\xa2\x45\xf7\x28\x5e\xaf\x47\x17\xfb\xc3\x6d\x3a\xdb\xff\xd0\x9d\x06\x03\x92\xba\xd8\x57\xed\xab\x8f\xfa\xc1\x1f\xe8\xad\xbf\x5f\xb7\x3f\xe5\xae\x88\x7e\x61\x06\xfc\x5c\xf6\x83\x7f\x88\xa3\x78\xfd\x17\xe9\x8e\x8d\xc0\x2f\x07\x00\x00")
 
 func registrationRegistrationappJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -526,12 +618,12 @@ func registrationRegistrationappJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/registrationApp.js", size: 1829, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "registration/registrationApp.js", size: 1839, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationRegistrationcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x56\xc1\x8e\xd3\x30\x10\xbd\xf7\x2b\xac\xd5\x4a\x4e\x44\x95\xc2\x8a\x0b\xad\x38\xac\x56\xe2\xc6\x85\x15\x5c\x56\x7b\xf0\x26\xd3\xd6\x22\x8d\x23\xdb\x69\x41\x28\xff\xce\x78\xb2\x69\xe3\x3a\x4d\x43\x0b\xf8\x12\xc7\x7e\x7e\x33\xf3\x3c\x1e\x3b\x5a\x56\x45\x6a\xa5\x2a\x58\x14\xb3\x5f\x13\x86\x8d\x57\x06\x98\xb1\x5a\xa6\x96\x2f\x68\x44\x14\xab\x2a\x17\x9a\xfa\xae\x25\x1b\x95\x55\x39\x44\x5c\xc3\x4a\x22\x52\x38\x82\xfb\xb2\xe4\xf1\x01\x92\xaa\xc2\x6a\x95\xe7\xa0\x7d\xd8\xc3\x7e\x9c\x4f\xd9\x13\xbf\x35\xa9\x2a\x01\xbb\xfc\x76\x6d\x6d\x49\x9d\x9d\x2c\x32\xb5\x73\x5d\x24\x59\xca\xd5\x23\xe8\xad\x4c\x1d\xa8\x9f\xe8\x39\x5e\x4c\xc8\xf0\x3e\x96\x7e\x5c\xd4\x18\x9b\x32\x32\x85\x9f\xc6\xd0\x94\x79\x66\x5a\x19\x5c\xdb\x0a\xcd\xb6\x1b\xf6\x91\xd9\xb5\x34\x8b\xfd\xb0\x87\x4f\x56\x60\x1f\x68\xa0\xa3\x65\x83\xe8\x52\x11\xdd\x26\xb1\xca\x96\x06\x52\x0d\x16\x59\x1b\x54\x67\xec\x60\xa2\x8e\x0f\x7d\x5c\xd6\x04\x04\x1a\x17\xb5\xdd\xa3\x79\x03\xf6\x9b\xc8\x65\x46\x31\x13\xcc\x1b\x79\x55\xa8\x47\x25\xd4\x25\xf0\x13\xc3\xc6\x65\x02\x69\xfc\x09\xd7\xec\x4e\x2d\xc5\x06\xec\x5a\x65\x73\x8a\x68\xa7\x3e\xdd\x7f\xa6\xff\x69\x00\xce\xd5\x4a\x16\x04\xa3\x5e\x08\x80\x8d\x90\x39\x01\xa8\x17\x02\x4a\x61\xcc\x4e\xe9\xc6\x56\xfb\x13\xc2\x9c\x86\xa9\xca\x60\xde\x8a\xec\x7e\x7a\xd8\xd6\xaa\x80\xa2\xda\xbc\x80\x26\xa4\xd9\x98\x89\x07\xaa\x17\xde\x2f\xee\x90\x51\x39\x38\xef\x23\xa7\xc8\x94\x74\xd9\xfb\x11\x7b\x60\x4a\xab\xc0\x64\x52\x2a\x63\x23\x3e\x6b\xd5\xe6\x0d\x47\x1c\x02\xed\x1a\x8a\x4e\x0e\xe1\x0e\x96\x68\x1e\x8e\x77\x67\x6f\xae\x49\x5f\xf4\x2d\xa5\x2d\x4e\xd6\x1a\x96\xcd\xce\xd3\xba\x84\x5c\xd5\x90\x49\x0d\xa9\xad\x74\xbe\x08\x68\xea\x29\x1b\x6f\xaf\xab\x85\x67\x23\x0e\x89\x5d\x33\x3b\x69\xd3\xf5\x81\x37\x31\x56\xd8\xca\x9c\xa2\x27\x13\x02\x4b\xcf\xfb\xbb\xbb\xf9\x49\x44\x2f\x31\x05\x0a\x5a\x2b\x3d\x44\xee\x19\xe1\xb2\xd8\xba\xc3\xd1\x49\x08\x3e\x6c\xb5\x6d\x4d\x11\x49\x8c\x5c\x15\x55\xb9\x54\x1a\xb3\xf2\xc0\x91\xdc\xb6\xa7\x4e\xda\x9f\xd1\x4d\x68\xe4\x06\x15\x17\x39\x8a\xdc\xaf\xd9\x71\x7b\xd1\x20\xbe\x9f\x87\x7a\x21\xb5\xd9\x7f\x71\x3c\x2d\x41\x7f\x30\xed\xec\x7f\x88\xa4\x3d\x66\x97\xef\xcc\x2b\xc1\x89\x6d\x79\x9d\xfd\x37\x91\x64\xb0\x14\x55\x6e\xc7\xb9\xde\x9e\x2d\x4a\xe2\x88\x7f\x2d\x9a\xab\xa1\xc2\xd3\xcb\x68\x6c\x4e\xb7\x5f\x98\xef\xc3\x7e\xd4\x83\xb3\x67\x02\x69\x0e\xe3\xdb\x0f\xc3\x11\x84\xa2\x53\xa5\xf7\x15\xe7\x59\x55\xe6\x12\xcb\x14\xe0\xdb\x42\x17\x78\x7d\xf0\x71\x9a\x8f\x73\xf1\xdd\xb0\x8b\xb3\x19\x7b\x04\x63\x5c\x91\x83\x1f\x25\x56\xc3\x2c\x61\x5f\x20\x57\x22\xc3\xdb\x65\x05\xc9\x70\x7c\xc7\x55\x56\xd3\xca\xe8\x3a\xc7\x47\x25\xc7\xa9\x02\xcf\x67\xb4\xf7\x9c\xbd\x61\x47\xb5\xf5\x62\x9f\xc2\x44\xe9\x3e\x40\xea\xde\xc7\x83\xf7\xbe\x88\x4a\xad\xca\xe3\xea\xdb\x16\xea\xbe\x39\xd7\x9a\xc3\x7e\xbe\x04\x07\x39\xf6\xe4\x18\x9f\xc7\x14\x5b\xab\xab\x53\x39\x76\x42\x8e\xc6\xa9\x33\x45\x74\x9c\x47\x9d\x52\x79\xa9\x1f\x74\x98\xae\x72\x22\x38\x7a\xd7\xa8\x72\x78\xeb\x8d\xf5\xe9\xd4\x6d\x32\x4e\x9b\xab\x2f\xdb\xbf\xc4\x5d\x0a\x8b\x4f\xb7\xe2\x8f\xa5\xab\x27\x7e\xaf\x9e\xd4\x31\x96\x8e\xdf\x01\x00\x00\xff\xff\x21\x4b\x0b\x7c\x76\x0d\x00\x00")
+var _registrationRegistrationcontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x57\xcd\x6b\xdb\x30\x14\xbf\xf7\xaf\x10\xa5\x54\x0e\x0b\x6e\x57\xb6\xc3\x5a\x76\x18\x85\xc1\x0e\xbb\xac\x74\x97\x51\x8a\x62\xbf\xc4\x62\xb6\x64\x24\x39\x59\x19\xf9\xdf\x27\xc9\x96\xbf\xed\xd8\xc9\xba\xe9\x10\x94\xa7\xa7\x9f\x7e\xef\xe9\x7d\xc8\xde\x3a\x63\x81\xa2\x9c\x21\x6f\x81\x7e\x9f\x21\x3d\x70\x26\x01\x49\x25\x68\xa0\xf0\x9d\x95\x10\xb6\xc9\x62\x22\xec\xdc\x0c\x3f\xe1\x61\x16\x83\x87\xa9\x92\x2f\x3c\xe3\x2c\xa6\x0c\x7c\x01\x1b\xaa\xb7\x11\x83\x86\x17\x95\x72\xc0\x99\x12\x3c\x8e\x41\x78\xb8\xae\x73\x5f\xca\xf1\x12\xfd\x28\xf5\x2d\x85\x0b\x19\xf0\x14\xb4\x1c\x5f\xec\x28\x0b\xf9\xce\x4e\x93\xf0\x51\xd1\xd8\x4c\x35\xe6\x9a\x6e\x1e\x40\x6c\x69\x60\xd5\xea\xc0\xa5\xb8\x81\xd9\x7f\xf4\xd3\xe2\xee\xcc\xaa\x95\x7e\xe8\xd7\xf3\x72\x46\x4b\x54\xf0\xd1\x93\x9c\xcd\x12\x35\xb8\x2c\x51\x0f\x13\xe7\x59\x33\xb6\x44\xa0\x6d\x82\x3e\x22\x15\x51\x79\x57\x8a\x1b\x20\xfe\x06\xd4\xbd\x15\x78\xd5\xf5\xe4\x1a\x75\x28\x0b\x97\xf8\x8a\xab\x54\x42\x20\x40\x69\xd4\x5c\xab\x26\xab\x8e\xd8\x2f\xaa\xb9\xde\x96\xf3\x04\xa1\x37\xb9\x69\x6b\x5d\x82\xfa\x4e\x62\x1a\x5a\x53\xac\x5a\x43\xd2\xd0\x5e\x11\x49\x83\x2f\x6c\xcd\xed\xba\x56\x6e\x0a\x1a\xba\x6a\xc7\x3f\x7f\xfa\x0a\x2a\xe2\x46\x11\xcb\x44\xe2\xc6\xfa\x36\x3f\x02\x1e\x25\x08\x46\x12\xd0\x4a\x85\xb3\xfd\x10\x56\x5c\x7b\x04\xbc\x6e\xd8\xba\x91\x5f\x94\x2f\xe9\x86\x65\xe9\x9a\x8b\xc4\x8f\xf9\x86\x32\xff\xc2\x91\xa7\xea\xc5\x3b\x0f\xb3\x34\xa6\x81\x3e\xe4\x39\x2b\x4e\x39\x5f\x22\x25\x32\xa8\x39\x69\x3a\x1a\x65\x96\x73\x89\xf5\x6c\x34\x89\xea\x87\xa4\x6b\xe4\x0d\xc2\x5a\x9c\xb6\x49\xed\xf0\x2d\xc2\xa4\xa3\x63\x46\xc7\x7b\xde\xb6\x00\x5f\xf4\xeb\xab\x08\x58\xcd\x9d\xfa\x92\x53\xce\x24\xf4\x71\x98\xe5\x14\x07\xe4\x6b\x32\xc4\x07\x21\xb8\x30\xc9\x51\x17\xe6\xc6\xde\xf5\x9e\xb3\x6f\xc9\xf7\x55\x1c\x2f\xd1\xfb\xeb\xeb\xd2\xb5\xa5\xbc\x95\xc1\x3a\x67\xdb\x36\x4c\xf1\x61\x99\x17\x5e\x33\x52\x97\xc8\xf9\xd1\xce\x20\x21\x26\xf7\xf5\x2c\x25\x52\xee\xb8\xc8\x15\x4c\xde\x05\x3c\x04\xfb\x47\x07\x76\xd7\xe7\x73\xfd\x5d\xd4\x1b\x7d\x74\x60\x79\xfb\x91\x80\x75\x9e\x8c\x35\x4f\x0a\x08\xa9\x80\x40\x65\x22\xee\xba\x53\x3b\x6c\xfa\x79\xba\x80\x48\x1e\x83\x31\xb5\x79\x85\x03\xf7\x24\x77\x54\x05\x51\x85\xeb\x4b\x45\x54\x26\xc7\xc2\x27\x20\xba\xc1\xbc\xbb\xb9\xb9\x1d\xd4\x30\xc3\x14\x4a\x1d\x35\x1d\x53\x6d\x24\xf5\x73\x69\x73\xd2\xaa\x63\x3c\x1a\x7c\xb0\xcb\xe1\x34\xe2\x0c\x58\x96\xac\x74\x5f\x1a\x67\xe8\x46\x37\x1d\x1a\x89\xa0\x59\xe8\x0b\x20\xb1\x6c\x17\x82\xa1\xb1\x12\x40\x7e\x1e\x56\x6d\xd2\x76\x91\x77\x34\x67\x07\xf0\x2f\xc9\xbb\xdc\xd1\xec\xf9\xb3\x8c\xb8\x50\x13\xe8\xb7\xb6\x9a\xe7\x05\xa1\x4c\x96\xc5\xf7\x04\x08\x9b\xd5\xf3\xf7\x1b\x9b\x83\x08\xc2\xa3\x7d\xef\x90\xfe\x47\xe0\xb4\x7a\xd6\xd1\x36\xf4\xb4\x80\xd7\x32\x20\x84\x35\xc9\x62\x35\x8d\xa9\x2b\x68\xb6\x6e\x78\xf8\x91\xe5\x4f\xa4\x4c\x97\x4c\x64\x65\xb7\xb8\xdd\x97\xac\xf8\x00\xe5\xfd\xe8\xea\x01\x43\xf2\x0a\x78\xfd\x61\xdc\x82\x49\x3e\xc6\xdd\x97\x0c\x9e\xe6\xf4\x69\x1c\xdf\x8e\x73\xbc\xba\x42\x0f\x20\xa5\x69\x2d\xf0\x2b\xd5\x3d\x28\xf4\xd1\x37\x88\x39\x09\x51\x4a\x36\xe0\x8f\x1b\xd8\xee\x6d\xc2\xee\xf4\x4e\x23\x3e\x29\x3a\x86\xda\x2a\xbe\xb2\x97\x8f\xd1\x1b\xd4\xea\x68\x47\x73\xea\x46\x4a\xfd\x6d\xb3\xef\x7d\xc0\x34\x1e\xda\x5e\x2a\x78\xda\x6e\x64\xae\xc7\xf5\xad\x99\x51\x94\xa8\x83\xcd\xac\x13\x64\x3f\x0c\xe2\xd3\xc0\x03\xb7\x86\xd7\xff\xb8\x3d\xe0\x8f\x9c\xd5\x81\x5e\x35\x8f\x92\x03\x3b\x81\x8f\x2b\xbf\x27\xf1\xe9\xf6\xb2\x71\x46\x33\x51\x3b\x6d\xee\x75\xd0\x6d\x07\xfc\xab\xd0\xae\x39\x9e\x12\x2f\xd5\x33\x7c\xea\x15\x0d\x3c\x67\xce\xa7\x45\x4b\x4f\x87\xae\x02\xff\xd4\xd4\x98\x03\x9e\x12\xa5\x3f\x45\xd8\x6c\xdf\xed\x47\xeb\x4b\xf3\xdb\xbc\xe7\x33\x49\x7f\x47\xb0\xa1\xf6\xd3\xa1\x70\x79\x39\xdc\xa9\xac\x6b\xa6\xed\xb0\x91\x37\x6b\x47\xf5\x6a\x3a\x62\xd3\xb6\x2c\xaf\xc5\xf6\x7a\x49\xce\x7f\xf7\x0b\xdd\x89\xfe\x00\x07\xe1\x87\xab\x21\x13\x00\x00")
 
 func registrationRegistrationcontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -546,12 +638,12 @@ func registrationRegistrationcontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/registrationController.js", size: 3446, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "registration/registrationController.js", size: 4897, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationRegistrationresendsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\xcd\x8a\xdb\x30\x10\xbe\xef\x53\x0c\x4b\x40\x36\x0d\x4a\xbb\xf4\xb4\x26\x87\xd2\x43\x1f\x60\xa1\x97\xd2\x83\x62\x8f\x63\xb1\xb6\x64\xa4\x51\xd2\x52\xfc\xee\xd5\xcf\x26\xb1\xe3\xc4\x2d\xa5\x73\x08\x63\x69\xe6\x9b\x99\xef\x9b\x28\xab\x9d\x2a\x49\x6a\x05\x59\x0e\xbf\x1e\xc0\x1b\x73\x16\xc1\x92\x91\x25\xb1\x22\x9e\x08\xb5\x77\xad\x30\xd1\x0f\xc6\x3b\x5d\xb9\x16\x33\x66\x70\x2f\x7d\xa4\x08\x00\x9f\xfa\x9e\xe5\x97\x90\x52\x2b\x32\xba\x6d\xd1\x84\x30\x8b\xaa\x7a\xe9\xec\xe7\xf3\x21\x5b\xc3\x37\xb6\xb2\xa5\xee\xd1\xbb\x6c\x75\x94\xaa\xd2\xc7\xe8\x36\x44\xbd\x77\x6e\x24\x7d\xcf\x8b\x87\x58\xe1\xdc\xf4\x8d\xa0\x2c\xa1\xae\xe1\x0d\xd3\x3b\x01\xf1\x34\x5d\xb0\x83\x30\x70\xe8\x60\x0b\xd4\x48\x5b\x5c\x8e\x3b\x6e\xdd\xae\x93\xe4\x6f\x92\x33\xb9\x0b\xb5\xe8\xab\x68\x65\x15\xe7\xf5\x41\x57\x27\x6f\xcd\x4d\x1a\x4c\x38\xd9\xb8\xfa\xa9\x03\x9f\x24\x3c\xc8\xf4\x22\x58\xdf\x68\x85\xca\x75\x3b\x34\xcf\xa1\xf0\xe8\x7b\x12\x3b\x14\x93\xcf\x38\xe6\x0c\x8c\xf7\xda\x52\xc6\x36\x49\x2a\x34\x9b\x44\x99\xed\xac\xe7\x38\xb4\x90\xcf\x53\xa8\x41\x35\x5a\x0c\x9f\xd1\x6b\x65\xf1\x7a\x88\x73\xe1\x44\x34\x6f\x75\x19\x79\xe0\x8d\xc1\x3a\xd1\x13\xf3\x78\x28\xe3\xe9\xab\xa4\xc1\x92\x9c\x69\x8b\x19\xcc\xb0\x86\xbf\xaf\x67\x8f\x92\xca\xe6\x12\xc7\x2d\x09\x72\xf6\x5e\x78\xb0\x52\xf8\x9d\xfe\xf8\xf4\xf4\x7c\x37\x22\x98\xac\x47\xa0\xb1\x69\x34\x46\x1b\xd8\x6e\xb7\xc0\xa4\x3a\x04\xa5\x47\x62\xb0\xa5\x8a\x67\x72\xe2\x36\x26\x0d\xfd\x7f\xa2\x96\xa6\x8b\x24\xd5\xda\x4c\x94\xe5\xab\xd3\x2e\x49\xfa\x99\x3d\xce\xab\x3d\x7a\x8a\x44\xeb\x59\x99\xb3\x37\x61\x72\xf1\x76\x67\x50\xbc\xde\x07\x48\x34\xbd\xff\xb0\x4c\xd3\x66\x03\x2f\x68\x6d\xd0\x0a\x7f\xf4\x5e\xd4\x8a\xc3\x17\x0d\x3b\x51\xbe\x02\x69\x18\x3f\x0a\xd0\x8b\x3d\xf2\x45\xb4\xf9\xf6\x08\xdb\xf8\xed\x61\x6c\x79\xd0\x3f\x8c\x52\x61\x2d\x5c\x4b\xcb\x93\xdc\xdb\x5c\xb6\x89\xc2\x33\x78\x07\x57\x4b\xf6\xcf\x3d\xcd\x75\x19\x46\x4a\x0e\x37\x9e\x8e\xab\xd7\x65\xf6\x86\xfc\xcf\xcd\x22\xe3\x70\xd2\x4e\xfa\x1d\xf2\x2c\x2f\x7e\x07\x00\x00\xff\xff\xe2\x23\x36\xd1\x23\x06\x00\x00")
+var _registrationRegistrationresendsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x54\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x10\x45\x00\xd9\x58\x20\x6f\xc5\x4e\x35\x72\xda\xa1\x3f\xa0\x40\x2f\xc3\x30\x28\x36\x1d\x0b\x95\x25\x43\x1f\xc9\x8a\xc1\xff\x7d\xfa\x68\x12\x3b\x4e\xdc\x61\x18\x0f\x01\x2d\x91\x8f\xe4\x7b\x8c\xb2\xc6\xc9\xca\x72\x25\x21\xcb\xe1\xf7\x1d\x78\x23\xce\x20\x18\xab\x79\x65\x49\x19\x4f\x98\xdc\x39\xc1\x74\xf4\x83\xd1\x4e\xd5\x4e\x60\x46\xb8\x35\x6f\xca\x29\x29\xb8\x44\xaa\x71\xc7\x7d\x1a\x0b\x68\x24\x3f\x07\x57\x4a\x5a\xad\x84\x40\x9d\x11\x8d\x06\x65\xfd\xdc\x99\x6f\xa7\x43\xb2\x86\xef\x64\x65\x2a\xd5\xa3\x77\xc9\xea\xc0\x65\xad\x0e\xd1\x6d\xad\xed\xbd\x73\x25\xe9\x47\x5e\xde\xc5\x0a\xa7\xf6\xaf\x04\x65\x09\x75\x0d\xef\x98\xde\x09\x88\xc7\x39\x83\xed\x99\x86\x7d\x07\x1b\xb0\x2d\x37\xe5\xf9\xb8\xa3\xc6\x6d\x3b\x6e\xfd\x4d\x72\x26\x77\xa1\x96\x7d\x61\x82\xd7\x71\x58\x1f\x74\x71\xf2\xde\xdc\xa4\xc1\x84\x93\x8d\xab\x1f\x3b\xf0\x49\xcc\x83\x4c\x2f\x82\xf5\xad\x92\x28\x5d\xb7\x45\xfd\x18\x0a\x8f\xbe\x27\xb1\x43\x39\xf9\x8c\x63\xce\xc0\x68\xaf\x8c\xcd\x48\x91\x74\x42\x5d\x24\xca\x4c\x67\x3c\xc7\xa1\x85\x7c\x9e\x62\x5b\x94\xd9\x79\x45\x7c\x46\xaf\xa4\xc1\xcb\x21\x4e\x85\x13\xd1\x54\xa8\x2a\xf2\x40\x5b\x8d\x4d\xa2\x27\xe6\xd1\x50\xc6\xd3\x57\x73\x8d\x95\x75\x5a\x94\x33\x98\x61\x0d\x7f\x5f\xcf\x1c\xb8\xad\xda\x73\x1c\x35\x96\x59\x67\x6e\x85\x07\xab\x98\xdf\xee\xaf\x0f\x0f\x8f\x37\x23\x82\xf1\x66\x04\x1a\x9b\x46\xad\x95\x86\xcd\x66\x03\x84\xcb\x7d\x50\xfa\xe7\x48\x0d\xb2\x54\xf2\xc4\x4e\x5c\xc7\x24\xa2\xff\x53\x34\x5c\x77\x91\xa5\x46\xe9\x89\xb4\x74\x75\x5c\x26\x6e\xdf\xb2\xfb\x2b\xe5\xee\x3d\x49\x4c\x78\x5e\xe6\xfc\x4d\xb8\x5c\xbc\xdd\x6a\x64\xaf\xb7\x01\x12\x51\x9f\xbf\x2c\x13\x55\x14\xf0\x8c\xc6\x04\xb5\xf0\x57\xef\x65\xad\x29\x3c\x29\xd8\xb2\xea\x15\xac\x82\xf1\x9b\x00\x3d\xdb\x21\x5d\x44\x9b\xef\x0f\x33\xad\xdf\x1f\x42\x96\x07\xfd\x60\x94\x1a\x1b\xe6\x84\x5d\x9e\xe4\xd6\xee\x92\x22\x4a\x4f\xe0\x13\x5c\xac\xd9\x3f\xf7\x34\xd7\x65\x18\x29\x39\x5c\x79\x3c\x2e\xde\x97\xd9\x2b\xf2\x5f\x57\xcb\x6a\x87\x93\x7e\xd2\xef\x90\x67\x79\xf9\x07\xcd\x57\x10\xe1\x30\x06\x00\x00")
 
 func registrationRegistrationresendsmscontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -566,12 +658,32 @@ func registrationRegistrationresendsmscontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/registrationResendSmsController.js", size: 1571, mode: os.FileMode(436), modTime: time.Unix(1464872134, 0)}
+	info := bindataFileInfo{name: "registration/registrationResendSmsController.js", size: 1584, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationRegistrationsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x55\xcf\x6e\xdb\x3e\x0c\xbe\xe7\x29\xf8\x2b\x0a\x48\xc6\x2f\x50\xd2\x62\xa7\x06\x39\x0c\x3b\xec\x01\x06\xec\x32\xec\xa0\x48\x74\x2c\xd4\xb6\x0c\x49\x6e\x37\x14\x7e\xf7\x49\x72\xfc\x2f\x8e\xdd\xad\x3a\xc9\x12\xf9\x91\xfc\xf8\x89\xa6\x69\x5d\x0a\xa7\x74\x09\x34\x81\xb7\x0d\xf8\x45\x6a\x8b\x60\x9d\x51\xc2\x91\x43\x3c\xe1\xe5\xb9\xce\xb9\x89\xfb\xb0\x58\xa1\x65\x9d\x23\x25\x06\xcf\xca\x5b\xf2\x00\xf0\xb9\xaa\x48\x32\x98\x08\x5d\x3a\xa3\xf3\x1c\x0d\x25\xb6\xb0\x5f\xfa\x4f\xb2\x85\x1f\xe4\x3e\x73\xae\xf2\x3b\x72\xef\x54\x81\xba\x76\x71\xff\xaa\x4a\xa9\x5f\xfd\x76\xe2\xf0\x33\x39\x6c\x22\x6e\x9f\xea\xe4\x9a\x46\xac\x2d\x74\x48\x7e\xd7\xe2\x74\xf5\x84\xf5\xc2\x0d\xbc\x14\x70\x04\x97\x29\x7b\x18\x8e\x0b\x66\xeb\x53\xa1\x9c\xbf\x69\x37\xd3\xbb\xc2\xfa\x32\x52\x65\x8a\x58\xa1\x37\x7a\xbb\x7c\xa3\x7c\x82\x94\xe7\x16\x9b\x4b\x6e\x61\x75\x19\x50\x91\xa1\x78\x1e\x7b\x6e\xe1\x61\xbf\xdf\x27\x03\x78\x5f\xca\xcc\x94\x8e\xd3\x8e\xa8\xa1\x3c\x76\x46\xd7\xd1\x8d\x66\x37\x24\x86\x92\x24\xcc\x65\x58\xd2\x89\xd3\x94\xae\x5a\x08\xb4\x96\x1a\xb4\x95\x2e\x2d\x5e\x47\x58\xad\xb8\x73\x62\x92\x3b\x7e\xb8\xe9\xa7\x52\xa0\xff\x4d\xec\x58\x9f\xdd\x52\xac\x7f\xe4\x6b\xbc\x1a\x40\x4f\xfc\x0a\x6e\xdb\x49\xba\xe4\x3e\x3b\x6d\xb6\xcb\xdc\xa5\x5c\xe5\x28\xdf\xa5\xee\x03\xa5\x4c\xf3\x18\x19\x34\x9b\xb9\x4c\xba\x92\xae\xc2\x07\x59\x07\xbe\x83\x32\x67\x01\x62\x2f\x25\x3e\xf5\x7d\x95\x38\xb1\x69\x0e\x73\x9d\xcd\x40\x58\xa5\xed\x6d\xe5\xc5\xea\xfc\x5b\x0d\xf1\x93\xb9\x5f\xd4\xe4\x30\x5c\xde\x23\xd0\x83\x5a\x9d\x23\xcb\xf5\x99\x4e\xa4\x34\x87\x8e\xc9\xb6\x4f\xdc\x9b\x8b\x98\x07\xcb\x0c\xa6\xd7\x6a\x65\x06\xa5\x32\x28\x5c\x6d\xf2\x1b\xfc\x6f\xe1\xef\xd3\xb3\xaf\xca\x89\x6c\xb0\x63\xd6\x71\x57\xdb\x35\x75\x0b\xee\x45\xfa\xe9\xf1\xf1\x69\xd1\x22\xac\xf0\x76\xa6\x49\xa3\x31\xda\xc0\xf1\x78\x04\xa2\xca\x17\x9e\x2b\x79\x69\x1e\x59\x8b\xd6\x13\xe3\x4d\x2b\x64\x55\xa6\x4b\x1c\x37\x2a\xd5\xa6\x68\x4f\xcb\xba\x38\xa1\x61\xf7\x16\xdd\xf7\x80\xae\xdc\x6f\x7a\x77\x89\x34\x32\xb8\xdb\xb6\x03\x6e\xe1\x15\xf5\x2c\xae\xde\x9e\x0c\xf2\xe7\x65\x80\x96\xa2\xfd\xc3\x3a\x45\xbb\x1d\x7c\xf3\xe3\x2b\xf4\x09\x7f\x55\xbe\xa1\x92\xc1\x57\x0d\x27\x2e\x9e\xc1\x69\x18\xff\x83\xa0\xe2\x67\x64\xab\x68\x73\xe5\x70\x9b\x79\xe5\x10\xb2\x5e\xe8\x3b\xa5\x48\x4c\x79\x9d\xbb\xf5\x4a\x96\x54\x4b\x76\xb1\xe9\x04\xfe\x87\x2b\x81\x7d\x38\xa7\x1b\x53\x6e\x3e\x62\x9a\x4d\x93\xf8\x31\xf9\x27\x00\x00\xff\xff\xf8\x51\x56\xc3\x04\x08\x00\x00")
+var _registrationRegistrationserviceJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x92\x3d\x6f\xc3\x20\x10\x86\xf7\xfc\x0a\x14\x45\x02\x4b\xc8\xd9\x89\x3a\x74\xe9\x96\xa5\x55\xa7\xaa\x03\xb5\x89\x83\x64\x83\xc3\x47\xa2\xaa\xf2\x7f\xef\x81\xbf\xe2\xe0\x4a\xbd\x01\x4e\xc7\xcb\xe9\xb9\x17\xc8\xc9\xab\xc2\x49\xad\x10\xc9\xd0\xcf\x06\x41\x60\x6f\x05\xb2\xce\xc8\xc2\xe1\xc3\x26\x96\xb8\xaa\x7c\xcd\x4d\xcc\x43\xe4\x8d\x2e\x7d\x2d\xc8\x56\x3a\xfb\xad\xbd\x56\xb5\x54\x22\x37\xa2\x92\x70\x8f\x87\x76\xdb\x6c\x16\x5b\x61\xae\xb2\x00\xf5\xbd\xe0\xad\x2f\x6e\x29\xfa\xc0\xbb\xb3\x73\x2d\xa6\x08\xef\x2e\xb0\xbe\xa6\xaa\xcf\x6c\x00\x99\x68\x57\x44\x24\xb6\xa1\x68\x77\x19\x27\x09\x61\x84\xf3\x46\xdd\x15\x42\x5c\x79\x2d\x4b\xee\xc4\x3b\xa0\x29\xde\x08\x96\x54\xe8\x42\xdf\x83\x0b\xc3\xa6\x6c\x3a\xee\x06\xb2\x05\xdd\x63\x37\xe2\x87\x24\x4b\x40\x0c\xd2\x6d\xb8\x63\xd1\xd3\xc3\x59\x88\x96\x1b\xde\x58\xb6\x72\x12\xc2\x4f\xf8\x63\x96\xc8\xba\x45\x05\x60\x97\x63\x45\x6f\xa2\x6f\x79\x25\x1c\xc1\xfb\x91\x7c\x6c\x08\xef\x31\xf0\x65\xf3\xdd\x6e\x65\xe4\xd1\x18\xe2\x6e\xfa\xe5\xf9\x28\xdc\x59\x97\x14\xd5\xba\x92\x8a\x22\xd1\x70\x59\x53\x98\xc6\xda\x9b\x36\x50\x77\xda\xb5\x85\x2e\x05\x45\xb6\xb1\x6b\xa6\x78\x53\x83\x21\x78\x3f\xb6\xc5\x87\x44\x02\x98\x7c\xd5\x34\x20\x38\x01\x7a\x20\x60\xe8\x1e\x27\x51\x46\x3c\xd6\x6f\x39\xfc\xf8\x86\x64\xa9\x28\xc2\xb3\x7e\xfb\x53\x34\x8e\xc6\xe6\x21\x53\xae\x61\x68\x36\x8f\x9f\xf6\x39\x6b\x25\x94\x6f\xbe\xc2\x67\x03\x6f\xfe\xfd\x7c\xad\xb6\x8e\x80\x6b\x34\xfa\xb2\x78\xac\x7e\xed\x32\x02\xd5\x5f\x3a\xd4\x8d\x91\xf0\x03\x00\x00")
+
+func registrationRegistrationserviceJsBytes() ([]byte, error) {
+	return bindataRead(
+		_registrationRegistrationserviceJs,
+		"registration/registrationService.js",
+	)
+}
+
+func registrationRegistrationserviceJs() (*asset, error) {
+	bytes, err := registrationRegistrationserviceJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "registration/registrationService.js", size: 1008, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _registrationRegistrationsmscontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x55\x4d\x8f\xdb\x20\x10\xbd\xe7\x57\xd0\x55\x24\xb0\x6a\x91\xec\x6a\x4f\x1b\xe5\xd4\x43\x7f\x40\xa5\x5e\xaa\x6a\x45\xcc\x38\x46\xc1\xc6\x02\x9c\x74\xb5\xf2\x7f\x2f\xe0\xf5\x57\x1c\x3b\xed\x72\xc2\x30\xf3\x66\xe6\xcd\x63\x4c\xd2\xaa\x48\xac\x50\x05\x22\x11\x7a\x5f\x21\xb7\x70\x65\x00\x19\xab\x45\x62\xf1\x2e\x9c\xb0\xe2\x58\x49\xa6\xc3\xde\x2f\x9a\x2b\x5e\x49\x20\x58\x58\xf3\xa6\x2a\x55\x48\x51\x00\xd5\x70\x14\xce\x8d\x79\x34\x1c\xf5\xc6\x89\x2a\xac\x56\x52\x82\x26\xd8\xe4\xe6\x5b\xf7\x89\x63\xf4\x0b\xaf\x33\x6b\x4b\xb7\xc3\x6b\x2b\x72\x50\x95\x0d\xfb\x8b\x28\xb8\xba\xb8\xed\xc8\xe1\x77\xb4\x5b\x05\xdc\x2e\xe9\xd1\x35\x09\x58\x31\x6a\x91\xdc\xae\xc1\x69\x2b\xf3\xeb\xcc\x34\x3a\xe7\x68\x8f\x6c\x26\xcc\xae\x3f\xce\xa9\xa9\x0e\xb9\xb0\xee\xa6\xd9\x8c\xef\x72\xe3\xca\x48\x85\xce\x43\x79\xce\xe8\xfd\xe3\x1b\xf8\x0b\x4a\x99\x34\x50\x7f\xe4\xe6\x57\x9b\x01\x49\x32\x48\x4e\x43\xcf\x18\x3d\x6e\xb7\xdb\xa8\x07\xef\x4a\x99\x98\x92\x61\xda\x01\xd5\x97\x47\x8f\x60\x09\x6e\xb8\x06\xbd\xe9\x13\x03\x8e\x23\x6a\x33\x28\xc8\xc8\x69\x4c\x57\x95\x24\x60\x0c\xd1\x60\x4a\x55\x18\xb8\x8e\xb0\x58\x71\xeb\x44\x39\xb3\x6c\x77\xd3\x4f\xa4\x88\x7c\x19\xd9\xd1\x2e\xbb\xb9\x58\xff\xc9\xd7\x70\xd5\x08\x1c\xf1\x0b\xb8\x4d\x27\xc9\x9c\xfb\xe4\xb4\x8e\xe7\xb9\x4b\x99\x90\xc0\xef\x52\xf7\x89\x52\xc6\x79\x0c\x0c\xea\xd5\x54\x26\x6d\x49\x57\xe1\xbd\xac\x3d\xdf\x5e\x99\x93\x00\xa1\x97\x1c\x5e\xba\xbe\x72\x18\xd9\xd4\xbb\xa9\xce\x26\x20\xb4\x54\xe6\xb6\xf2\x9a\x17\x1f\x87\xf8\xd1\xd4\x2f\x68\xb2\x1f\x33\xf7\x08\x74\xa0\x46\x49\xa0\x52\x1d\xc9\x48\x4a\x53\xe8\x90\x6c\xf3\xc4\x9d\x79\x12\xf2\xa0\x99\x86\xf4\x5a\xad\x6e\x36\x71\xa1\x21\xb1\x95\x96\x37\xf8\x8f\xd1\xbf\xa7\x67\x2e\xc2\x26\x59\x6f\x47\x8d\x65\xb6\x32\x4b\xea\x4e\x98\x13\xe9\xf3\xd3\xd3\xcb\xac\x85\x5f\xfe\xed\x8c\x93\x06\xad\x95\x46\xfb\xfd\x1e\x61\x51\x9c\x99\x14\xfc\xd5\x91\xfe\xea\xdb\x87\x97\xe2\x75\xd4\xb8\x06\x95\x40\xcb\x4c\x15\x30\x6c\x55\xaa\x74\xde\x9c\x16\x55\x7e\x00\x4d\xd7\x06\xec\x4f\x8f\x2f\xec\x1b\x79\x68\x63\x0d\x2c\x1e\xe2\x66\xc6\xcd\x3c\xa4\x8e\xc8\xc5\xdb\x83\x06\x76\x9a\x07\x68\x58\xda\x3e\x2e\xb3\xb4\xd9\xa0\x1f\x6e\x82\xf9\x56\xc1\x9f\xd2\xf5\x94\x53\xf4\x5d\xa1\x03\x4b\x4e\xc8\x2a\x34\xfc\x07\xa1\x92\x1d\x81\x2e\xa2\x4d\xc5\xc3\x4c\xe6\xc4\x83\xf1\x72\xa1\x77\x4a\xe1\x90\xb2\x4a\xda\xe5\x4a\xe6\x84\x8b\x37\xa1\xef\x18\x7d\x45\x57\x1a\xfb\x74\x4e\x37\x06\xdd\x74\xca\xd4\xab\x3a\x72\x93\xf2\x2f\xf7\xf3\x6e\x5a\x11\x08\x00\x00")
 
 func registrationRegistrationsmscontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -586,12 +698,12 @@ func registrationRegistrationsmscontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/registrationSmsController.js", size: 2052, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "registration/registrationSmsController.js", size: 2065, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationViewsRegistrationformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x58\x5f\x6f\xdb\x36\x10\x7f\xdf\xa7\xe0\x88\x62\x6e\x37\x38\x4e\xb2\x22\xd8\x32\x4b\x45\x1e\x56\xa0\x0f\xc5\x0a\x74\x1b\x30\xac\x7b\xa0\xa5\xb3\x45\x94\x22\x55\x92\xb2\xe3\x05\xf9\xee\x3b\xfe\x91\x2c\xdb\x52\x52\xb9\xcd\xf4\x90\x88\xe4\xfd\xbf\x1f\xef\x4e\x9e\x2f\x95\x2e\x89\x5c\x4d\x33\xa1\xd8\x47\x22\xd8\x56\xd5\x36\xa1\x99\x12\x75\x29\x29\x91\xac\x84\x84\x1a\xbe\x92\x75\xe5\x28\x69\xa4\x98\x32\x81\x7b\x48\x07\xd2\x82\xa6\x4e\x80\xa9\x17\x25\x47\xd6\x75\x79\xa6\x61\xc5\x0d\xee\x3f\x7f\x41\xd3\x6f\x08\x3e\xf3\x9c\xaf\x1d\x0d\x97\x48\x31\x41\x0a\xbb\x51\xaf\x6f\xde\x82\x2d\x54\x4e\x12\x42\x4d\x69\xe8\x24\x9d\xcf\x90\xac\xc3\x70\x68\x4c\x54\xbd\xb2\x53\x53\x26\x54\xab\x4d\x94\xde\x32\x2c\x05\xdc\x26\xf4\xe2\xfc\x9c\xfa\xd7\x86\xf2\x65\xb3\x56\xcb\xa5\x81\x56\xc0\xc5\x39\x3d\x54\xb1\x93\xe7\x65\x96\x39\x5a\x5c\xa1\xca\x4c\x49\xcb\xb8\x04\xbd\x4f\xe0\x89\x04\x5b\x80\x48\xff\x30\xa0\x5d\xb0\xe6\xb3\xb0\x3e\xa6\xf3\x92\x5c\x10\x4a\x95\x83\xf0\x71\x12\x6a\xc5\xd1\x2f\x54\x53\xb2\x5b\x01\x72\x65\x8b\x84\xfe\x88\x66\x95\x5c\x36\xcb\x4b\x4a\x34\x7c\xaa\xb9\x86\x3c\x66\x23\x72\xd9\x6d\x85\x0b\x0b\xb7\x96\x12\x56\x5b\xb5\x54\x59\x6d\x8e\xd4\xc6\xc7\x25\xb8\x60\x72\x05\x31\x3f\x18\x86\x3f\x31\x85\x39\xb3\x5c\xc9\xe7\x13\x2f\x72\xf2\x82\xf6\x98\x1d\x33\x57\x82\x31\x6c\x05\xa6\x0b\x86\x60\xff\xd9\x33\xd0\x5a\x69\xef\x86\xb3\x63\x5a\xf0\x1c\xd5\x2c\x99\x30\xd0\x23\xb0\x47\x68\x42\xf3\xba\x12\x3c\x63\x16\xea\x18\x46\x9a\xfe\x5e\x70\x43\x9a\x25\x29\x98\x21\x4c\x68\x60\xf9\x96\x2c\x00\x24\xb1\xec\x23\xc8\x0e\x60\xf6\xc4\x1f\x6f\xcf\x67\x7d\xb9\x3c\x39\xdb\xbf\x96\x8c\x8b\x51\xa9\x06\xc7\x71\x94\xca\xb8\x1b\x52\x19\x16\x4f\x6a\xf7\x3b\x66\xcc\x46\xe9\x7c\x94\xe9\x55\x64\x3a\xb2\x7e\x77\x10\x1c\xd8\xad\x3b\xf8\xbd\xf2\xc5\x61\x6f\x63\x24\xca\x1a\xb1\x0d\xd0\x3e\x13\x53\xad\x4a\xda\xba\x4d\x4c\xa1\x6a\x91\x93\x18\x28\xc2\x2c\x11\xc0\x8c\x25\x57\x04\x6f\x87\x66\x19\xd6\x2c\x33\x00\xaa\x5e\x1d\x5c\xae\xdd\x2d\x6a\x1d\xff\x2a\x9a\x9e\x1e\xbe\xad\x95\x48\xba\xe4\xba\xf4\x55\xe0\x24\x4c\xac\xdb\x22\x32\x88\x8e\x2e\x09\xca\xa8\x98\x45\xdf\xe5\x3e\xb2\x86\xca\xd6\x01\xae\x4e\x04\xce\xce\x82\x91\x10\x8a\xb6\xba\x62\x04\xa4\x91\x66\x48\xae\x88\x54\x96\x60\xd8\xb2\xe2\x4b\x73\x38\xc0\xf0\x3f\xb5\xb3\x96\x61\xbf\x99\x1e\xc0\xe5\x72\xfa\x1a\x01\xab\xb4\x6b\x33\x05\x36\x7c\x57\xa9\x31\x98\xa4\x0c\xfd\xfb\xee\x8e\x1c\x74\xf4\xfb\xfb\x61\x30\xa1\x11\x06\x04\x64\x36\xc2\xa4\xc3\x47\xf7\x31\xb6\x77\xd2\x82\xcb\xd8\xad\x08\xb7\x7b\xba\xe1\xb9\x2d\xae\x2f\x7e\x3a\xaf\x6e\x7f\x19\x84\x90\x7b\x1e\xe9\x7e\x1d\x45\xbd\x3d\xb0\xb1\x5b\x55\xde\x6d\x84\x53\xed\x86\x22\x9c\x59\x52\xfc\xe3\xf3\x1a\x8e\x3e\x97\xd5\x2a\x5b\xd1\xf4\x66\x17\x4d\x17\xdb\x2a\xb4\x40\x7f\x13\x1f\x90\xe8\x0f\x43\x04\xc7\xe0\x6b\x08\x00\x6e\x70\x2b\xd4\xe6\x30\xe0\x24\x49\x12\x32\x41\xef\x26\xc3\xb0\x78\x57\x28\x09\x44\xd6\xe5\x02\xf4\xa8\xe2\xe1\x22\xd7\x6d\x11\x2f\x7d\xe2\x9b\x0c\x3f\x60\x4a\x53\x59\x9c\xe2\xa0\x77\x30\xed\x6e\x12\xc9\x54\x59\x09\xb0\xc8\x81\x37\x65\xbf\xf8\x4c\x3e\x7c\xf8\xe1\xd5\xdf\xe7\xd3\x9f\xff\xf9\x7e\x42\x1f\xc3\x87\xd7\x37\x7e\x3a\xea\x98\x79\x6a\xdd\x79\x13\xfa\x0b\xa9\xf6\x42\x3d\xbe\x3f\x75\x02\x36\x46\xe6\xe8\x2a\xe6\x29\xc6\xa2\xcc\xdd\x86\x07\x60\x36\x54\x7d\x32\xc4\xd3\x28\xd8\x39\x3d\x8e\xe9\x70\xe0\xbe\xea\x99\x57\x1e\x06\x63\xb0\x78\x70\xd6\x0e\x75\xad\xd5\xd6\x0f\xc5\x27\x18\xc7\x1b\x95\x5f\x63\x22\x8f\xc8\x69\xbd\x48\x6f\x24\x89\x7b\x3e\xf2\x64\x83\x13\xf9\x8a\xaf\xbf\x7c\x08\x7f\x1a\xec\x7c\xd2\xde\xca\x35\x8e\x59\x18\x52\x9f\x56\x1f\x14\x14\xac\x35\xd6\x4e\xdc\x9c\x0a\x58\x3b\x68\xbc\xa5\xc4\xf0\x7f\xd1\xe7\x4b\xec\xb4\x83\x6d\x04\x73\xc3\x30\x7b\xb6\x72\x30\xbc\x9e\xcd\x9c\xe6\xd9\x1b\x6b\xfe\x52\xf5\x99\x92\x02\x4d\x7d\x65\x20\xd3\x60\x93\xbb\xbb\xeb\xeb\x08\xb7\xb0\x73\x7f\xff\x1d\x37\xa6\x06\x9d\x44\xfa\xdf\x3c\x7d\x9f\xd9\xb3\x60\x77\x7f\xdf\xb4\x4a\x09\xcb\xab\xf4\x7d\xc6\xf0\x03\xc8\x7d\x1f\xf1\x12\x93\x45\x36\xdc\x16\xb8\x06\x32\x74\x55\xb0\xb7\x10\xfc\x87\xad\x5e\x87\x6b\xef\x33\xd1\x88\x3b\x6d\x4a\x19\xfa\x58\x77\x93\xc4\xc0\x8f\x04\x99\xc0\x11\xaa\x41\xec\xc2\x76\xe7\x93\xb9\xa9\xd0\x27\x37\xd5\xa4\xf3\x99\x7b\xef\x1c\xa1\x41\x8b\xda\x5a\x74\x20\x8c\x83\xe1\x87\x86\x56\x1c\x1e\x6b\xc6\x0d\x8e\x06\xf8\x56\x69\x0c\x89\xde\xfa\x1b\x96\x73\xc3\x16\xc2\xdd\xe1\x6f\x3b\x97\xe4\x99\xc7\x30\x4d\xdf\xe3\x16\xa9\x2b\xef\x6f\x10\xff\xa8\x35\xd1\xe5\xf9\xcc\x09\x4a\xff\x0b\x00\x00\xff\xff\x18\x86\xf2\xd5\x3a\x11\x00\x00")
+var _registrationViewsRegistrationformHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x19\x6d\x8f\xd3\x36\xf8\xfb\x7e\xc5\x33\x0b\xed\x60\x5c\xae\xe5\x40\x68\xbb\x5d\x8a\x6e\xd2\x90\xf8\x80\x40\x82\x4d\xda\x80\x9d\xdc\xc4\x6d\x2c\x1c\x3b\xd8\x4e\x7b\xe5\x74\xff\x7d\x8f\xed\x24\x4d\x8f\x6b\x93\xbe\x80\x46\x25\xb8\xd8\x7e\xf2\xbc\xbf\x3a\xe7\x13\xa5\x73\x90\xd3\x28\x11\x8a\x7e\x04\x41\x17\xaa\xb4\x31\x49\x94\x28\x73\x49\x40\xd2\x9c\xc5\xc4\xf0\xa9\x2c\x0b\x07\x49\x2a\x88\x88\x0a\xdc\x43\x38\x26\x2d\xd3\xc4\x21\x30\xe5\x38\xe7\xf8\xea\x2c\x3f\xd1\x6c\xca\x0d\xee\xdf\x7f\x40\x46\x3f\x00\xfe\xce\x53\x3e\x6b\x70\x6b\x35\xaf\xb6\x9b\xa3\x89\x60\x57\xa3\xf3\x01\x3e\xde\x71\x10\x93\x47\xc3\x21\xf1\x8f\xd1\xd4\x46\x26\x8f\xc9\x93\x61\x0b\x83\x07\xce\xd3\xc8\xd2\xb1\x01\xfc\xeb\x59\xf3\xab\x98\x8c\x95\xb5\x0a\xb9\xc6\xed\x74\x81\xc2\xf0\x24\xca\x18\x9f\x66\x76\xf5\xf5\x16\x0a\x64\x73\xcc\x44\x4c\x7e\xa7\x86\x27\xc0\xe5\x44\x91\x2f\x61\xbf\x90\xa9\xd2\xd7\xdd\x90\x35\x76\x2e\x0b\xd4\x5c\xa2\xa4\xa5\x5c\x32\xbd\x1e\xd8\xbf\xe0\xf9\x00\x54\x7a\x4c\x84\x9a\x72\x44\xfe\xa7\x61\xda\x19\xe4\x7c\xe0\xcf\x3a\xde\xf7\xd4\x9c\x61\x72\x95\x3a\x81\xd0\x2e\x01\x8f\x53\x46\x4e\xaf\x04\x93\x53\x9b\xc5\xe4\x31\xea\x36\xe7\xb2\x5e\x9e\x12\xd0\xec\x53\xc9\x35\x4b\x2b\xeb\x57\x6f\xd9\x45\x81\x0b\xcb\xae\x2c\xd9\x48\xb8\xfa\x21\xe1\x82\x5a\x74\x02\xf4\x92\xc1\xbf\xef\x68\xf4\xf9\x22\xfa\x67\x18\xfd\x0a\xef\xa3\xcb\x0f\x0f\xef\x0d\xbc\xcf\xb4\xe8\x3e\x26\x40\x4b\xab\x26\x2a\x29\x4d\x4f\xfc\x49\x46\xe5\x94\x79\xc9\x66\x68\xf4\x94\x5a\x56\xab\x08\x3d\x0f\x78\xda\x68\x6e\xb3\xa6\x9c\x21\x1d\x33\xcc\x18\x3a\x65\xa6\xed\xef\x41\x65\x27\xf7\x98\xd6\x4a\x7b\xcd\x39\x26\xa3\x8c\xa7\x48\x77\x42\x85\x61\x1d\xc8\xef\x20\x10\x93\xb4\x2c\x04\x4f\x90\xdd\xcb\xb2\xe2\x97\x8c\xde\x66\xdc\x40\xbd\x84\x8c\x1a\xa0\x42\x33\x9a\x2e\x60\xcc\x98\x04\x4b\x3f\x32\x79\x2b\x42\x7a\xd3\xe3\xd2\xeb\xa7\xa1\x76\xe9\x44\xa3\x96\x8c\x5e\x84\x83\x86\xee\x09\xbc\x92\x62\x01\xa8\x58\x4d\x13\xb4\x1d\x32\x11\x7d\x3e\x06\x34\xdb\x71\x1f\x9b\x40\x4a\x4d\xc6\xcc\x31\x94\x32\xc5\x97\x13\xa5\x19\x62\x90\x29\x98\x82\x26\x0c\xa8\xc6\x7f\x42\xa8\x39\x4b\xbb\x65\xd8\x51\xd4\xca\xe7\xb6\x12\xad\x66\xbb\x97\x88\xdf\x44\xb4\x0e\x90\xf3\xc1\x36\xd9\x64\xaf\xd4\xc3\x72\xca\x05\x19\xfd\xe1\xfe\xec\x9c\x77\x02\x92\xdb\x79\xa5\xda\x0d\x79\xa5\x5a\xb8\x98\xad\x48\xee\x1e\xb3\x1e\xc1\xa1\x63\xb6\xe2\xaa\x76\x2b\xbf\x04\x9a\xa6\xe8\x07\xe6\xbb\xb2\x68\x41\x8d\x99\x2b\x9d\x92\xd1\xeb\xea\x69\x67\xbb\x36\xa8\x6e\x9b\x76\x79\x10\xac\xbb\x5c\xb7\x32\xfe\xd3\xbe\x75\x64\xe5\x1d\xef\x22\x4b\x7c\xab\x65\x00\x8d\xc1\xec\x5f\xa1\x16\x70\x25\xef\x1f\xd5\x80\x47\x0f\xf6\x71\xa8\x1a\x4b\xed\x53\x3b\xb8\x4f\x23\xc3\x52\xed\x60\x32\x55\x8a\x14\x2a\x1b\x02\xb5\x20\x18\x35\x16\x9e\xb6\xb2\xd4\xee\x79\x30\x10\xb9\xb4\x4a\x5d\x22\x21\x6d\x5d\x89\x61\x50\xef\x03\x56\x1b\x3c\x02\x7f\xb4\x37\x91\x4a\x04\xb3\x52\xcf\x5a\xc4\x12\x2a\x41\x2a\xdb\x88\x8a\x4d\x93\x6e\x32\xf3\xe1\xa8\x57\x31\xda\x4d\x7a\xdb\xe8\xdd\x4c\x5f\x33\x14\x05\xe3\xe9\x6f\x55\x3a\xa1\x52\xb0\xae\x96\x37\x1c\x68\xe6\xba\x64\xb1\x38\x46\xb3\x2a\x65\xb0\x50\x20\x3f\x19\xd3\xa0\xe4\xfe\xb2\x8f\xb1\x47\x48\x32\x47\xfd\xed\x0a\x51\x5a\x14\x8c\xba\x80\x74\x8e\x85\x05\xce\x52\x08\xa0\x3b\x72\xf1\xff\xcb\x5f\xb3\x26\xcc\x5b\x21\x85\xf8\x26\xdc\xf5\x36\xb8\xbd\x77\x5a\x6b\x51\x58\x97\xe0\x5a\x20\xdb\x36\xc5\x2b\xf9\xb3\x9d\xd4\xda\x64\x6f\xa5\xcf\x03\xe4\xb0\x25\xf6\x3d\xb2\x59\xd3\x65\xb5\x43\xcd\x40\xaa\x7c\xa8\xa1\xfa\x93\xec\x5b\xfa\xd4\x1a\x44\x1e\x01\x8e\x74\x9d\xb3\xde\x29\x3c\xc7\x6c\xab\xb4\x9b\x40\x32\x8c\x55\xd7\x9b\x7b\xf5\xa3\xcc\x29\x37\x74\x2c\x18\xda\xe7\x47\xb4\xd8\xd8\x4d\x85\x2f\x70\x28\xf4\x45\xe6\xfe\xba\xaa\xf2\x4d\x66\xc3\xd1\x69\x74\x27\xdb\x90\x33\x9b\xa9\x14\xae\xaf\x01\x39\xb6\x73\xf5\xfc\xe2\x65\xd8\xb9\xb9\xe9\x17\x13\xc8\x8f\x61\x82\x25\xb6\xf2\xf6\x16\x0e\xb2\x1a\x2a\x2b\x27\x4d\x8c\x18\xbb\x10\xa1\xe6\x45\x73\x9e\xda\xec\xec\xd1\x2f\xc3\xe2\xea\xb7\x5e\x21\xe2\x7e\x1d\x45\xbd\x45\xb4\xb3\xae\xd7\xf2\xa8\xc2\xab\x06\xbd\xbf\x74\x17\x1a\xb9\x21\x23\xfc\xcf\x7b\x48\x38\xda\x05\x8d\x55\xb6\x20\xa3\x8b\xa5\xf6\x9d\x2d\x8a\x30\xdb\xf9\x04\xd4\x13\xbb\x07\x0c\x1a\xff\x8a\x29\xd6\x5f\xd2\x64\x6a\x7e\xdb\x70\x10\xc7\x31\x1c\xa1\x36\x8e\xba\xf2\x4b\x2b\x0b\x7b\x0d\xbe\xce\xb0\x78\x80\x2c\xf3\x31\xd3\x3b\x67\x5b\x87\xa9\xdd\x16\x3e\xf1\x2e\x56\xfb\xd2\x06\x66\x7b\x25\xdc\x90\xad\x1d\x9f\x81\xcd\x70\xcb\x90\xa8\xbc\x10\xcc\xe2\x91\x9a\x4c\xc8\x4a\x5a\x3e\x7a\xff\xfe\xe1\xb3\x77\x38\x16\x7e\xf8\xb9\x27\x89\x8e\x0e\xd4\xd1\x3e\xaa\xae\x23\xbc\xd6\xf6\xc8\xe1\x4b\x39\x0e\x91\xbc\xeb\x59\xa6\x58\x31\xe3\x7e\x57\x0c\x6d\x55\xef\x4a\xe0\x6b\xf7\x1a\x9b\x03\xc1\x05\xf5\x36\x91\xe0\xe0\x13\xf4\x66\xb2\x36\x1f\xbb\xd3\x9d\xc3\xa3\x41\x7f\xeb\xda\xee\xe9\x6e\xb3\xd4\xe6\xb8\x0a\xb2\x7b\x57\x6d\xe8\xf6\x0f\xb3\x25\xab\x77\xc7\xd8\xa6\x30\xf1\xf7\x6c\xfb\x4d\x69\x35\xf9\x43\x4f\xfe\xb5\x6b\x2f\x0d\x7d\x21\xa1\xda\xf4\xb6\x85\x39\x35\x30\xe5\xb3\x5e\x17\x74\xdf\x81\x73\x7f\xd2\x5e\xaa\x19\x8e\x9f\x68\x1b\xef\x6a\x5e\xa3\x48\x44\xe3\x30\xe3\x0c\x16\x09\x36\x73\x0e\xfa\x92\x80\xe1\x9f\x51\x49\xa7\xc3\x61\xbf\xf2\xee\x46\x11\x74\x09\x5b\xb8\x20\x39\x1b\x0c\x1c\x47\x83\x17\xd6\xe0\xf0\x74\xa2\xa4\x40\x11\x9e\x19\x96\x68\x66\xe3\xeb\xeb\xb3\xb3\x2a\x00\xc2\xce\xcd\xcd\x4f\xdc\x98\x92\xe9\xb8\x82\x7f\xe5\xe1\xbb\xc4\x19\x04\x79\xba\x7b\x1e\x9c\x87\x85\xe5\xc5\xe8\x8d\x9b\x19\xfd\x0c\xc7\x73\xf4\x00\x98\x73\x9b\xe1\x9a\xc1\xba\x00\xc7\x9a\x8f\x63\x54\x18\x2d\x7d\xae\x0b\x9d\x67\x85\xee\x50\xb6\x5e\xff\x0d\x65\x2d\xf8\x5d\xdf\x55\xd6\x49\x3f\x2e\xad\x45\x21\xc2\xc4\x11\x3e\xe6\x10\x48\x04\x36\xf7\xd8\xc9\xa5\x91\xa6\xdc\x8d\xb6\xf8\x54\x68\x54\x8b\x5e\x6c\xd3\xcd\xb5\x9a\xe8\x56\xc0\xde\xf3\x21\x44\x46\x6f\x70\x0b\xca\xa2\xbb\x45\x0a\x2c\x1e\x48\xee\x0d\xc7\x5b\xcd\x13\xf5\xae\x69\x7d\xba\x5a\xf3\x25\xab\xcd\x52\xf5\x78\x3e\x70\xaa\x18\xfd\x07\x40\x1c\x66\xb4\x87\x1b\x00\x00")
 
 func registrationViewsRegistrationformHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -606,12 +718,12 @@ func registrationViewsRegistrationformHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/views/registrationform.html", size: 4410, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "registration/views/registrationform.html", size: 7047, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationViewsRegistrationresendsmsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x53\x4d\x8f\xd3\x30\x10\xbd\xf3\x2b\x8c\xb5\xd2\xf2\xa1\x6c\x8b\x04\x07\xa4\x24\x88\x23\x37\x24\x24\x2e\x2c\x87\x49\x32\x49\x2d\xec\xb1\xf1\x47\x77\xfb\xef\xd7\x76\xba\x55\xd2\xba\xcd\x69\xec\x79\xf3\x3c\xf3\xe6\xa5\x1e\xb5\x55\x8c\xa6\xaa\x97\x1a\xfe\x31\x09\x07\x1d\x7c\xc3\x7b\x2d\x83\x22\xce\x08\x14\x36\xdc\xec\x34\x61\xaf\x69\x14\x56\x81\x17\x31\x88\x45\x3c\x55\xb9\xd0\x29\x11\xf1\x7b\xf5\x30\x87\xef\xde\xf3\xf6\x0d\x8b\x5f\x3d\x88\x3d\x1b\x25\x3e\x9f\x38\xad\x7e\x3a\xe6\x56\xf9\xb6\xde\xc4\xf0\x2c\x71\xde\x47\x02\x56\x93\xaf\x9e\x5d\xc3\xbf\x6c\x8f\xe7\x74\xf8\xb4\xdd\x2e\x48\x73\xbd\x1a\x2a\x41\x26\xf8\x2a\x76\xec\x41\x10\xda\x35\x20\x83\x24\x74\x28\xdb\x9f\x69\x30\x46\x41\x75\x68\xeb\xcd\x7c\x77\x89\xcd\x6c\x69\x5a\xa5\x07\x94\x79\xd8\xac\xc8\x5c\xc7\x99\x12\x24\x91\x26\xbf\x6b\xf8\xe7\xac\x8a\xc5\xff\x41\x58\x1c\x96\xf2\xbd\x82\x21\x78\xdd\x6b\x65\x24\xfa\x98\xd2\xe3\xc8\x2f\x1e\x3c\x7e\x91\xc8\x80\xf7\x68\xa9\xe1\xf7\x8f\x8f\x1f\xbf\xfd\xd9\x56\x5f\xff\x7e\xb8\xcf\x4f\xf4\x3b\xa0\x09\x73\x2f\x16\x1d\xfa\xdf\x20\xc5\x90\x77\x73\xda\xc0\x6a\x86\x24\x6a\x9a\x00\x9d\x83\x09\xdd\x95\x9d\x2e\xe7\x7a\xb8\x43\x6b\xb5\x2d\x90\x15\x08\x23\xdf\xdc\x2a\x6f\x7f\xd0\x3e\xf5\xc2\xcc\x4a\xdb\xd5\x8a\x6f\x12\x89\xb9\x7e\x29\x5a\xfb\x9d\x98\x28\xd0\xb2\x27\x70\x6c\x12\x7b\xa4\x2b\x0f\x14\xae\xeb\xcd\x2d\x7f\x94\xbc\x78\x6e\xd2\x65\xb8\xf4\xea\x99\xbf\x9d\x01\x7a\xad\x4d\xf1\x6d\x87\x5f\x7a\xb8\x0b\xde\x6b\x62\xfe\x60\xa2\x28\xf3\xcf\xc5\x59\x2f\xc1\xc5\xe5\xc5\xb4\x05\xe1\xa2\xc3\x62\x64\xac\x50\x60\x0f\xd9\x16\x83\x70\xd0\x49\x1c\x1a\xfe\xb6\xbc\xe0\xbb\xac\x62\x61\xa9\xbf\x90\x06\xb6\x84\x33\xa7\xdc\xa5\x72\x73\x57\xd7\x05\x2b\x4f\x7d\x44\xd5\x9b\xd4\x43\xfb\x12\x00\x00\xff\xff\xf4\x2d\x66\x4c\x74\x04\x00\x00")
+var _registrationViewsRegistrationresendsmsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7d\x53\x4d\x4f\xc3\x30\x0c\xbd\xf3\x2b\x42\x84\xc4\x97\xca\x86\x04\x07\xa4\xb6\x88\x23\x37\x24\x24\x2e\x0c\x21\xb7\x75\xbb\x88\xc4\x29\x49\x3a\xb6\x7f\x4f\x92\x0e\xd4\x6e\xdd\x7a\x72\xe2\xe7\x17\xfb\xf9\x35\xad\xb5\x51\x8c\x9a\xa4\x94\x1a\xbe\x98\x84\x8d\xee\x5c\xc6\x4b\x2d\x3b\x45\x9c\x11\x28\xcc\x78\xbb\xd4\x84\xa5\xa6\x5a\x18\x05\x4e\xf8\xc0\x17\xf1\x50\x65\xbb\x42\x09\x8f\x5f\xa9\x9b\x3e\xbc\xb8\xe4\xf9\x09\xf3\x5f\x5a\x89\x15\xab\x25\xae\xff\x39\x8d\xfe\xd9\xe6\x46\xf9\x3c\x9d\xf9\x70\x27\xb1\xdb\x47\x00\x26\x8d\x4b\xd6\x36\xe3\xf7\xf3\xed\x39\x1c\x6e\xe7\xf3\x01\x69\xac\x57\x55\x22\xa8\xed\x5c\xe2\x3b\x76\x20\x08\xcd\x18\x10\x41\x12\x0a\x94\xf9\x4b\x18\x8c\x51\xa7\x0a\x34\xe9\xac\xbf\xdb\xc7\x46\xb6\x30\xad\xd2\x15\xca\x38\x6c\x54\xa4\xaf\xe3\x4c\x09\x92\x48\x8d\x5b\x66\xfc\x2e\xaa\x62\xf0\xbb\x13\x06\xab\xa1\x7c\x7f\x60\xe8\x9c\x2e\xb5\x6a\x25\x3a\x9f\xd2\x75\xcd\xf7\x1e\xdc\x7e\x9e\xa8\x05\xe7\xd0\x50\xc6\xcf\x17\x8b\xeb\xc7\xf7\x79\xf2\xf0\x71\x75\x1e\x9f\x28\x97\x40\x0d\xc6\x5e\x0c\x5a\x74\x6f\x20\x45\x15\x77\xf3\xbf\x81\xd1\x0c\x41\xd4\x30\x01\x5a\x0b\x0d\xda\x03\x3b\x1d\xce\x75\x73\x86\xc6\x68\x33\x41\x36\x41\xe8\xf9\xfa\x56\x79\xfe\x4c\xab\xd0\x0b\x6b\x47\xda\x8e\x56\x7c\x94\x48\xf4\xf5\x9f\x43\xd5\xf2\x27\x62\x62\x82\x97\xfd\x80\x65\x8d\x58\x21\x1d\x78\x61\xe2\x3a\x9d\x1d\x33\xc8\x94\x19\x77\x5d\x3a\x0c\x87\x66\xdd\x31\xb8\x6d\x81\xfe\x6a\x43\x7c\xdc\xe2\xfb\x26\x2e\x3a\xe7\x34\x31\xb7\x69\xbd\x2a\xfd\xdf\xc5\x59\x29\xc1\xfa\xed\xf9\xb4\x01\x61\xbd\xc5\x7c\xd4\x1a\xa1\xc0\x6c\xa2\x2f\x2a\x61\xa1\x90\x58\x65\xfc\x74\x7a\xc3\x67\x51\xc5\x89\xad\xbe\x22\x55\x6c\x08\x67\x56\xd9\x7d\xe5\xfa\xae\x0e\x0b\x36\x3d\xf5\x16\x95\xce\x42\x0f\xf9\x2f\x39\xa7\x0a\xde\x75\x04\x00\x00")
 
 func registrationViewsRegistrationresendsmsHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -626,7 +738,7 @@ func registrationViewsRegistrationresendsmsHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration/views/registrationresendsms.html", size: 1140, mode: os.FileMode(436), modTime: time.Unix(1464872355, 0)}
+	info := bindataFileInfo{name: "registration/views/registrationresendsms.html", size: 1141, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -651,7 +763,7 @@ func registrationViewsRegistrationsmsformHtml() (*asset, error) {
 	return a, nil
 }
 
-var _sharedConfigserviceJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x91\xc1\x6a\x03\x21\x10\x86\xef\xfb\x14\x16\x0a\x2a\x14\x7b\xaf\xf4\xd4\x47\xe8\xb1\xe4\x60\xcd\xec\x46\x6a\x35\xe8\x18\x28\xc5\x77\xaf\xba\x66\x9b\xdd\x84\xfc\xa7\xd9\x99\x6f\xe6\xff\x71\xd9\x98\x9c\x46\xe3\x1d\x61\x9c\xfc\x0e\xa4\x88\xa6\x08\x24\x62\x30\x1a\xa9\x6c\x1d\xe5\xa6\x64\x55\x10\xdf\x7e\x9f\x2c\x30\x6a\x30\xfe\xf8\xe4\x9d\x35\x0e\x84\xf6\x6e\x34\x13\x7d\x22\x1f\x3b\xde\xe8\x2a\x11\x21\x9c\x8c\x2e\xec\x3c\x7e\x9f\x3f\x2b\x45\x1f\x0f\x88\xc7\x52\xad\x26\x3b\x2e\x87\xb6\xbd\xe4\x59\x8d\x59\x5b\x3a\x27\xac\x3a\xa9\xd0\x11\xb9\xf4\x02\x60\x0a\xee\x02\xaa\x9a\x00\xdf\x1a\xf7\xf2\x5f\x2e\x40\xee\xae\x2b\xe7\x05\x63\x5a\x59\xfb\xa9\xf4\x17\xdf\xdc\x34\x23\x61\x0f\xb3\xfb\x76\x54\xd5\xc2\x8a\x72\x86\xd1\xe7\xfe\x3c\x5c\xe0\x01\xdc\xc5\x6b\x07\x88\x47\xef\x22\xdc\xda\xaf\x9a\xf7\xc8\x2b\x39\x83\x62\xaf\x50\xc9\xdb\x6c\x8f\xc9\x7a\xa4\x6b\x2a\x6f\x7a\x99\x80\x2d\x7f\xf9\xda\xfa\xfe\xa9\x3c\xac\xab\x3c\x64\xce\xb8\xfc\x0b\x00\x00\xff\xff\xb8\xd8\x11\x0e\x45\x02\x00\x00")
+var _sharedConfigserviceJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7d\x91\xc1\x6a\xc4\x20\x10\x86\xef\x79\x0a\x0b\x05\x15\x8a\xbd\x57\x7a\xea\x23\xf4\x58\x7a\x70\xcd\x24\x91\x5a\x5d\x74\x5c\x28\x8b\xef\x5e\x35\xd9\x6c\x93\x5d\xfa\x9f\x26\x33\xdf\xcc\xff\x63\xd8\x90\x9c\x46\xe3\x1d\x61\x9c\x9c\x3b\x52\x44\x53\x04\x12\x31\x18\x8d\x54\xb6\x8e\x72\x63\xb2\x2a\x88\x6f\xdf\x27\x0b\x8c\x1a\x8c\x3f\x3e\x79\x67\x8d\x03\x11\x27\x15\xa0\xa7\xbc\x91\x55\x22\x42\x38\x19\x5d\x38\xed\xdd\x60\xc6\xf7\xf9\x93\x3e\x91\x0f\xfa\x38\x21\x1e\x4b\xb5\x99\x7c\x72\xd9\xb5\xed\x35\xcb\x66\xcc\xda\xd2\x25\x5d\xd5\x49\x85\x05\x91\x6b\x2f\x00\xa6\xe0\xfe\x40\x55\x23\xe0\x5b\xe3\x5e\xae\xe5\x0a\xe4\xc5\x75\xe3\xbc\x62\x4c\x2b\x6b\x0f\x4a\x7f\xf1\xdd\x4d\x33\x10\xf6\x30\xbb\xef\x47\x55\x2d\xac\x28\x67\x18\x7d\x9e\x29\xca\x05\x4e\xe0\xd8\xf5\xa5\x03\xc4\xa3\x77\x11\xee\xed\x57\xcd\x7b\xe4\x95\x5c\x40\xd1\x2b\x54\xf2\x3e\xbb\xc4\x64\x4b\xa4\x5b\x2a\xef\x7a\x99\x80\x2d\x7f\xf8\xd6\xfa\xff\x53\xb9\xdb\x56\xb9\xcb\x9c\x71\xf9\x0b\xc7\xe6\xfe\x34\x41\x02\x00\x00")
 
 func sharedConfigserviceJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -666,12 +778,52 @@ func sharedConfigserviceJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "shared/configService.js", size: 581, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "shared/configService.js", size: 577, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sharedDirectivesFooterHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x51\xd1\x4a\xc4\x30\x10\x7c\xf7\x2b\x42\xde\xaf\x01\x7d\x10\xa4\x15\x04\xe1\xf0\x4d\xf0\x03\x24\x4d\xf6\xda\xa5\x6d\xb6\x24\x1b\xd1\xbf\x37\xbd\x8b\x50\x73\x55\x51\x30\x10\xb2\x61\x66\x67\x67\xd8\xda\xe2\x8b\x40\xdb\xc8\x30\xa2\x85\x6b\x29\xcc\xa8\x43\x68\xe4\x81\x88\xc1\xcb\xdb\x0b\x91\x4e\xbd\x90\x32\x60\xc8\x31\x38\xce\x48\x89\xb6\x91\x99\xdc\xce\x24\x06\x78\xc1\xf0\xca\xb9\x5e\xf1\xcb\x1e\x4b\xa6\x40\x8f\x0c\x2d\x58\xfb\x0e\xb8\x91\xcf\xed\xa8\xdd\x20\x45\xef\xe1\xd0\xc8\x9e\x79\x0e\x37\x4a\x75\xd8\x55\x1d\x72\x4b\x34\x84\x0a\x49\x21\x87\x37\x8a\xe4\x46\x74\xa0\xb2\x4b\x95\xb4\x83\x42\xc7\x9e\x6c\x34\x8c\xe4\xaa\x9e\xa7\x71\x63\xdc\x72\xee\xc9\xc4\x29\x75\xe9\x85\x78\x6e\x48\xe9\x22\x83\x4a\x21\xbe\x8d\x25\xd2\xbd\xfc\x4d\x36\xa5\x67\xb4\x6b\x17\x5f\x38\xbd\x7b\x7c\xf8\x1f\xb7\x57\x7f\xdb\x04\xf7\xb1\xad\x0c\x4d\x9f\x96\x70\x26\x55\x87\x59\xbb\x8f\x81\xa7\xa6\x5d\x22\x0e\x1b\x33\x9f\x28\x7a\x03\xc2\x90\x05\x41\x4e\xec\x8f\xe4\x52\x4e\x2d\x7a\x1b\x7e\x7f\x88\xbe\xfa\xe6\xf2\xf4\xbc\x03\x47\x8e\x3b\xf3\x0b\x03\x00\x00")
+
+func sharedDirectivesFooterHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_sharedDirectivesFooterHtml,
+		"shared/directives/footer.html",
+	)
+}
+
+func sharedDirectivesFooterHtml() (*asset, error) {
+	bytes, err := sharedDirectivesFooterHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "shared/directives/footer.html", size: 779, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _sharedDirectivesHeaderHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x55\x4d\x8f\xd3\x30\x10\xbd\xf3\x2b\xa6\x66\xb5\x82\x83\x1b\x24\xc4\x2d\xc9\x85\x0b\x1c\x60\x25\x38\x71\x42\x6e\x32\x49\xad\x4d\x3c\xc1\x76\x1a\xc1\xaf\x67\x92\x38\xcd\x36\x69\x97\x5d\xf6\xd0\x5e\x6a\xcd\xe7\x7b\xe3\xe7\x49\xbc\x47\x95\xa3\x05\x9d\x27\x62\x3c\x8a\xf4\x15\xf0\x2f\xce\xf5\x01\xb2\x4a\x39\x97\x88\x8c\x8c\x47\xe3\x83\xe7\xe8\xed\x73\x2a\x2a\x49\xa4\xb1\x82\xbd\xc5\x22\x11\xaf\x23\x91\xc2\x67\xef\x7e\x50\xbb\xbd\x33\x95\x36\x08\x71\xa4\xd2\x38\xe2\xf8\x45\x76\xa8\xed\x74\x69\x64\x67\x55\x23\xc0\x94\xd2\xed\xa9\x4b\xc4\x8d\x25\xf2\xdb\xd6\x1d\xc1\x1c\x13\x37\x52\xce\xbd\x0c\x79\x5d\xe8\x4c\x79\x4d\xc6\x31\x08\x3d\xd5\x2c\x14\x14\x4a\xee\xb0\xaa\xd8\x1a\xe9\x54\xca\x33\x65\xea\x5c\x7a\xa2\xca\xeb\xa6\x6f\x9c\x55\xa4\xee\x53\x60\xdc\xb0\x57\x07\x04\x43\x40\x0d\x1a\xb0\xf8\xab\x45\xe7\x5d\x1c\xcd\xf1\xe7\xeb\x31\xcd\x95\x9d\x73\x6a\x34\x2d\xf0\x3f\x15\x85\x43\x9f\x08\xf9\x01\xde\xbf\x5b\xd0\x1a\x62\xd5\x08\x43\x67\xf7\x3c\x80\x3a\xbf\xe3\xee\x5f\x38\xf7\xcd\x0d\x1e\x78\xf6\x6f\xd7\xfc\x9a\xaa\x75\x23\xbf\xa5\x27\x53\x16\xbd\xcc\xa9\x33\xa3\x7f\xd5\x6c\x02\xb7\x1a\xc0\x47\x8b\xca\x33\x7b\xec\xb6\xdb\xed\x09\xe7\x35\x60\x26\xbc\x36\x06\xc6\x32\x48\x66\xae\x7c\x11\xc3\x10\xae\x3d\xd6\xe7\x43\xa6\xb0\x5d\xeb\x3d\x99\xa3\xcc\xc8\x96\xca\xe8\x3f\xc3\xdd\x47\x0c\x57\xa4\x5f\xb1\x83\x87\xd6\x01\xfd\x98\x75\xa1\x79\xf4\xef\xee\xff\x0f\x30\xa3\xba\x51\xe6\xf7\x8c\x2d\x18\x5e\x0c\x6b\xf6\x87\x19\x2f\x34\x37\xb9\x9f\x2a\xc5\x47\xae\xe8\xf9\x9a\x1c\xdf\xec\xa0\x49\xc7\x6c\xfb\xf4\x9d\x36\xf9\xe9\x93\x8e\xa3\xde\xc7\xb9\x17\xe7\xf9\xa8\x9a\xaf\x2b\x3c\x91\x7e\xa2\x1a\xaf\x2a\xae\x7e\xe7\xb6\xbc\x8d\xbf\xf3\xea\xe4\xc3\x15\x14\xf5\xcc\x7d\xbe\x99\x6f\x1f\x6e\x6f\x61\x03\xe3\x97\xe6\x27\x13\xd1\x66\xb9\xe2\xa7\xf5\x6e\xb1\xd4\xce\x0f\x7a\x39\x23\xb2\x07\xdb\xef\x5b\x08\x3c\xd1\xc5\xcb\x10\x3e\x05\x5f\x70\x2e\xc1\x0d\xd5\x75\x90\x6a\x7f\x43\xa0\xcd\x25\x64\xe1\x18\x47\x63\xbf\xf4\x6f\x00\x00\x00\xff\xff\x77\x94\x44\x34\x8d\x07\x00\x00")
+var _sharedDirectivesFooterJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x8f\x31\x0b\x83\x30\x10\x85\x77\x7f\x45\xb6\x18\x10\xdd\x75\x6e\xd7\x4e\x1d\x4a\xe9\x10\xe2\x59\x03\x31\x27\x97\x4b\xa1\x14\xff\x7b\xad\x41\xa1\xb5\x6f\xbb\xfb\x1e\xef\xde\xe5\x5d\xf4\x86\x2d\x7a\x91\x2b\xf1\xca\xc4\x2c\x19\x03\x88\xc0\x64\x0d\xcb\x66\xd9\x68\x7f\x8f\x4e\x53\x39\x60\x1b\x1d\xe4\xd2\x72\x78\x62\x44\xef\xac\x87\xb2\x43\x64\x20\x59\x88\xeb\x4d\x2d\xee\x8f\xca\xd6\x12\xcc\xb9\x8f\xe4\xbe\x60\x3c\x2d\xee\xe3\x6a\xde\x9f\x5d\x45\xc0\x91\xfc\xcf\x32\x81\x54\xaa\x16\xf2\x20\x8b\x3f\x78\x74\xda\x40\x2d\x98\x22\xec\x31\xc3\x30\x73\x86\x33\xb9\x39\xc0\xe0\x30\xa2\x07\xcf\xa1\x0a\xbd\x26\x68\xab\xad\x70\xa8\xd2\x47\x65\xcf\x83\x93\x5f\x39\x53\xb3\x8d\x93\x6a\xb2\x49\xe5\xaa\x79\x03\x10\x09\x6e\xac\x41\x01\x00\x00")
+
+func sharedDirectivesFooterJsBytes() ([]byte, error) {
+	return bindataRead(
+		_sharedDirectivesFooterJs,
+		"shared/directives/footer.js",
+	)
+}
+
+func sharedDirectivesFooterJs() (*asset, error) {
+	bytes, err := sharedDirectivesFooterJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "shared/directives/footer.js", size: 321, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sharedDirectivesHeaderHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x55\x4b\x6f\x9c\x30\x10\xbe\xe7\x57\x4c\xdc\x28\x24\x52\x59\x52\x55\xbd\x54\x40\x0f\xbd\xb4\x87\x36\x52\xda\x4b\x4f\x91\x17\x86\xc5\x0a\x78\xa8\x6d\x76\xd5\x44\xfb\xdf\x3b\x3c\xf6\x45\x60\x93\x28\x52\xcb\x05\x98\xe7\x37\xf3\xcd\xd8\x61\x8e\x32\x45\x03\x2a\x8d\x44\xf7\x29\xe2\x13\xe0\x27\x4c\xd5\x12\x92\x42\x5a\x1b\x89\x84\xb4\x43\xed\x7a\xcd\x56\xdb\xf8\x14\xb4\x20\x11\x87\x12\x72\x83\x59\x24\xde\x04\x22\x86\xaf\xce\xfe\xa2\x7a\x76\xad\x0b\xa5\x11\xc2\x40\xc6\x61\xc0\xf6\x03\xef\x3e\xb6\x55\x0b\xed\xaf\x8c\xac\x04\xe8\x85\x6f\x73\x5a\x45\xe2\xcc\x10\xb9\x59\x6d\xb7\x60\xb6\x8e\xdb\x3c\x9a\x9c\xca\x54\x22\x9d\x22\x6d\x1f\xb9\x52\x85\xfa\x06\x7f\xd7\x68\x9d\x85\xd3\x28\x82\x5a\xa7\x98\x31\x9a\x74\x10\xb0\x0d\x6a\x2b\xa9\x8f\x46\x88\xe1\xaa\x4d\x31\x57\x3a\x1d\x33\xe0\x06\x04\x4d\x90\x91\xd8\x6a\x53\x67\x26\x21\x93\xfe\x1c\x8b\xa2\xb1\x56\x23\xa6\x65\xea\x3b\xa2\xc2\xa9\xaa\xc9\x95\x14\x24\xef\x62\xe0\x46\x42\x2e\x97\x08\x0f\x0f\xf0\x38\xf1\xa7\x11\x19\x7c\x04\x4f\x93\xb7\x5e\x43\x23\x7d\x94\xa6\x4d\xc5\xf1\xab\xa2\x36\xb2\x50\xf7\x08\x09\xd5\xda\x8d\x96\x35\xea\x7c\xf8\xac\x72\xd4\x91\x78\xf0\xde\x79\x9c\xd6\x74\x8e\xde\x5b\xf0\xc8\xe5\x68\xf6\x64\xd6\x5b\x37\x75\xef\x27\x1e\x69\x41\xb0\xeb\xc1\x80\x78\x1e\xa2\x43\x01\x5b\x96\xa8\x6b\xe0\x37\x65\x99\x45\xae\xc0\xff\x00\xef\xaf\xc6\x08\x96\x5d\x43\x55\x72\xc7\x65\x96\xe9\x35\xd7\xf8\x8d\x7d\x2f\xce\x70\xc9\x63\x7d\xc9\xc0\x06\x34\x31\x46\xdb\xd1\x34\xd4\x24\xd2\xa0\xf3\x53\x5a\xe9\x09\x1a\x27\xa9\xfc\x6c\x50\x3a\x04\x8d\xab\xd9\x6c\x36\x5d\xe9\x68\xb5\xfb\x15\xfb\xfd\x36\xee\x22\x4f\x62\x68\xcd\x95\xc3\x72\xdc\x64\x63\x36\xaf\x9d\x23\xbd\xdd\x60\x32\x0b\xa9\xd5\x7d\xbb\x5a\x01\xc3\x15\xf1\x77\x5c\xc1\xbe\xb4\x45\xdf\x79\x4d\x24\x0f\x8e\x67\xdf\xe9\xfb\x62\x86\x6c\xf7\xea\xe7\x72\x7e\xa4\x17\x2f\x27\xbf\x3b\x77\x5a\xf2\x37\x27\xc3\xfe\xe2\x6f\xd4\xed\xc2\x87\x6a\xb2\xb3\x47\xc7\xe6\xff\x32\x2c\xe2\x2f\x54\xe2\xab\x59\x7c\x09\x88\x8c\x4c\x09\x25\xba\x9c\xb8\x91\x15\x59\x27\x40\x26\xcd\x2c\x75\x57\x48\xed\x46\xf6\xf6\x20\x80\xd2\x55\xed\xc0\xfd\xa9\x90\x2f\x2a\x95\xa6\xa8\x99\x75\x59\xf2\x5f\x62\x4d\x76\xeb\xe8\xae\x91\x2c\x65\x51\xb3\x88\x0f\xcb\x46\xfa\xb3\x11\x5e\x5c\xc2\x7a\x2d\x82\x27\xc2\xef\x9a\xd4\xa5\xb0\xf5\xbc\x54\x0c\xea\x07\x5f\x4f\x8c\xee\xc9\x5e\x75\xfd\x6a\xaa\xfc\x57\x1b\xf1\xc2\x3b\xf5\x74\x37\xbd\x70\x7e\x0e\xa7\xd0\xdd\xf6\xb7\xdc\x7d\xa5\xa7\xae\x59\x83\x0b\x65\x5d\x3b\xef\x23\x4b\xb2\x77\x4c\xde\xf4\x86\x07\x73\xfd\x3a\x84\xcf\xc1\xd7\x2b\x87\xe0\xda\xe8\xaa\x5f\xb5\x86\x41\x50\x7a\x0a\x59\xff\x19\x06\x5d\xbe\xf8\xe4\x2f\xfa\xb6\xb8\xb9\x12\x09\x00\x00")
 
 func sharedDirectivesHeaderHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -686,12 +838,12 @@ func sharedDirectivesHeaderHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "shared/directives/header.html", size: 1933, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "shared/directives/header.html", size: 2322, mode: os.FileMode(436), modTime: time.Unix(1792322440, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _sharedDirectivesHeaderJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x90\x4f\x4f\x84\x30\x10\xc5\xef\xfb\x29\xc6\x53\x21\x21\x70\x87\x70\x34\xf1\xe6\xc9\x83\x31\xc6\x34\x74\x16\x1a\xcb\x94\x4c\xa7\x26\xc6\xf0\xdd\xad\x45\xfc\xb3\xec\x3b\xce\xfb\xf5\x75\xde\x14\xe7\x48\x83\x58\x4f\x50\x94\xf0\x71\x82\x24\x15\x03\x42\x10\xb6\x83\xa8\x2e\x4f\x34\x8d\xd1\x69\xae\x67\x6f\xa2\xc3\x42\x59\x09\xef\x3e\x7a\x72\x96\xb0\x9e\x50\x1b\x64\x55\xc1\xd3\x73\x99\xe9\x2f\xd5\xc6\x32\xa6\xdc\xb7\x8d\x7e\xf4\xf1\x3e\xd3\x77\x3b\x7c\xfc\x76\x17\xa3\x44\xa6\x8b\xe1\x66\x6c\x4b\xb5\xa0\x6e\x55\x75\xc5\x5e\x9c\x1e\xb0\x05\xe1\x88\x47\x5b\x70\x4e\xbe\xe0\x03\xbb\x14\x30\xf8\x79\xf1\x84\x24\xa1\x09\x93\x66\x34\xcd\xcf\xc2\xa1\xd9\x1a\xd5\x93\xcc\xee\xca\x3f\xa9\xc6\x6b\xfb\x67\xff\x30\xf8\x05\x2b\x40\x87\x73\xca\xab\x40\x8b\xf0\x65\xa7\x5d\x99\xfd\xbe\xd8\x8b\xf3\xa3\x25\xe8\xf3\x83\x9a\x71\xb4\x41\x90\xe1\xa6\xef\x21\x92\xc1\x73\xba\x96\xe9\x0e\x29\xeb\xbf\xc9\xfa\x0b\xac\x65\x77\x5a\xcb\xa2\xec\x3e\x03\x00\x00\xff\xff\x0c\x02\x7e\x41\xd2\x01\x00\x00")
+var _sharedDirectivesHeaderJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7d\x51\xd1\x6a\xc2\x40\x10\x7c\xf7\x2b\xb6\x4f\x49\x8a\x4d\xe8\xab\x21\xf8\x50\x2c\x2d\x85\x0a\x56\xa1\xc5\x6a\x09\x77\x1b\x13\x4c\x6e\xe5\x6e\x4f\x28\xad\xff\xde\xf3\x52\x8b\x68\xea\x3e\xce\xce\xcc\xcd\xdc\x86\x85\x55\x82\x2b\x52\x10\x46\xf0\xd5\x03\x37\x81\x35\x08\x86\x75\x25\x38\x48\x3d\x92\xab\x95\xad\x73\x1d\x37\x24\x6d\x8d\x61\x50\xb1\xf9\x24\x4b\xaa\xae\x14\xc6\x25\xe6\x12\x75\xd0\x87\xf9\x22\xf2\xec\xfd\xc4\xb2\xd2\xe8\x7c\xb7\x2d\xfb\x8d\xec\xd8\xb3\x1f\x0e\xe4\xf3\x67\x0f\xa3\x91\xad\x56\x27\x60\xbb\x68\x43\x0d\x20\x18\x05\xfd\x8e\xf5\xa6\xce\x05\x0e\x80\xb5\xc5\xf3\x35\x63\xe3\xf6\x8c\x33\x5d\x3b\x03\x41\xcd\x86\x14\x2a\x36\x89\x29\x73\x8d\x32\xf9\x0b\x6c\x92\xb6\x51\x5c\x72\x53\x77\xbc\xe3\x6a\xac\x07\x47\xf9\x8d\xa0\x0d\xf6\x01\x6b\x6c\x9c\x5f\x1f\x72\x66\x7d\xda\xe9\x30\x9e\xfb\xfb\x63\x1f\x35\xad\x2a\x05\x99\x17\xc4\x1a\x57\x95\x61\xd4\x70\x95\x65\x60\x95\xc4\xc2\xfd\x96\x4c\x3b\x5d\x92\x04\xb8\x44\x70\x7a\xb2\x0c\x05\xe9\x06\x36\x64\xd8\x78\x54\x18\x5d\x00\xd3\x1a\x15\x50\xe1\x11\x83\xc6\xb8\xa4\x17\x02\xed\x35\x53\x2f\xc9\x2e\x1c\xe6\x78\xb6\xb9\x86\x26\x67\x51\x3a\x85\x24\x61\xf7\xd5\x63\x41\xb4\xae\x30\xf6\x78\x98\x84\xc3\xc1\xf2\x3b\x7d\x37\xd7\xd1\xeb\xcb\xe4\xfe\x66\x3a\x7e\x1a\x3d\x67\xe1\x7c\x99\x2e\xae\xa3\x24\xea\x6e\xd6\xde\xd1\xdf\xbf\x75\x1f\x82\x44\x41\x12\x67\x93\xc7\xbb\xc3\xc9\x42\xbf\x9a\xdf\x2e\x22\x70\x97\x0c\xba\x9d\x76\xe7\xf0\xae\xf7\x0f\x61\xe7\xd2\xec\xa2\x30\x4a\x7f\x00\xa8\x9c\xea\xc7\x0d\x03\x00\x00")
 
 func sharedDirectivesHeaderJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -706,12 +858,32 @@ func sharedDirectivesHeaderJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "shared/directives/header.js", size: 466, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "shared/directives/header.js", size: 781, mode: os.FileMode(436), modTime: time.Unix(1792322440, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _userAuthorizecontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x58\x5f\x6f\xdb\x36\x10\x7f\xef\xa7\xe0\x8c\x02\x92\x31\x57\x5d\x5e\x63\x64\x40\xb0\x76\xc0\x80\x61\x2d\x96\x05\x7b\x30\x82\x81\x96\x4e\x36\x57\x99\xd4\x48\xca\x9d\x9b\xfa\xbb\xef\x8e\x94\x64\xfd\x8f\x1d\xa4\xd3\x83\x21\x93\xf7\xe3\xfd\xee\x0f\xef\x44\x86\x69\x21\x63\x2b\x94\x0c\xe7\xec\xf1\x15\xc3\x27\x28\x0c\x30\x63\xb5\x88\x6d\xb0\x7c\xf5\xca\x8d\x71\xb9\x29\x32\xae\xdd\x3b\x3d\xd1\x4e\x25\x45\x06\xe1\x4c\x58\x73\x50\x85\x92\x99\x90\x70\x9b\xe7\xb3\xf9\x49\x24\x56\xd2\x6a\x95\x65\xa0\xc3\xd9\x6d\x61\xb7\x4a\x8b\x2f\xf0\x53\x3d\x38\x5b\xb0\x81\xd1\x79\xa5\x71\x60\x2e\x7a\x2d\xe4\xdf\x10\x5b\x76\xc3\x56\xc1\x6b\x13\xab\x1c\x82\x05\x0b\x5e\x6b\xa5\xec\x5d\xfd\x2f\x53\x31\x27\x7b\xdc\x9f\xcf\x42\x26\xea\x33\xbd\xde\x1b\xd0\x77\xa0\xf7\x22\x86\xe0\x61\xe9\x75\x54\xa6\x0f\x29\x0b\xbd\x82\x05\x3b\x2d\x8f\xef\xd5\xe2\xf8\xea\x97\x5e\xb0\xc6\xc2\x95\x07\xe9\xd9\x73\xcd\xf6\x3b\xa4\x6a\xb7\xc2\x94\xfa\xaa\xf1\x7f\x0a\xd0\x87\x8f\x5c\xf3\x9d\x41\x81\x7a\xd1\xc8\x00\xd7\xf1\x36\x44\x1f\xd4\xd2\xbb\x48\x03\x8a\x1b\x2b\xe4\x46\xe9\x0d\x97\xe2\x8b\x93\x45\x5c\x63\x95\x55\x10\x67\x02\xa4\xfd\x4b\x24\x64\x5c\x1f\x0d\x89\xb3\xc0\x74\x61\xde\x89\x23\x90\xa6\x3e\x42\xae\xda\x72\x98\x26\x5a\xf2\x1d\x90\x09\xb5\x8f\xdc\x68\xc3\x5c\xef\x45\x37\x8a\x72\x8f\xc7\xfe\x54\xad\x8e\xe6\xeb\x49\x97\x74\x49\xa2\xc1\x98\x6b\x54\xbc\x68\x4d\xac\xb9\xfc\xd4\x1f\x85\x1d\x17\x59\x7f\x38\xdf\x2a\x09\xfd\xe1\x96\x71\xd7\xc8\xac\x3d\x9d\xf2\x18\xd6\x4a\xa1\x9a\x94\x67\x06\xda\x93\x1b\x61\xb7\xc5\xba\x9c\xaa\x67\x8e\xcb\xae\x69\xbc\x4c\xab\xda\x83\x8f\xd3\x14\x9a\x4b\xf5\x3c\x98\x27\xdc\x92\xaf\xfd\x4b\xb5\x4d\x9c\x9f\x30\x89\xf7\x38\x46\x89\x53\x0f\xd6\xb9\x7d\x9a\xed\xa8\x4f\xc1\xb6\x93\xed\x38\x80\x2e\x85\x10\xda\xc2\x36\x92\xbe\x35\x4e\x4f\xb4\x01\x1b\x36\xf2\x63\xde\x97\xb0\x5b\x90\x61\x6f\xb8\xa9\x38\x44\x23\x79\x97\x71\xf3\x69\x27\x16\x49\x2f\x47\x65\x73\xae\x0d\xf8\x0d\xd0\xb4\xb7\xf9\x74\xc2\xdf\xa3\xa3\x81\x1b\x25\x27\x09\xf9\x82\x10\xd5\xbb\x79\xab\x21\x45\x6a\x01\x68\xad\x74\xc0\xbe\x67\x7e\x8d\xc8\x58\x6e\x0b\x33\x42\xa3\x37\xfa\x44\x7c\x5a\xa6\x75\xd8\x89\x94\x85\xfd\x12\x30\x64\x03\x15\x25\x53\x15\x88\x3e\x24\x32\x79\x26\x6c\x18\x2c\x82\x01\xef\xbd\x7d\xcb\x7e\x16\x99\xc5\x30\x24\x05\x8a\xa1\xf1\xb8\x95\xfd\x62\x3d\xd9\x5a\x87\x7f\x89\x52\x07\xac\x5b\x10\x0b\x85\x85\xdd\x82\xe5\xca\x2c\x98\x81\x2c\x1d\xf3\xb7\x06\x5b\x68\xe9\x44\x22\xf4\x3a\xfc\xfb\x21\x75\xd0\x39\xbb\xb9\xb9\x21\x78\x9f\xe6\x71\x80\x7a\xd9\xd5\xa2\x54\xe9\xf7\x1c\xd3\xdc\xb3\x5a\x9c\xbc\x1b\x96\x3d\x40\x8c\x11\x71\x8e\x23\xe7\x7c\x04\xbd\x13\xc6\xf8\xca\xec\x53\xb3\x74\xda\xf5\x90\xd3\x2a\x6c\x5e\xc3\x7e\xe5\x6b\xc8\x08\xdb\x5e\x6d\xd5\xf9\x1f\x65\x20\x37\x76\xcb\xde\xb0\xab\x87\xe1\x65\x29\xea\x25\x01\xcb\xb5\x35\x7f\x62\xb1\x0a\xa9\xa7\xeb\xeb\x1d\xec\xd6\xa0\x55\x8a\x94\xce\xd8\x5a\x75\x12\x44\xad\x42\xb5\xea\x70\x7e\xa0\x1e\xa7\x0b\x38\x37\xa1\xe9\x01\xac\x9b\x53\x44\xab\xca\x7f\x21\xcf\x12\x16\xe5\x85\xd9\x86\x1d\x9a\x63\xfb\xfe\x59\xfc\x7c\xab\xb9\x90\x9d\x03\x7d\x7b\x6e\xbe\xdf\x5d\xc8\xcd\x81\xbe\x3d\x37\x6a\xdc\x3c\x8e\x55\x21\xed\xa5\x0c\x09\xfa\xd2\x04\x5d\xb5\xf0\xcc\x7c\x4b\x0f\x2e\xa2\xe4\x31\x93\xe9\xdf\xc0\xb5\x3f\x06\xce\x01\x5f\x68\x42\xf5\xc9\x72\x99\x11\x15\xea\x99\x66\x9c\x05\xef\x1b\x32\x54\x8d\x5d\x3d\xd4\xa8\x43\x5b\xe1\x9a\xc4\x2a\x28\x37\x34\x7d\xbf\xbb\xdd\x43\x2f\x2e\x55\xe9\x85\x32\x22\x18\x28\x82\xdd\xaa\xde\x35\xb9\x59\xdf\xf7\x3c\x2b\xb0\xbe\x97\x6a\x0f\x63\x9e\x23\x77\x9f\xa8\xd5\x2d\xe7\x04\xa3\x28\xbc\xb9\x9a\x72\xbc\x6f\x59\x97\x04\x1a\x1b\x6b\xa6\x54\xce\xd4\x1e\x7b\x6b\xc9\xbe\xdf\x52\x2b\x7a\xdf\x0d\xc6\x67\x55\x51\x7c\x38\x23\x29\xc6\xa0\xe5\x87\xfb\x45\xcc\xdf\xef\x72\x7b\x60\x99\x6b\x6a\x6f\x7e\x64\x33\x8c\x9e\x9c\x0d\x0a\x77\xc3\x55\x86\xe4\x14\xa3\x3a\x70\x7e\xbb\x4f\xd8\xe1\xfc\x70\xbe\x78\xc3\xf4\x1a\x74\xb2\xda\xf5\xef\x18\x7a\xe9\xd3\x90\xa8\xf2\xa0\xa3\x72\xc1\xae\x46\x8a\x52\xf5\xb4\x01\xf4\x91\x48\xfe\x09\xa6\x41\x13\x44\x5c\x5d\xec\xb0\x18\x5f\x6c\x38\x68\x43\x5b\x92\x9e\x6a\x5b\xd2\x31\xef\xf4\xcd\x7d\x52\x3e\x8c\x7a\xc1\xa8\x62\x36\xe1\x87\x1e\x9d\xfb\x53\xa1\x8d\x65\xeb\x03\x4b\x20\xe5\x45\x66\x17\xec\x37\xac\x05\x14\x76\x3c\x50\x30\x77\x14\x48\x44\xc2\xa4\xb2\x2c\x56\x32\x15\x9b\x42\x83\x3b\x7f\xd7\x1b\x9c\x1d\xc0\x4e\xa6\x0f\x09\x9e\x99\x33\x63\xdb\x65\xd5\x36\x8d\xb6\xcf\x87\x35\xdd\x5b\x44\x9f\xe0\x60\xbc\x86\xd5\x0f\x0f\xec\xeb\x57\x16\x4c\x84\xfc\xe8\x2b\xfd\x4b\x53\x99\x54\x79\x76\x62\x74\xc7\x8e\x93\x87\x14\x7f\x64\xed\x9d\x4f\x30\xb0\x31\xcf\x32\x3c\x2e\x60\x48\x29\x82\x2d\x33\xde\x81\xc5\x8a\x6f\xde\x09\x0d\x74\x82\x6d\x9f\x33\x87\x9b\x51\xe3\x46\xa2\x71\xfe\x5c\x9e\x81\xdc\x68\x2e\xd1\x4b\x7f\xa8\xd6\xf9\xa7\x73\xe7\xb2\x3c\xff\x08\x6c\xf8\x1e\x6e\x9b\x2a\xc2\x41\xbd\xcf\x3a\x1a\xb3\x27\xcf\xc6\xb4\x65\x0b\xb4\xe4\xfe\xf7\x5f\xc2\xd3\xed\x12\x5f\x9b\x7b\x9d\x85\xf3\x89\xca\xd0\xbf\x9c\x2a\xaa\x4b\x29\xea\xee\x4f\x20\x41\x26\xb9\x12\xd2\x76\x2e\x99\x66\xd5\xf8\x6c\xa4\x56\xd0\x93\xe0\x0e\xb7\xd0\xc4\x45\x15\x6c\x1c\x54\x44\x39\xb7\x5b\x8a\x71\x58\x09\x4f\x50\xac\x4d\x69\x28\x99\x10\x1f\x3b\xcf\x17\x91\x55\x77\x56\x63\x6e\xfc\xff\x77\x09\x33\x77\x97\x30\x7b\x91\xbb\x04\xff\x7b\x9c\x93\x15\xff\x05\x00\x00\xff\xff\x83\x00\xaf\x1b\x05\x16\x00\x00")
+var _sharedSharedJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\xc8\x31\x0a\x80\x30\x0c\x00\xc0\xdd\x57\x64\x4b\x0b\xd2\x0f\xf4\x29\xe2\x50\xda\xa8\x85\x9a\x42\x93\x0c\x22\xfe\x5d\xf1\xc6\x73\x9b\x71\xd6\xda\x19\x9c\x87\x7b\x82\x0f\x9a\x10\x88\x8e\x9a\x15\xe3\x3f\x89\x77\x6b\x69\x84\xb3\x17\x6b\xe4\xb0\xaa\x5c\xdd\x3a\xb7\xca\x14\xe4\x48\x83\x0a\xce\xb0\xac\x3e\x4e\x8f\x77\x3e\xbe\x9c\x47\x37\x09\x55\x00\x00\x00")
+
+func sharedSharedJsBytes() ([]byte, error) {
+	return bindataRead(
+		_sharedSharedJs,
+		"shared/shared.js",
+	)
+}
+
+func sharedSharedJs() (*asset, error) {
+	bytes, err := sharedSharedJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "shared/shared.js", size: 85, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _userAuthorizecontrollerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x58\x6d\x6f\xdb\x36\x10\xfe\x9e\x5f\xc1\x19\x05\x24\x77\x8e\xb2\x7c\x8d\xe1\x15\x41\x9b\x02\xc3\x86\xb6\xe8\x0b\x36\xc0\x4b\x0b\x5a\x3a\xd9\x5c\x25\x51\x23\x29\xb7\x6e\x9b\xff\xbe\x3b\x52\x92\xf5\xea\xd8\x41\x3b\x7d\xa2\xc8\x3b\xde\x73\xaf\xe4\xd1\x8f\x8b\x2c\x34\x42\x66\xfe\x94\x7d\x3d\x63\xf8\x79\x85\x06\xa6\x8d\x12\xa1\xf1\xe6\x67\x67\x76\x8e\x67\xeb\x22\xe1\xca\x8e\xe9\x0b\x52\x19\x15\x09\xf8\x13\x61\xf4\x4e\x16\x32\x4b\x44\x06\xd7\x79\x3e\x99\xee\x49\x42\x99\x19\x25\x93\x04\x94\x3f\xb9\x2e\xcc\x46\x2a\xf1\x05\x9e\xd6\x93\x93\x19\x1b\x98\x9d\x56\x12\x07\xd6\x82\x47\x22\xfb\x07\x42\xc3\x16\x6c\xe9\x3d\xd2\xa1\xcc\xc1\x9b\x31\xef\x91\x92\xd2\xbc\xa9\xff\x12\x19\x72\xd2\xc7\xfe\x7c\x12\x59\x24\x3f\xd1\xf0\x9d\x06\xf5\x06\xd4\x56\x84\xe0\xdd\xce\x9d\x8c\x4a\xf5\x21\x61\xbe\x13\x30\x63\xfb\xed\x71\x5c\x6d\x8e\x43\xb7\xf5\x8c\x35\x36\xae\x2c\x48\xdf\x96\x2b\xb6\x4d\x11\xaa\xd9\x08\x5d\xca\xab\xe6\xff\x2d\x40\xed\x5e\x71\xc5\x53\x8d\x04\xf5\xa6\x81\x06\xae\xc2\x8d\x8f\x36\xa8\xa9\xd3\x40\x01\x92\x6b\x23\xb2\xb5\x54\x6b\x9e\x89\x2f\x96\x16\xf9\x1a\xbb\x2c\xbd\x30\x11\x90\x99\x0f\x22\x22\xe5\xfa\xdc\x10\x59\x0d\x74\x97\xcd\x19\x71\x84\xa5\x29\x8f\x38\x97\x6d\x3a\x0c\x13\x95\xf1\x14\x48\x85\xda\x46\x76\xb6\xa1\xae\xb3\xa2\x9d\x45\xba\xaf\x77\xfd\xa5\x5a\x1c\xad\xd7\x8b\x36\xe8\xa2\x48\x81\xd6\x57\x28\x78\xd6\x5a\x58\xf1\xec\x63\x7f\x16\x52\x2e\x92\xfe\x74\xbe\x91\x19\xf4\xa7\x5b\xca\x5d\x21\xb2\xf6\x72\xcc\x43\x58\x49\x89\x62\x62\x9e\x68\x68\x2f\xae\x85\xd9\x14\xab\x72\xa9\x5e\xb9\x9b\x77\x55\xe3\x65\x58\xd5\x16\xfc\x7a\x18\x42\x73\xab\x9e\x05\xf3\x88\x1b\xb2\xb5\x1b\x54\x69\x62\xed\x84\x41\xbc\xc5\x39\x0a\x9c\x7a\xb2\x8e\xed\xfd\x6a\x47\x7c\x0c\xa6\x1d\x6c\x77\x03\xdc\x25\x11\xb2\xb6\x78\x1b\x41\xdf\x9a\xa7\x2f\x58\x83\xf1\x1b\xf1\x31\xed\x53\x98\x0d\x64\x7e\x6f\xba\x29\xd8\x47\x25\x79\x17\x71\xf3\x6b\x07\x16\x51\xcf\x47\x69\x73\xae\x34\xb8\x04\x68\xea\xdb\xfc\x3a\xee\xef\xc1\x51\xc0\xb5\xcc\x0e\x02\x72\x05\x21\xa8\xb3\x79\xa3\x20\x46\x68\x1e\x28\x25\x95\xc7\x7e\x66\x6e\x8f\x40\x1b\x6e\x0a\x3d\x02\xa3\x37\x7b\x8f\x7f\x5a\xaa\x75\xd0\x89\x98\xf9\xfd\x12\x30\xa4\x03\x15\x25\x5d\x15\x88\x3e\x4b\xa0\xf3\x44\x18\xdf\x9b\x79\x03\xd6\xbb\xb8\x60\xcf\x45\x62\xd0\x0d\x51\x81\x64\xa8\x3c\xa6\xb2\xdb\xac\x47\x5b\xcb\x70\x83\x20\xb6\x8c\x7e\xad\x8d\x2f\x0c\xa4\x33\x96\x4b\x3d\x63\x1a\x92\x78\xcc\xde\x0a\x4c\xa1\x32\x4b\x12\xa0\xd5\xe1\xf3\xcb\xd8\xb2\x4e\xd9\x62\xb1\x20\xf6\x3e\xcc\xbb\x01\xe8\xe5\xa9\x16\xc4\x52\xdd\x70\x0c\x73\x87\x6a\xb6\xb7\xae\x5f\x9e\x01\x62\x0c\x88\x35\x1c\x19\xe7\x15\xa8\x54\x68\xed\x2a\xb3\x0b\xcd\xd2\x68\x57\x43\x46\xab\x78\xf3\x9a\xed\x0f\xbe\x82\x84\x78\xdb\xbb\x2d\x3b\xff\x41\x02\xd9\xda\x6c\xd8\x39\xbb\xbc\x1d\xde\x96\xbc\x6e\x01\x58\x5b\xd0\x61\xae\xae\x28\x0d\xbd\x23\xb2\xa9\xf6\x7b\x50\x16\x76\xa3\x0a\x18\xcf\xab\xc1\x32\x77\x3f\x6b\x3f\xc8\x5b\xb8\x29\x41\x94\xd1\x7f\x62\x91\xf5\x1d\xfc\x14\xd2\x15\x28\x19\xa3\x29\x4f\x52\xa2\x55\x60\x97\x1d\x5b\xdf\x3e\x00\x23\x60\xbd\x3f\x04\xb4\x3a\xb1\x4e\xc4\x59\xb2\x05\x79\xa1\x37\x7e\x07\xe6\x58\xbd\x7a\x10\x3e\x77\x44\x9e\x88\xce\x32\xfd\x78\x6c\xee\x9c\x3e\x11\x9b\x65\xfa\xf1\xd8\xe8\xc2\xc1\xc3\x50\x16\x99\x39\x15\x21\xb1\x7e\x6f\x80\x8d\xcc\x76\x57\x91\xd3\x72\xdb\xf1\x3c\x30\xbb\x8f\x60\x3e\x51\x85\xea\xaa\x75\x9a\x12\x15\xd7\x03\xd5\x38\x8a\xbd\xaf\xc8\xd0\x29\x62\xeb\xb8\x42\x19\xca\x08\x7b\xb8\x2d\xbd\x32\xa1\xa9\xef\xb0\xd9\x43\x03\x1b\xaa\x34\xa0\x88\xf0\x06\x8a\x77\xf7\x34\xea\xaa\xdc\x3c\x97\xb6\x3c\x29\xf0\x5c\x2a\xc5\xee\xc6\x2c\x47\xe6\xde\x43\xab\x8f\xca\x3d\x1b\x79\xe1\xfc\xf2\x90\xe1\xdd\x51\x7b\x8a\xa3\xf1\x42\x90\x48\x99\x33\xb9\xc5\x3b\x41\x89\xbe\x7f\x15\xa8\xe0\xfd\x34\xe8\x9f\x65\x05\xf1\xf6\x88\xa0\x18\x63\x2d\x1b\x8e\x93\x90\xdf\xa4\xb9\xd9\xb1\xc4\x1e\xc6\xe7\xbf\xb2\x09\x7a\x2f\x9b\x0c\x12\x77\xdd\x55\xba\x64\xef\xa3\xda\x71\x2e\xdd\x0f\xe8\x61\xed\x70\x3c\x79\x43\xf5\x9a\x69\xaf\xb5\xbd\x77\x84\xd0\x0b\x9f\x06\x45\x15\x07\x1d\x91\x33\x76\x39\x52\x94\xaa\xaf\xcd\x40\x97\x5b\xb2\x8f\x77\x98\xe9\x00\x10\x5b\x17\x3b\x28\xc6\x37\x1b\x76\xda\x50\x4a\xd2\x57\xa5\x25\xb5\xa7\xfb\x5e\x61\x2f\x7c\x98\xeb\x3b\x7a\x15\xa3\x09\x2f\xa8\xf4\x5e\x11\x0b\xa5\x0d\x5b\xed\x58\x04\x31\x2f\x12\x33\x63\x2f\xb0\x16\x90\xdb\xb1\x11\x62\xb6\x85\x89\x44\xc4\x32\x69\x58\x28\xb3\x58\xac\x0b\x05\xf6\xdd\xa0\x4e\x70\xb6\x03\x73\x30\x7c\x88\xf0\xc8\x98\x19\x4b\x97\x65\x5b\x35\x4a\x9f\x97\x2b\x7a\x6f\x09\x3e\xc2\x4e\x3b\x09\xcb\x5f\x6e\xd9\xb7\x6f\xcc\x3b\xe0\xf2\x3b\x57\xe9\xbf\x37\x94\x83\x22\x8f\x0e\x8c\xee\x5c\xa3\xe5\x1e\x68\xae\x5c\xab\xdd\xeb\xab\xd0\xb1\x21\x4f\x12\x6c\x73\xd0\xa5\xe4\xc1\x96\x1a\xcf\xc0\x60\xc5\xd7\xcf\x84\x02\xea\xbc\xdb\xfd\xf1\xf0\x61\xd4\x78\x49\x69\xf4\xcd\xf3\x23\x38\xd7\x8a\x67\x68\xa5\xb7\xb2\xd5\xb7\x75\xde\x8a\xda\x1b\x1d\x6c\xdd\x35\xdf\xc2\x75\x53\x84\x3f\x28\xf7\x41\x2d\x3d\xbb\xb7\xa7\xa7\x94\x85\x2c\xca\xa5\xc8\x4c\xe7\xa5\x6a\x52\xcd\x4f\x46\x12\x97\xbe\x08\xd3\xcd\x40\x93\x2f\xa8\xd8\xc6\x99\xd0\x9b\x18\x77\x5b\x3c\xb9\x8d\xec\x7b\x73\x8f\xc7\x6c\xb8\xd9\xe7\xab\x2e\x56\xa9\x30\xd4\xea\xba\x3c\xe5\x6b\x18\x7e\x41\x28\x45\x08\xc3\x88\x4c\xda\x87\x2e\xed\x04\x19\xf9\x11\x32\x16\x49\x14\x4d\xa9\x8f\x92\x30\xe2\x98\xc0\xb8\x53\xc9\xf0\x81\x49\x5f\x53\xbb\x50\xab\xf8\x83\xdb\x66\xc1\xe8\xe7\x2d\x8d\xc7\xde\x38\xe8\x73\xb8\x9f\x4b\x95\xfa\x95\x66\xb3\xe6\x96\xff\xfb\xf3\xc8\xc4\x3e\x8f\x4c\x7e\xd0\xf3\x48\x43\x5d\x1e\xba\x87\xdb\xdc\xe9\xd9\x81\x4a\xa1\x87\x35\x9f\x1e\x6c\x23\x19\x16\x29\x64\x26\x08\x11\x91\x81\x9b\x04\xe8\xcf\xf7\x68\xb9\xdb\xcb\xd3\x5c\x90\x02\x06\x0c\x3d\x5f\x7a\xe4\x5f\x6f\x80\xc2\xc9\x46\x0a\x37\x68\x53\x74\x8f\x1c\x07\x70\xe0\xb6\x87\x65\x78\xc8\xc2\x5d\xfe\xea\x5f\xe8\x6b\xa5\xf8\xce\x71\x4f\xd9\x13\x66\x07\xec\x8a\x2d\xed\xe0\xb6\x29\xc1\x3d\x9e\x8c\xbf\x71\x88\x2c\x2f\xcc\x01\xdb\xd8\xf5\xb1\x87\x0e\xbb\x18\x98\x1d\xdd\xfa\x99\xb7\x11\x51\x04\x63\x77\x06\x47\x5a\xd6\x42\xd4\xf7\x10\x95\x53\x67\xc1\x08\xfb\x30\x9d\xb3\x7d\x9e\x63\xa4\x3f\xdd\x88\x24\xf2\x2d\xe3\x31\xc7\x42\xe7\xbf\x56\x7b\x25\xa3\x5d\x6b\x47\x12\x31\x14\x13\x2e\xf0\xee\x7b\x5d\x6d\xa4\xec\x40\x3c\xa6\xdc\x84\x9b\x96\xd1\xb1\x61\x11\x10\xd8\x79\xff\xc2\x7f\x72\xf5\xfe\xdb\xfc\x6f\xfd\x78\xfa\xd7\x9b\xd7\xcf\xcf\xdf\xbe\xfc\xfd\xe6\xc5\xc2\x5f\xbe\x9f\xdf\x3e\x9e\x5e\x74\x30\x95\x0f\x66\x6e\xc7\x27\x58\x28\x43\x19\xc1\xbb\xd7\xbf\x3d\x95\x69\x8e\x57\x11\x74\xa1\x5d\x5a\x5e\xe2\x7d\xfb\xaa\x75\xd6\xba\x94\xbb\x3b\xbb\x9b\x92\x32\xff\x01\x25\xe6\x63\xe9\x2e\x1a\x00\x00")
 
 func userAuthorizecontrollerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -726,12 +898,12 @@ func userAuthorizecontrollerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/authorizeController.js", size: 5637, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "user/authorizeController.js", size: 6702, mode: os.FileMode(436), modTime: time.Unix(1792322440, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _userControllerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x1c\x69\x6f\xdb\xc8\xf5\x7b\x7e\x05\x63\x18\x25\x85\x08\x92\x53\xb4\x1f\x2a\xc3\x2d\xdc\x1c\xdb\x14\xbb\x4e\xb0\xde\x7c\x0a\x8c\x60\x44\x8e\xa4\xa9\x29\x52\x21\x47\x72\x5c\xaf\xff\xfb\xce\xc1\x21\xe7\xcd\x41\xd2\x87\x6c\x39\x6b\x7e\xb0\xa9\x39\xde\xbc\xfb\xbd\xb9\x18\xcd\xd6\x59\x4c\x49\x9e\x45\x83\xe0\xea\x45\xc0\x9e\x70\x5d\xe2\xa0\xa4\x05\x89\x69\x78\xf8\xe2\x85\x28\x43\xd9\x7c\x9d\xa2\x42\xbc\xf3\x67\xb4\xcc\x93\x75\x8a\xa3\x3d\x42\xcb\xcb\x7c\x9d\x67\x29\xc9\xf0\xf1\x6a\xb5\x37\x68\x9a\xc4\x79\x46\x8b\x3c\x4d\x71\x11\xed\x7d\x2e\x71\xf1\x9f\x7c\x89\xdf\xd4\x65\x7b\xc3\xc0\x2e\x1c\xa8\xf1\xec\xaa\xd1\x3e\xc9\xfe\x87\x63\x1a\x1c\x05\x5f\xea\x31\xc2\xfd\x6f\xe1\x90\xfd\x2d\xf2\x9c\x9e\xc6\xf9\x0a\x8b\x5f\x69\x1e\x23\x4e\x51\x55\xb5\xa6\xf8\x13\x2a\xd0\xb2\x14\xbf\x2f\x48\x96\xe4\x17\xe2\x75\x99\xfc\x96\xa3\x92\x56\xef\xbf\xe0\x84\xa0\xea\xfd\x2d\x41\x69\x3e\xe7\x3f\x4e\x72\x4a\x66\x44\xc2\x3b\xc5\xc5\x86\xc4\x6c\x8c\x66\xfc\x8f\xc5\x1c\x65\xe4\xff\xb0\x3a\x08\x39\xfa\xda\x4f\xc6\x89\x19\x99\xab\x82\xb3\x43\x49\xa3\x62\xbc\x83\xd8\x68\xff\xdb\x30\x68\xa8\x62\xef\x8a\x26\x51\x5c\x53\xc4\x7e\x49\x7a\xd8\x4b\x45\x8d\x78\x13\xb4\x88\x37\x49\x49\x83\xb1\xef\x71\xd0\x39\x0c\x1c\xd4\x49\xa9\xd5\x3f\x00\x65\x4a\x81\xf8\xb3\x41\x45\xb0\x59\x32\x61\xd1\x05\x29\x2b\x82\x45\xf9\x72\xc4\xd4\xab\xc8\xd0\x12\xb3\xca\x86\x44\x51\x7a\xa8\x37\xcb\x34\x8c\x4a\xd6\xf6\x0a\xd0\x40\xb2\x0d\xa1\xb2\x6e\x12\x7c\x39\x83\x04\xa2\xd5\xaa\xc8\x37\x28\x75\x54\x09\xa5\x44\x31\xfd\x15\x7f\x5b\xe3\x92\x8a\x16\x75\x83\x6b\x2f\x02\xbf\xe0\xb2\x44\x73\x8e\x72\x18\x42\x6a\xf2\x8b\x0c\x17\x5c\x29\xcf\x40\xe7\x25\x5e\x4e\xeb\x72\x93\x7a\x4e\xcd\x35\x2c\x4e\x73\x94\xe0\xa4\xaa\xd0\xca\x4b\x9c\x32\xa5\xc7\xc9\x6f\x68\xfa\x21\x4b\xf0\x77\xd6\xe2\x00\xf6\x8c\x17\x38\x3e\x3f\xad\x9a\xb1\x6a\xf0\x1b\xc0\x42\x71\x8c\x57\xdc\x80\xe4\x0b\xa8\x2b\x70\x65\x5c\xf2\x05\xd4\xcd\x31\xfd\x84\xb3\x84\x64\xf3\x37\xf9\x3a\xe3\x8d\x8c\x12\x88\xf1\x22\xbf\x78\xb7\x44\x24\x7d\x8b\x29\xff\x2b\x14\x90\xf5\x71\x96\x5b\x3d\x8f\x93\x44\x36\xd2\xbb\xc1\x42\xab\xcf\xa7\x45\x9e\xe1\x6c\xcd\x19\xee\x18\xd3\x53\xeb\x1a\x59\x6f\x6a\x8c\x6f\x55\xb9\xfa\x17\x4c\x4b\x1c\x18\x38\x6a\x5c\xbd\x55\x33\xa3\x2b\x28\xb6\xfa\xfd\x1b\x65\xe7\xc7\x71\xcc\xa5\x00\x3a\x5a\xe5\x56\xcf\xf7\x28\xc6\xd3\x3c\x3f\x07\xdd\x60\xa1\xd5\xe7\x27\x42\x17\xeb\x29\xe8\xa1\x17\x41\x6d\x4b\x12\x05\xad\x42\x84\x6b\x9e\x55\x68\xf6\x91\xf0\x40\x0f\x50\x74\x68\x5a\xcd\x89\xe1\x27\xac\x32\xab\x87\xee\xd6\x54\x0f\x50\x66\xf5\xf8\x2c\x8d\x56\xbd\x5a\xf5\xc7\x6b\xba\xc8\x0b\x03\x24\x2c\xb4\x25\xae\x57\xbb\xb4\xc6\x57\xaf\x9b\x3f\xf3\xb2\x73\xcc\x1c\x10\x89\x65\x8b\x26\x84\xc8\x00\xc1\x1d\x50\xb8\x5f\xd6\xa1\x51\x0f\x6d\xca\x0f\x1b\x61\x31\x45\x53\x9c\xf2\x97\x04\x51\x14\x42\xef\x19\xc6\x05\x46\x14\xbf\xaf\x22\x97\x80\xb2\x4a\x8c\x92\x84\xf9\x1e\x50\xf2\x93\x8e\xa1\x1c\xbf\xc1\x53\x73\x9b\x24\x23\x34\x1a\x68\xe4\xd5\x11\x52\xd6\x18\x21\xc0\xed\x1d\x57\xa8\x28\xf1\x87\x8c\x46\x7a\x9c\x1c\x51\x34\x1d\x04\xbf\xff\xce\x7d\xa7\xea\x7e\xed\x18\xc7\xd2\x1d\x6b\x50\x32\x0b\xa2\xda\x5d\xc3\x18\x65\x36\xe5\x4f\x81\xe9\xba\xc8\x0e\x41\xf9\x35\xf8\xe5\x08\xbc\x16\x14\xee\x81\x23\x2d\x72\x0e\xec\x16\x74\x81\xb3\xc8\x19\xe5\x6b\xe2\x22\x2e\x50\x17\x92\x1a\x43\xcd\xa0\xcb\xbb\x1c\xfa\x3b\x30\xf5\x8b\xdd\x21\x41\x0c\x36\xd2\xa2\xb4\x2e\x57\xf3\xe1\x4c\xad\xe0\x1c\xb1\xf8\xd6\x86\xa3\x03\x4f\x2d\x36\x9f\xe4\xc1\x3a\x5b\xa0\x2c\x49\x59\x28\x04\xb4\x84\x7e\x2a\xae\x03\x9c\xb2\x8c\xf7\xb6\x63\xb6\x41\x6e\x63\xb5\x4b\x85\x78\xbe\x54\xac\xb1\x87\x55\x36\xb8\x41\xb7\x3a\x03\xc7\xd6\xa1\xce\xb9\xde\xf6\x76\xea\xec\x48\x19\x9d\xea\xcc\xdd\x28\x44\xed\x41\xf4\x5b\x65\x6b\x42\x3b\xc5\x8f\x16\xed\xd6\xb2\x38\xd1\x5e\xfe\x6a\xed\xe0\x62\x64\x2d\x54\x57\x9f\x5b\xc9\x14\x46\x16\x4b\xa8\x2a\x4c\x45\x83\xc3\x16\x61\x23\x00\xe4\x76\xd2\xd6\xe6\x02\x5c\xa8\x06\x5e\x0f\x22\x51\x64\x86\xde\x0e\x97\xe5\xa1\xff\xfe\x85\x24\x25\xd0\x6a\x6f\x9c\x3b\x77\x66\xfc\x23\x46\x8b\x6a\x2e\xd3\x97\xe3\x55\xf3\x7b\xe4\xb3\x19\x74\xf4\x78\x63\x66\x0b\x5a\xac\x3a\x38\xf4\xcd\x25\x47\xb3\xbc\x78\x87\xe2\x45\xb3\x2a\xd2\x54\xba\x98\xc1\xe5\xd9\xb4\x18\x95\xec\xff\xba\x14\x51\x2c\x5c\x49\xc4\x42\x1f\x0f\x25\x32\xaf\x8e\x82\xd7\x36\x33\x20\x23\xae\xcd\xd8\x29\xf5\x23\x30\xf2\x61\x17\x83\xc0\x64\xd0\xce\xa0\x18\x4f\xca\x66\xe6\x38\x63\x33\x66\x33\xf6\x98\x39\xc1\xe8\x1e\x99\x55\x8f\x7c\x24\x95\xc2\xc7\x29\x0d\x45\xb7\xee\xf4\x63\x57\x69\xcd\x89\x5d\x1c\x93\x73\x63\x27\xab\x8a\x6a\xcd\xc0\x9c\xd5\xef\x0a\x9f\x14\x7e\xa3\xd5\xba\x5c\x44\x8e\xc4\xb2\x5a\x01\xd0\x87\x1f\xdc\x98\x9b\xfb\xdf\x6c\x7f\x82\xd2\x34\x52\xa3\xdf\xca\xdd\xb0\xde\xe5\x8a\xb1\x0a\x3b\x03\x91\x7a\x28\x5f\xe0\x8a\xc2\x63\x41\x05\x63\x48\x18\xbc\x0a\xea\x8e\xa3\x14\x67\x73\xba\x60\x45\xa1\x6e\xd1\x2f\x43\x07\x89\xea\xf1\xa7\x61\x95\x2d\xf8\x3a\x3a\x26\x0a\x1e\x97\xe6\x5e\x7a\xd3\xe8\x46\xa5\x5b\x0b\xd4\x53\x4d\xcd\x46\x6a\x05\x70\xb4\x28\xf0\x8c\x61\xb8\x87\x8b\x22\x2f\xf6\x04\x0b\x38\x8c\xca\xf9\xdc\x93\x67\x95\xcb\x40\x3f\xa8\x19\x54\xc4\x3d\x71\x33\xf8\x55\x50\xf1\x6c\x06\xdb\x34\x03\xc9\xea\xa5\x9c\xed\xb9\x8c\x41\x34\xe0\x2b\xd9\xd5\xf2\xbb\x2d\xf5\x92\x2c\x57\x29\x8e\x5c\xfa\x80\xbf\x53\xbe\x10\x82\xb3\x66\x08\xbb\xd5\x82\x24\xf8\x2d\x4e\xd1\x65\xf4\xd7\xbf\x1f\x1c\x38\x1a\xac\xf2\x92\x08\x26\x86\x34\x5f\x05\x05\x99\x2f\x68\x68\x6a\xeb\x78\x1c\x9c\x2e\xf2\x0b\x89\xee\x4b\xa8\xc8\x15\xe6\x62\x51\x2a\x12\x0d\x3a\x98\xe2\x5c\xc9\x8d\xf0\x66\x18\x88\xb5\xa3\x61\x80\x79\x25\x92\xeb\x96\x03\x9b\x67\x2c\x0f\x7c\xbf\x4e\xd3\xd3\xb8\xc0\x38\x63\xbc\x8b\xd4\x8e\x45\x14\x96\xcb\x50\xac\xd3\x34\x25\xdf\xcb\xd0\xb4\xce\x7a\x15\x4b\xa2\x6c\xeb\x4c\xb3\xf3\x34\x09\x2c\x44\x9b\xa5\x27\x5b\x27\x29\x66\xb2\x42\x14\x7f\x2e\xd2\x09\xdf\xb6\x59\xae\xf8\xaa\x2f\x2d\xc7\x3c\x75\x1d\x6f\x08\xbe\x28\xc7\x3a\x71\x89\x44\x63\x41\x97\x69\xe8\x80\x86\x0a\x96\xa0\xbe\xdb\x30\x08\x93\x80\xb1\xc7\x6a\x30\x63\x5c\x28\x05\x17\x26\x90\x29\x76\x53\xae\xf6\x69\x39\x71\x6a\xb5\xdf\x68\xb4\x69\xc2\x04\x6c\xdc\x78\x7b\xd4\x9b\x33\x13\x7d\xab\xc6\xdf\xbe\x32\xcb\x49\xbd\x11\xe5\xf7\x15\x5c\x37\x26\x95\x8a\x78\x5b\xe9\xdc\x65\x38\xe8\x3f\xfd\x9d\xe4\x8a\xe3\x87\xf2\x53\x5e\x96\x64\x9a\x32\x5a\xa3\x8f\x53\xee\x1c\x47\xe7\xf8\xb2\x9e\x87\x8e\x04\xb0\x81\x72\x90\xff\x0c\x5e\xdb\xc6\xc4\x1f\xd3\xf1\x83\x9f\x1e\x27\x5e\x3b\xb2\xb6\x59\x13\x0f\x68\x62\x21\x21\xc3\x17\x3f\x73\x36\xf4\x98\x5e\x49\xa4\xbf\x80\x6e\x67\x6a\x41\x42\xe7\x4e\x5f\x87\xa7\x10\x79\x09\x40\x72\x9b\x83\x05\x2f\xd5\x22\x09\x73\x28\x24\x43\xa9\x44\xd8\x8f\xaf\x94\x81\x0b\x6d\x00\xe1\xac\x2f\x9e\xd7\x3d\x9c\x10\xdc\x17\x62\x1e\xc8\xe5\xa1\x9f\xbd\x4d\xcd\xba\x3f\x9f\xb7\xd9\xdb\xeb\xe7\x6a\xda\x5b\xda\xfe\x45\xe4\x45\x0f\xeb\x3d\xee\xee\x10\xfa\x98\x94\x67\xb7\x54\x8f\xee\xab\xa6\xc9\x63\x07\xf7\xd6\xbd\xaf\xdb\x19\x9c\x46\xdd\xae\xdb\xdb\x63\xc7\x6a\xae\x72\x6c\x6c\x8d\x63\xfe\xb6\x70\xe3\x10\xb8\x07\x36\x1d\x9b\x93\x92\xe2\xe2\x04\xeb\xea\xd7\xe2\x35\xc0\x96\x23\x84\x25\xeb\x7a\x81\x81\xfb\x94\x10\x8c\xac\xd3\xc0\x3c\x81\x4c\x41\xc8\xc1\xe3\x18\xfc\x4b\xb4\x8f\x96\x21\x68\xe8\x6e\x35\x43\xb0\x4e\x6e\xec\x42\x9e\xf0\xec\xb8\x1e\x2d\xec\x73\x8d\x6b\x6f\xf1\xec\xab\xaa\xe7\x46\x79\xc9\xcd\xdd\x4f\x4f\x03\x36\xcf\x4e\xe9\xb9\xc8\x8e\x2c\x32\x6c\xc1\x9c\x9f\x48\xce\xbf\x23\x39\x48\xe7\x12\x41\x4f\x9b\x3e\xee\x82\xd3\x6d\xcf\x9d\x20\xba\x6d\xb9\x02\xf1\x04\x72\x8e\x8a\xef\x4f\x27\xeb\x00\x08\x6f\x35\xef\x00\xe7\x36\x7f\xd0\x9c\xe3\xd9\x49\xf5\xcd\x37\xae\x3c\x3b\x20\xfc\x79\xf6\x4d\x7d\x73\x8c\xdb\xb8\x9b\x3e\xe6\x6a\x9d\x96\xd6\x73\x8c\x29\xab\xfc\x11\x8d\x77\x6a\x12\xfd\x80\x06\xec\x91\xb3\x32\xcd\x1e\x76\xda\xcf\x46\xbb\x93\x08\x69\x9e\x9c\x17\xee\xfa\x9e\xc6\xa9\x69\x90\x1b\x4e\xb7\x71\x76\x82\xe8\x36\x4e\x0d\x84\x6d\x07\x6d\xc6\xc9\x9f\x7b\x39\x15\x56\x67\x11\x20\xb6\x72\x5b\x78\xd9\x3b\xb9\x68\x68\xad\x0d\x9f\xcb\xa7\x7f\xcc\xb6\xc9\x75\xa2\xd8\x17\x17\x1b\x89\x1b\x64\x3a\x7e\x5c\xfa\x38\x26\x78\x1f\xe3\x1e\xb3\x08\x00\xa4\x1e\x32\xc9\x4f\x72\xba\x20\xd9\xdc\x3a\xf8\x71\xfd\x62\xa7\x7c\xd7\x0c\xf0\xe5\xc7\x75\x5c\xbe\xc4\x42\x7a\x2d\xa5\x98\x8a\x1b\xfd\x3c\x58\x2d\xe4\x7e\x8e\xaa\xa3\x79\xb7\x53\x32\x2e\xfc\x6c\xc9\x31\xf5\x99\xad\x28\x3e\x19\xd7\xec\xe0\xf0\xdd\x46\xa9\x5f\x79\x7a\x36\x49\x65\x92\x73\x8d\x2b\x7f\x76\x83\x94\xbc\xd8\x4d\x73\x04\xb7\xe9\x1e\xd1\x18\x25\x8f\xee\x60\x8a\xf6\x5d\x42\x6b\x5c\x70\x3d\x99\x9f\x8b\x7f\x23\x0a\xa2\x06\x53\xd9\xc2\x85\xaf\xef\xc0\x59\xb8\xa0\x74\x55\x4e\xc6\xe3\x8b\x8b\x8b\xda\xa3\x8c\x98\x4d\x8c\xe5\x34\x78\x9c\xf3\x9b\x05\xff\x8a\x53\xc2\xb4\xfc\x2b\x49\x8e\x42\x27\x75\xaf\x2a\xe4\x6a\x10\xb2\x03\x49\x3c\xad\xc3\xbf\xa8\x23\x7e\x5f\xe9\xe5\x0a\x1f\xc5\x79\x82\x59\x51\x42\x0a\x1c\xd3\xaf\xeb\x82\x78\xc7\xb1\x08\x91\x29\x94\x6f\x9c\x3a\xb6\x7e\x65\xf6\x95\x4e\x51\x7c\x6e\xdc\x70\xea\x21\x17\xa0\x63\x0f\x2b\x15\xa9\x56\x42\x1e\x4c\x18\x24\x93\xe2\x18\xab\xdb\x1e\x78\xac\x4b\xa6\x91\x82\xec\xa6\x64\x70\x33\x82\x5b\x6f\x6b\x46\xe0\x9e\xc9\x90\x79\x3d\x36\xc2\x76\x43\x06\x1f\x51\xff\x96\x40\x59\x7d\x43\xa0\xfe\x1a\x80\xf0\x8e\xc3\x00\x60\xe6\x64\xb6\xe8\x59\xf9\x0d\x86\x92\x63\x35\x40\x07\x61\x9b\x71\x05\x40\xfa\x30\x06\x40\xbe\x78\xdb\xc5\x28\x8b\xd9\x64\xe1\x28\x90\x2f\x7e\x78\xf2\x22\x09\xfc\x64\x80\xd1\xa6\xc0\xcb\x7c\x83\xc5\x85\x76\xfe\xd2\xd2\x4e\x9c\xd7\xb5\xee\xde\xeb\xa2\x51\x73\x0e\x40\x2d\x6b\xff\xdf\xd3\x8f\x27\x23\x71\xe1\x34\x12\xaf\xfc\xa3\x19\xd9\x9c\xcc\x2e\xa1\xd0\x5d\xa7\x8b\xab\x0f\x6a\xd4\x67\xa2\x0d\x2d\x69\x4c\x61\x83\xd2\x35\x13\xde\x39\xbe\x6c\x5b\xb8\x3d\x2e\x0a\x74\x39\x22\xa5\xf8\x2f\xfb\x0c\xda\x9c\xb0\x39\x7c\x35\x8a\x36\xec\x30\x20\x5d\xf3\x22\xb1\x5c\x6b\xb2\xf1\x0b\xc3\xf4\xac\xab\xa7\x8b\xff\xa2\x63\x4b\x38\x50\x8f\x7f\x62\xe7\x85\xfa\x65\x73\xd6\x7a\xef\x48\x80\xf5\x9d\x5c\x76\x96\x8a\x1b\xa3\x9c\x7e\xee\x8b\xf3\x59\x20\xf8\x27\xef\xfd\xe4\xe2\xe4\xa1\xf7\xda\x8f\x17\x49\x86\x21\x38\xb4\x28\x64\xe8\xc1\xa9\xeb\xc6\xaa\x6f\x00\x01\xb4\xf7\xed\x52\x17\x4b\x2a\xc8\xd6\xe5\x39\x04\x1d\x81\x77\x99\xaf\x72\x01\x51\xa7\xef\xe1\x8f\xee\x6f\x4a\xb4\x81\xde\x26\x72\xa2\xe2\x3e\xd2\xc9\x1f\x99\xb8\xf4\x5f\xc9\x10\xd4\xd6\x39\xb5\xf4\x48\xbe\xe3\xed\xea\xb1\xee\x23\x8e\xca\x55\xca\xb0\x8f\xec\x0a\xc2\xef\xab\x7f\x9c\x19\x8c\x18\x06\xaf\x6f\x3c\x84\xb8\xe6\x20\xa8\xb9\x99\x76\x5f\xb7\x48\x49\x91\xeb\x5f\x8f\xdd\x16\x9d\x1e\xfa\x9c\x2e\xd8\x03\xa2\x8f\xd4\xda\x88\x97\x11\xc3\x4b\x7c\x47\x14\x34\x28\xbd\x57\x7d\x7c\x00\xfd\xba\x89\xca\xbb\xb5\x0a\xfe\xba\xfd\x1c\xb5\xf5\xc3\x15\xfc\x3f\x20\x2d\x1c\x1a\x79\xcf\xd9\x2d\xb7\xb4\x40\x22\x77\x93\xd9\x2c\x76\xad\xdb\xde\xeb\x84\xb6\x9e\x60\xba\xe7\x7e\x00\xf5\x09\xfc\xd9\x39\xd1\xd3\x53\x5c\xf9\xd7\xf8\x4a\x55\xcb\xd1\x66\x5f\x8a\x29\xe6\xdc\xf0\x83\x51\xf5\xf7\xaa\x1c\x57\x45\x86\xd6\x41\x5b\xdd\x1e\xc6\xe3\x0f\xb3\x80\xd9\x4c\xc1\x02\x6f\xc9\x12\x18\xa3\x2b\xa1\xa2\x38\x65\xb3\xeb\xe4\x32\xe0\xd1\x22\x19\xf2\x08\x9d\xe5\x74\x28\x3e\x42\x15\x2c\x31\x62\xa1\x8a\x2e\x10\x0d\x50\x90\xe1\x8b\x80\x09\x9f\xf7\x99\x62\x96\xb4\x05\x6a\x2d\x1f\x27\xa3\x46\x67\xab\x10\x03\x2e\x25\x1c\x05\xee\x53\xb6\x20\x59\x6e\x48\xe0\x2b\xc4\x46\xd1\xa1\x35\x00\x5c\x2c\x3f\x92\xcc\xb1\x40\xa7\xad\xb5\xda\x67\xb5\xd4\xab\x3d\x90\x37\xc3\xae\xea\x59\x82\x40\x12\xa1\x30\xe2\x5a\x95\xf8\xee\xd6\xb5\x05\x44\xac\x60\x70\x20\xe2\xc5\xc6\xc4\x97\xee\x03\x16\x09\x7d\x3a\xae\x99\x6a\x17\xaa\x6f\xc1\x01\x2d\x54\x4e\xe9\xca\xe3\x57\x6c\xa7\xe5\xbc\x16\x2d\x10\x8f\xba\xaf\x2b\xf1\x14\x4f\xcf\xca\x1c\x0a\xc1\x52\xe8\xe5\x68\x5f\xb0\x4b\xbb\x5b\x72\x10\x0c\xae\xaa\x6b\xfc\xd0\xd2\xda\x18\x0d\xbd\xa9\x67\xa7\x49\x67\x51\xd4\x58\x99\x8b\x96\x5e\x5b\xae\x6e\x77\xd3\x70\x94\xdf\x3f\x8b\xae\x80\x86\x8a\x65\xb0\x40\x6d\x82\x4c\x5c\x63\xc3\xab\x3b\xce\x40\xe1\x72\x96\xf0\x3a\xa0\x7f\xbe\x03\xee\xfa\xb1\xbc\x3b\xf8\xdb\xc1\x3f\x5a\x0e\x81\x78\x98\x3e\x4a\xd6\x3c\x7c\x4a\x65\xbd\xc9\x67\x09\xf8\x83\x7d\x37\x00\x5a\xd0\xd8\xce\x1d\x46\x58\xd2\xa1\xfa\x55\xfe\x9d\xa7\xc9\xcf\x52\x6a\x4a\x8c\x4f\xc2\x0e\x24\xf6\x1e\x13\xe8\xa4\x69\x7b\xf6\x60\x0f\x3d\xf1\x20\xf1\x6c\x18\x2e\x34\x76\xc0\x30\xec\x00\x24\xe3\x83\x19\x6a\x6e\xa5\xb7\x0e\xe0\x86\xeb\xde\xa2\x72\xa6\xa6\x66\xee\xed\xdd\xab\xde\xdd\x59\x7a\x5d\x72\xb2\x72\xd1\xd6\x2f\xca\xb5\x67\xa3\x66\x02\xca\xd9\x3a\x34\x36\x65\x86\xc6\xae\xcb\xd0\xd8\x56\xe1\x69\xa9\x95\xd4\xf0\x83\xa8\xea\x5b\x38\x4f\x2b\xbf\xb3\x5a\xdd\x35\xc1\xb3\xd6\x5e\x1f\x26\x8f\x73\x58\x88\x27\x6e\xf1\x96\x5b\x8b\x57\x50\x97\xec\x14\x4d\xa0\xd9\x65\xed\x6a\xb3\xe7\x1e\xd3\x33\xb9\x45\xc9\xff\x3e\x47\x1d\x89\xc6\x0e\x44\x9d\x96\x74\x6c\x47\xd4\x19\xba\xc2\x8e\x74\xeb\x01\x74\xbb\x3d\xd5\x7a\x56\x72\x13\x8d\x1d\x50\xf2\x6a\x41\xf5\x6e\xe9\x14\x0c\xc1\x37\x4e\x9f\xee\xa6\x74\x3f\x44\x0a\x75\x3d\xe0\xf1\xf4\x8f\x00\x00\x00\xff\xff\x32\x75\xd0\x65\xf1\x5f\x00\x00")
+var _userControllerJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x1d\x69\x6f\xdb\xc8\xf5\x7b\x7e\x05\x63\x04\x25\x8d\x15\x24\x6f\xb0\xfd\x50\x05\x6e\xe1\xe6\xd8\xa6\xdd\x4d\x82\xcd\x6e\x81\x22\x30\x8c\x31\x39\x96\x58\x53\xa4\x42\x52\x52\xd4\xac\xff\x7b\xe7\xcd\x41\xce\x49\x8e\x64\xc9\x96\xb3\x1e\xc0\x16\x39\x9c\xe3\xbd\x37\xef\x1c\xce\x0c\xa3\xab\x45\x1e\xd7\x69\x91\x47\xc7\xc1\xd7\x27\x01\x49\xe1\xa2\xc2\x41\x55\x97\x69\x5c\x87\x2f\x9e\x3c\xa1\x79\x28\x9f\x2c\x32\x54\xd2\x6b\x48\xc3\x59\x91\x2c\x32\x1c\x1d\xa5\x75\xb5\x2e\x16\x45\x9e\xa5\x39\x3e\x9b\xcf\x8f\x8e\xdb\x22\x71\x91\xd7\x65\x91\x65\xb8\x8c\x8e\x7e\xab\x70\xf9\x8f\x62\x86\x5f\x36\x79\x47\x83\xc0\xcc\x3c\x16\xfd\x99\x8f\x86\xcf\xd2\xfc\xbf\x38\xae\x83\xd3\xe0\x53\xd3\x47\xf8\xec\x73\x38\x20\xff\xcb\xa2\xa8\x3f\xc6\xc5\x1c\xf3\xbb\x45\x8d\x3f\xa0\x12\xcd\x2a\x7a\xbf\x4a\xf3\xa4\x58\xd1\xcb\x34\xaf\x71\xb9\x44\x19\xbd\x99\x25\xbf\x16\xa8\xaa\xf9\xf5\xcf\x38\x49\x11\xbf\x7e\x95\xa2\xac\x98\x84\x83\xb6\xa3\x77\x45\x9d\x5e\xa5\x31\x02\x4a\x7d\x24\x4d\xa4\x31\xed\xeb\x7d\x39\x41\x79\xfa\x3f\x3d\x1b\xc0\x97\x6e\x09\x25\xae\xd2\x89\xc8\x38\x7f\xc1\x70\x14\x84\xb7\x20\x1b\x3d\xfb\x3c\x08\x5a\xac\xe8\x75\x83\x13\xb9\x63\x18\x91\x0b\x81\x0f\xb9\xe4\xd8\xd0\x2b\x8a\x0b\xbd\x62\x98\xb4\x88\xb8\x92\x05\xbf\x41\x60\xc1\x8e\x8d\x5a\x73\xa3\x60\x26\x18\x08\xd2\x12\x95\xc1\x72\x46\x06\xab\x9e\xa6\x15\x47\x98\xe6\xcf\x86\x84\xbd\xca\x1c\xcd\x30\x79\xd8\xa2\x48\x73\x5f\xc8\xc5\x72\x09\xa2\x8a\x94\xfd\xaa\xe0\x90\xe6\xcb\xb4\x66\xcf\xc6\xc1\xa7\x73\x15\x41\x34\x9f\x97\x05\xa1\x8a\xe5\x11\x65\x4a\x14\xd7\xbf\xe0\xcf\x0b\x5c\xd5\xb4\x44\x53\xe0\xc6\x09\xc0\xcf\xb8\xaa\xd0\x04\x40\x0e\x43\x15\x9b\x62\x95\xe3\x12\x98\xf2\x5c\xa9\x3c\xc3\xb3\xcb\x26\x5f\xc7\x1e\xb0\xb9\x51\xb3\xb3\x02\x25\x38\xe1\x0f\xa4\xfc\x0a\x67\x84\xe9\x71\xf2\x2b\xba\x7c\x9b\x27\xf8\x0b\x29\x71\xa2\xd6\x8c\xa7\x38\xbe\xfe\xc8\x8b\x91\xc7\xca\xbd\xd2\x16\x8a\x63\x3c\x07\x01\x62\x17\xca\xb3\x12\x73\xe1\x62\x17\xca\xb3\x09\xae\x3f\xe0\x3c\x49\xf3\xc9\xcb\x62\x91\x43\x21\x2d\x47\x85\x78\x5a\xac\x5e\xcf\x50\x9a\xbd\xc2\x35\xfc\xa7\x0c\x48\xea\x58\xf3\x8d\x9a\x67\x49\xc2\x0a\xc9\xd5\xd4\x4c\xa3\xce\x87\x69\x91\xe3\x7c\x01\x04\xb7\xf4\xe9\x78\x6a\xeb\x59\x2e\xaa\xf5\x6f\x3c\xb2\xd5\x2f\x09\x97\x58\x20\xb0\x3c\xb1\xd5\x16\xc5\xb4\xaa\x4a\xb6\x51\xef\xef\x28\xbf\x3e\x8b\x63\x18\x05\xa5\xa2\x91\x6f\xd4\x7c\x83\x62\x7c\x59\x14\xd7\x4a\x35\x35\xd3\xa8\xf3\x63\x5a\x4f\x17\x97\x4a\x0d\x39\x4b\xe5\xb6\x24\x11\xad\x71\x40\x80\xf3\x8c\x4c\xbd\x0e\x6b\x4f\xa9\xa1\x64\xbd\xd0\xa5\xe6\x9d\xa6\x27\x8c\x3c\xa3\x86\xac\xd6\x44\x0d\x25\xcf\xa8\xf1\x1b\x13\x5a\x71\x69\x3c\x3f\x5b\xd4\xd3\xa2\xd4\x9a\x54\x33\x8d\x3a\x1f\xc9\xa0\x4a\xa5\xc5\xad\x52\x2e\x21\x62\x5c\x63\xfe\x88\x14\x54\xee\xb5\x16\x27\xc4\x42\xbc\x5e\xe2\x72\xbd\x9a\xe2\x12\xd3\x56\xd5\x2c\x03\x82\x7f\xe3\x92\x50\x09\x33\xde\x16\x70\xa8\x99\xce\x3a\x54\x1e\xf5\x3a\x2c\xd3\xe4\x6d\x99\x10\x36\xf9\x70\x3d\x37\x5a\x7a\x39\x25\x8e\x08\x31\x83\x55\xb5\x2a\xca\x44\x69\xc4\xf6\xc8\xd4\x4b\x49\x5a\xbf\x23\xa6\x47\x55\x4a\x4a\xa6\x52\x67\x09\x88\xad\x29\x29\x48\x61\xe9\xce\x52\x8a\x22\xdf\x94\xa2\x77\xb2\xa2\x26\xf6\x70\x82\x89\xa9\x48\x63\x86\x61\x6b\xec\x99\x55\x07\x53\x11\x3e\xab\x1a\x27\xa6\x75\x42\xa8\x43\x46\x2d\xa6\xe6\xcd\x64\xe8\x12\x53\x4f\x26\x41\x35\x0a\x55\x3b\x17\xc6\x25\x46\x35\x7e\xc3\x7d\x0c\xda\xca\x3c\xd1\x72\x18\x3b\x49\x39\x3f\xca\x10\xb2\xfe\x5b\x38\x25\x03\x97\xe6\x69\x1d\x1d\x4b\xe8\x35\xbe\x0c\x7b\xa2\x19\x6b\xbb\x1d\x9b\xa3\xb2\xc2\x6f\xf3\x3a\x92\x9d\x9b\x61\x8d\x2e\x8f\x83\xdf\x7f\x07\x2b\x27\x37\x61\x48\x35\x74\x2f\x1e\xde\x58\x00\xb1\x54\xd0\x5d\x88\xab\x20\x6a\x2c\xaf\xea\x6e\xe8\x45\x21\x95\xb8\x5e\x94\xb9\x0a\xd5\x8d\x72\x67\xf1\xa1\x8c\x56\xc0\x98\x46\x92\x13\x74\x6c\x96\xa8\xa7\x38\x8f\xac\x0e\x5b\x83\x5c\x04\x23\x6e\x03\x52\x24\x8b\xff\x04\x55\x5e\xb8\x2b\x10\xfe\x8c\xed\xd6\x9d\x76\x36\x94\x1c\x2e\x79\xe0\xf5\x04\x44\xe5\xed\x9c\x12\x57\xa5\x0b\x46\x0b\x9c\x92\x9b\xf5\xae\x08\x16\x39\x91\xe9\x24\x23\x5e\x8d\x82\x4b\xe8\xc6\xe2\x26\xc0\x19\x09\x5e\xb6\xed\xb3\xab\xe5\x2e\x52\xdb\x58\x08\x5c\xdf\x72\x81\xdd\x2d\x4a\xbe\x2f\xf9\xcb\x85\x43\x0a\x1e\x1c\xb3\x73\xd6\x9a\x26\x1c\x1e\x72\xa0\x18\xb7\x1e\x39\x28\xe4\xb2\xdb\xc9\x81\x25\x6c\xb0\xca\x01\x98\x52\x15\xb4\x3b\x11\x0c\xe1\xb1\x53\xb6\xa6\x37\x1d\x62\x21\x79\xf2\xb4\x3c\xbb\xeb\xac\x60\x23\x64\x27\x37\x6c\x35\xa6\xaa\x77\x61\x0c\xaa\x70\x55\x64\x35\x09\x49\x1d\x6c\xa4\x34\xb2\xdd\x68\x4b\xf1\x20\x0c\xaa\x06\xd7\x9d\x8c\x28\xd2\xdd\xaf\x1e\x5d\xe7\xc0\x7f\xf7\x83\x24\x9c\x3a\x63\x78\x34\xa2\x35\xe5\xee\x84\x5c\x55\xeb\x79\xfa\x12\x4a\xaa\xb2\x43\x12\x29\xee\x6c\xc4\xfb\xe8\x22\x95\x5a\x41\x22\xd6\x20\xe0\xb5\x05\xa4\x69\xb2\x25\xfd\x3c\x69\x37\xac\xe6\x19\x81\x28\x92\xb3\x52\x70\x6c\xde\x5f\x35\x88\x0c\x82\xef\x8f\x77\xc6\x4c\xaa\x2f\xdf\xc9\x50\x8c\x4a\x67\x59\xb6\x43\xb6\xea\x22\x0b\x77\x49\x09\xaf\x30\xdb\x37\x9c\x96\xf8\x0a\xec\xe9\xc8\x61\x50\xb7\x92\x25\xa6\xcd\x3a\x6d\x17\xe0\x78\x6b\x25\x76\x8f\x2e\x1b\x9f\x1b\xf2\x15\x4a\x5e\xbc\xdb\xcd\x30\x63\x3a\xdd\x24\xb8\x4a\xb3\x68\xce\x55\x7a\xab\x31\xd4\x01\xe9\x1c\xcd\xa5\x52\x78\x3f\xe3\xaa\x01\xe4\x31\xca\x7e\x03\xca\x1b\xd2\x70\xe8\x1c\x5b\x17\xe2\xce\x11\xbe\xd9\x80\xe0\x62\x2c\xbd\x08\xce\x0a\xef\x97\xe0\x1c\xa0\xbd\x11\xbc\x99\x9f\xd8\x84\xe0\x4d\xa5\xed\x08\xae\xc7\x4d\x72\xc8\xa4\x47\xc4\x52\xb8\xa5\x45\xba\x52\xad\xe1\x55\x51\xbe\x46\xf1\xb4\xa1\x81\xd4\xa4\x8d\x10\x30\x9c\x6d\x89\x61\x45\x7e\x17\x15\x0d\xc4\xc2\x39\x03\x2c\x74\xd1\x8f\x01\xf3\xdd\x69\xf0\xbd\x05\x6f\x75\xbc\xf5\xf0\x8f\x31\x45\xa0\xcd\xce\xd9\x08\xa4\x4c\x4d\x9b\xb3\x04\x84\x26\x55\x3b\x8f\x7d\x85\x48\x28\xa7\x75\xa5\x87\xb5\xc3\x1d\x12\xab\xe9\xf9\x94\x8d\xbf\x8b\x52\x12\x88\x0e\x36\xf1\x22\x57\x65\xcc\xd0\xdb\x28\xc6\x66\xea\xad\xa4\x2a\xdb\x80\x51\x79\xc7\x70\x28\x74\x12\xf0\x0d\xe7\x8b\x6a\x1a\x59\xe6\x46\xf8\xfb\x08\xb9\x7b\x8b\xad\xe9\xa1\xe6\xb3\xcf\xa6\xda\x40\x59\x16\x89\xde\xb7\x32\xd6\xa4\x76\x35\x27\xa4\xb2\x5b\x1d\x91\x6a\x78\xdd\x16\x85\x67\x14\x0b\x42\x90\x30\xf8\x2e\x68\x2a\x0e\x33\x9c\x4f\xea\x29\xc9\x0a\x65\x89\x7e\x1a\x76\x18\x5f\xf7\x4c\x02\x97\x05\x57\xc5\xce\xc9\x31\x39\xdd\xd8\x5f\x04\x4a\x78\xa3\xca\xce\x05\x22\xb9\x7c\xbd\x23\x5c\x96\x45\x79\x44\x49\x00\x6d\x70\xe5\xb3\x23\xdf\x81\xbd\x94\xfa\x46\xc5\x80\x23\xf7\xc0\xc5\xe0\x17\x8a\xc5\xa3\x18\xec\x53\x0c\x18\xa9\x67\x6c\xc2\xd2\x26\x0c\xb4\x00\xbc\x57\xe7\x8b\x01\xcc\x51\xaf\xd2\xd9\x3c\x23\xe1\xa3\x85\x1f\xf0\x97\x1a\x26\xfb\x71\xde\x76\x61\x96\x9a\xa6\x09\x7e\x85\x33\xb4\x8e\x9e\xff\xf9\xe4\xc4\x52\x60\x5e\x54\x29\x25\x62\x58\x17\xf3\xa0\x4c\x27\xd3\x3a\xd4\xb9\x75\x34\x0a\x3e\x4e\x8b\x15\x03\xf7\xa9\xca\xc8\x1c\x72\xfa\xba\x26\xa2\x05\x7a\x88\x62\x7d\xaf\x1c\xe1\xe5\x20\xa0\xef\x47\x06\x01\x86\x87\x88\xbd\x45\x3d\x36\x69\x46\xdc\xc6\x37\x0b\x12\x2b\xc7\x25\xc6\xf0\x8e\x2d\x12\xeb\x27\xa2\xb0\x9a\x85\xf4\x5d\x44\x9b\xf3\xa5\x0a\x75\xe9\x6c\xde\xd4\x30\x90\x4d\x9e\x69\xd7\xc1\x8c\x03\x03\xd0\xf6\xf5\x8a\xc9\x93\x35\x26\x63\x85\x6a\xfc\x5b\x99\x8d\x61\x11\xc9\x6c\x0e\xef\xa0\xeb\x6a\x04\x9e\xee\x68\x99\xe2\x55\x35\x92\x91\x4b\x18\x18\xd3\x7a\x96\x85\x96\xd6\x50\x49\x1c\xd4\xd7\x4b\xd2\xc2\x38\x20\xe4\x31\x0a\x5c\x11\x2a\x54\x94\x0a\x63\x95\x28\x66\x51\x60\xfb\xac\x1a\x5b\xb9\xda\x2d\x34\x52\x6c\x30\x56\x96\x91\x38\x6b\x34\x4b\x45\xc6\xf2\xc2\x11\x77\x79\x2e\x96\xe3\x66\x85\x8c\x5b\x57\x00\x6f\x8c\x39\x8b\x38\x4b\xc9\xd4\x25\x30\xc8\xb7\xee\x4a\x6c\xfa\xe5\x6d\xf5\xa1\xa8\xaa\xf4\x32\x23\xb8\x46\xef\x2f\x41\x39\x0e\xaf\xf1\xba\x09\x79\x86\xb4\xb1\x63\xa1\x20\xff\x1a\x7c\x6f\x0a\x13\x24\x5d\xf1\x2b\xb7\x0e\x25\xde\x28\xb2\xae\x88\x09\x0c\x1a\x9d\xd2\xce\xf1\xea\x27\x20\x83\xc7\xe4\x04\x03\xfa\x93\x52\xed\x5c\x4c\x8d\xcb\xd4\xf1\x55\x78\x02\x90\xa7\x4a\x93\x20\x73\x6a\xc6\x53\x31\x5d\x4f\x14\x4a\x9a\xa3\x8c\x01\xec\x86\x97\x8d\x81\x0d\x6c\xa5\x85\x73\x5f\x38\xfb\x42\x3f\x73\x95\x0a\xd1\x40\x36\x0d\xfd\xa8\x6d\x1a\xd2\xfd\xf1\xb4\xcd\xd1\x91\x9f\xaa\xe9\x2e\x69\xea\x17\xea\x17\xdd\xad\xf6\xb8\xbd\x42\xf0\x11\x29\xc7\xda\x2d\xd9\xba\xcf\xdb\x22\xf7\x6d\xdc\x3b\xd7\x77\x6c\x27\x70\x12\x76\x87\x2e\x6f\xf7\x6d\xab\x81\xe5\x48\xdf\x12\xc5\xdc\x65\xd5\xc5\x31\x8a\x7a\x20\xe1\xd8\x24\xad\x6a\x5c\xbe\xc3\x32\xfb\x75\x68\x0d\x65\x59\x8d\xda\x16\x7b\xe6\xd5\x8c\xba\x16\x67\x6c\x79\xa1\x23\x35\xf3\x00\x3c\x05\x3a\x0e\x0e\xc5\xe0\x9e\x93\xbd\x37\x0f\x41\x02\x77\xaf\x1e\x82\xb1\x8e\xf4\x10\xfc\x84\x47\xc5\x75\x6f\x66\x1f\x38\xae\xbb\xc4\xa3\xae\xe2\x69\x23\xbf\x64\x73\xf5\xe3\x29\xc0\xfa\x4a\x6e\xd9\x17\x39\x90\x49\x86\x3d\x88\xf3\x03\xf1\xf9\x0f\xc4\x07\xe9\x9d\x22\xf0\x94\xe9\xb3\xbe\x76\xfa\xe5\xb9\xb7\x89\x7e\x59\xe6\x4d\x3c\x00\x9f\x83\xd3\xfd\xe1\x78\x1d\x0a\xc0\x7b\xf5\x3b\x94\x5d\x24\xdf\xa8\xcf\xf1\xa8\xa4\x7c\xfd\x8d\xaf\x8e\x37\x20\x90\x1e\x75\x93\xaf\x8f\xb1\x8d\xba\xf1\x11\x57\x63\xef\x96\xec\x63\x5c\x92\x87\xdf\xa2\xf0\x5e\xea\x48\xdf\xa1\x00\x3b\xc6\x59\x88\xa6\x87\x9c\xfa\xc9\x68\xbf\x13\xc1\xc4\x13\x68\x61\x7f\xee\x29\x9c\x12\x07\xd9\xdb\xe9\x17\xce\xde\x26\xfa\x85\x53\x6a\xc2\x94\x83\x2e\xe1\x84\xb4\x93\x35\x95\x8d\x17\xa1\xd8\x56\x90\x85\xa7\xde\xce\x45\x8b\x6b\x23\xf8\x30\x3e\xfe\x36\xdb\x44\xd7\x0a\xa2\x2f\x2c\x26\x10\x1b\x78\x3a\x6e\x58\x7c\x14\x93\xba\x3b\x74\x87\x5e\x84\xd2\x48\xbb\x3c\xbc\x78\x57\xd4\xd3\x34\x9f\x18\x0b\x3f\x6e\x9e\x1c\x94\xee\xba\x52\xe8\xf2\xed\x2a\x2e\x97\x63\xc1\xb4\x96\x60\x4c\x41\x0d\x3f\x0d\xd6\x0c\xb2\x9f\xa2\xea\x29\xde\xaf\x94\xb4\xed\xc7\x7b\x52\x4c\x3e\xd1\x8a\xa0\x93\xb6\xe9\x5f\xed\xbe\x5f\x28\xe5\x0d\xd8\x8f\x22\x29\x44\x72\x22\x51\xe5\x8f\x2e\x90\x8c\x16\x87\x29\x8e\xca\xde\xfe\x7b\x14\x46\x46\xa3\x5b\x88\xa2\x79\xb2\x81\xd1\xaf\x72\x58\x0a\x2c\x86\x7f\x49\x33\xa4\x15\xee\xac\x84\x0d\x5e\xe7\x1e\x9b\x69\x5d\xcf\xab\xf1\x68\xb4\x5a\xad\x1a\x8d\x32\x24\x32\x31\x62\x61\xf0\xa8\x80\x3d\x6e\x7f\x8b\xb3\x94\x70\xf9\x45\x9a\x9c\x86\x56\xec\xbe\xe3\xc0\x35\x4d\xb0\x0a\x69\xe2\x28\x1d\xfe\x49\x2c\xf1\xbb\xa8\xd7\x73\x7c\x1a\x17\x09\x26\x59\x49\x5a\xe2\xb8\xbe\x58\x94\xa9\xb3\x1f\x03\x11\xe6\x42\xb9\xfa\x69\x6c\xeb\x05\x91\xaf\xec\x12\xc5\xd7\xda\x9e\x22\x8f\x71\x51\x78\xec\x6e\x47\x85\xb1\x15\x1d\x0f\x32\x18\x69\xce\x86\x63\x24\xf6\x1d\xe2\x91\x3c\x32\xed\x28\xb0\x6a\x62\x0c\x36\x43\xb8\xf3\x44\x85\x48\xd9\xf1\x38\x20\x5a\x8f\xf4\xb0\x5f\x93\x01\x3d\xca\x27\x1b\x55\xfc\x44\xa3\xe6\x6c\x22\xaa\x1d\x07\x81\x02\x99\x95\xd8\xb4\x26\xd7\x1b\x04\x24\xcb\x6c\x80\xdc\x84\x29\xc6\xbc\x01\xa6\xc3\x48\x03\xec\xc2\x59\x2e\x46\x79\x8c\xe1\x44\x07\x76\xe1\x6e\x8f\x6d\xc3\x52\x0f\x30\xd2\xca\x94\x78\x56\x2c\x31\x3d\x5e\x07\x2e\x3a\xca\xd1\xf5\xba\xc6\x49\x40\xf2\xd0\x88\x98\x43\xc1\x96\x94\xff\xe7\xc7\xf7\xef\x86\xf4\x50\x85\x88\x5e\xc2\x11\x5e\xf9\x24\xbd\x5a\xab\x83\x6e\x5b\x5d\xcc\x8f\xf7\x6a\xd6\x44\x6b\x5c\xd2\x8a\xc2\x12\x65\x0b\x32\x78\xd7\x78\xdd\x35\x71\x7b\x56\x96\x68\x3d\x4c\x2b\xfa\xcb\xea\x1c\x77\x29\x61\xbd\x7b\xde\x8b\xd4\xed\x20\x48\xfb\xe2\x22\x3a\x5d\xab\x93\xf1\x13\x81\xf4\xbc\xaf\xa6\x8d\xfe\xb4\x62\x87\x39\x10\xc9\x1d\xd8\x39\x5b\xfd\xb4\x3c\xef\xdd\xb5\x77\xe3\x5a\xb9\x6c\xcd\xa5\x87\x1e\x00\xfe\xa0\x8b\x8b\xab\x80\xd2\x8f\xed\xfb\x29\xe8\xca\x43\xe7\xb6\x1f\x27\x90\x04\x42\x65\xd1\x22\x1d\x43\x07\x4c\x7d\x87\x2e\xb8\x3a\xa0\x8d\x7a\x9f\x73\x60\x23\x09\x6f\xd9\xd8\xc6\x8d\x54\x45\xe0\x9c\xe6\xe3\x2a\x20\xea\xd5\x3d\x90\x64\x7d\x53\xa1\xa5\xaa\x6d\x22\x2b\x28\xf6\x25\x9d\x90\x36\xd9\xdb\xd6\x60\xdb\xf8\xd4\x4c\x23\x75\x6d\xe4\x84\x64\xec\x8c\x97\x36\x2d\x6b\x0f\xc4\xd6\x65\x95\x10\xee\x0d\xcc\xee\x2e\xe8\x36\x07\x8a\xcd\x66\xdc\x7d\xd3\x31\x4a\x02\x5d\xf7\x7c\xec\xbe\xf0\x74\xe0\x67\x55\xc1\x8e\x26\x7c\x46\xad\x0b\x79\x66\x31\x9c\xc8\xf7\x58\x41\x0d\xd3\x9d\xf2\xe3\x1d\xf0\xd7\x26\x2c\x6f\xe7\x2a\xf5\x6e\xfb\x18\xb5\xf3\x70\x26\xf8\x55\x50\x0b\x07\x9a\xdf\x73\xbe\x5d\x18\xab\x34\xba\x51\x34\x8b\x6d\xf3\xb6\x3b\x0d\x68\x9b\x00\xd3\x1e\xfb\x29\xa0\x8f\xd5\xdb\xde\x40\xaf\xdf\xc5\xb5\x9d\xf7\x15\xdd\x81\x3b\x4b\x97\xa5\x2a\xbd\xf6\x3b\xb7\xec\x84\x0a\xbe\xc0\x86\xd7\xb5\x09\x17\xdd\x15\x5c\x97\x59\x73\x6c\xa6\x5e\x00\x1e\x12\x3b\x5a\xe1\xfa\xdf\x28\x4b\x13\xe1\xf9\x69\x39\x8e\x7a\x6a\xff\x8d\xff\x3b\x5f\x25\x8e\x0a\x8d\x03\xdc\x1f\x4a\x7b\x29\xb9\x2e\x43\xac\xa1\xe0\xee\x88\xfb\xe6\x74\xf4\xe7\x1c\x17\xe2\x37\xce\x86\xf1\xa2\x2c\xc9\xe8\x0b\xfc\x86\xcf\x44\x83\x69\xbd\x8e\xc2\x34\x8f\x8b\x92\x06\xa7\xa2\x12\x11\x51\xba\x6f\xcf\xa1\xb5\x9d\xfd\xe4\x78\xe5\xe8\x43\x14\xbb\xa8\x8b\xe2\x82\xf0\x49\x59\xef\xb1\x0f\xd0\x4d\x28\xcd\xab\x0b\xe9\x70\xb8\xbd\xf7\x45\x57\x74\xef\xb1\xa3\x12\x13\x6c\xf6\x39\x32\x97\x25\x26\x01\x46\x57\x17\x5d\xa6\xb8\x11\x18\x27\x7b\xaa\x32\x16\xb5\xc2\xcf\x04\x4a\x65\x51\x9e\x2b\x81\x7d\xac\x9b\xe1\x4e\xbf\xbd\x91\x39\xd8\x94\xd7\x65\x19\x35\x43\xe7\x69\x71\x11\xd1\x68\xb5\x65\xa7\xa0\x9e\x86\x31\x31\xfa\xd7\xef\x17\x75\x45\xc0\xf8\xb5\x78\x99\x15\x24\x02\xa5\xd4\xed\xaf\x5a\xa7\x75\x86\xa3\xb0\x51\x4a\x8c\x7e\x49\xe8\x53\x55\xda\xaf\x18\xfe\xa7\x58\x94\x81\x18\xe5\x60\x8a\xaa\xe0\x12\x14\x3d\x63\x92\x64\xe8\xd3\x1e\x2a\x53\x44\x5f\xa3\x6d\x07\x4e\x71\x1d\x85\x14\x75\x2f\xd8\x5b\x5b\xcd\x6d\x96\xb3\x8e\x73\x0b\xab\xa4\x95\xc5\x5c\x5c\x17\xb7\x54\xab\xb4\x8e\xa7\x6d\x59\xbe\x33\xb5\xcf\xc7\x8b\x11\x09\xed\x7e\x78\xfe\xdc\xbe\xc8\xa5\xb3\x03\xb6\x07\x04\xf6\xc3\xfa\x04\xe0\x4d\x67\x36\x55\xdd\xdf\xbb\x48\x3b\xb5\x0f\x74\x5f\x4d\x8f\x87\x2a\x27\xd0\x2e\xd7\x7e\xc5\x19\xaa\x16\x8b\xe1\x87\xaa\x56\xdd\x34\x06\xb7\x6b\x86\xe9\xf9\xad\xda\xe0\x2a\x7c\xab\xba\x8d\x76\xde\xc5\x78\x3b\xad\x81\x85\x41\xf7\x36\xd4\xdd\xd3\x43\x9e\x0d\x25\xf8\x0a\x2d\xb2\xba\x9f\x28\xce\xe9\x68\x8a\xa4\xbc\x31\xbf\x73\x5f\xfa\x06\xe0\xf5\xaf\x25\xb0\x97\xdc\x5b\x24\x26\x8e\xc9\x55\x0d\x31\xc9\xe9\xf2\xdb\x2d\xc1\x59\xdb\xe1\x59\x05\xd1\x19\x31\xd4\xb6\x98\xcb\x23\x84\xa3\x9e\xad\xda\xf3\x1d\x85\x70\x73\x54\xd2\x96\xc4\xf4\x2a\xce\xf0\x0c\x2c\x4e\x52\xc4\x0b\xb8\x18\x5e\x16\xc9\xfa\xd8\x82\xbb\x69\xcf\xc7\xd4\x5d\xda\xe3\x6b\x4f\x75\xbc\xac\x3b\x13\xf8\xb3\x8d\x62\xc7\x96\x6a\xf2\x69\x01\xca\x81\xcf\x87\x1a\x34\x8a\x80\x11\x20\xdd\x3e\x58\x84\x89\x4a\x38\xe7\x1a\xd9\x5e\x3a\xec\x29\xca\xb3\x76\x73\x95\x96\x55\xcd\x3f\xfb\xc0\x96\x3f\x88\x0c\x47\x85\x0c\xa9\xe5\xc5\x7d\x57\x14\x09\x68\xf6\xf8\xe6\x40\x4e\xea\x97\x0f\x35\xe7\xbc\x81\x67\xa0\xf6\xef\x3f\x67\xe6\xe3\x86\xa9\x64\xec\x73\xdc\x21\x35\xcb\x45\x24\x02\xaa\x00\xfb\xd5\x97\xe8\xa9\xe0\x77\xb0\x13\x6a\xed\x78\xdd\xa7\xfa\xce\x1b\x45\xf1\x47\xd2\xda\x3d\x73\x7b\xed\xd0\xd8\x34\x35\xe4\xdf\x72\x86\x4f\x3a\x6a\x9f\xe9\x67\xeb\x7e\xef\x3d\x2c\x83\x86\x36\xc4\x47\x75\xf4\x33\x96\x76\xc2\xdd\xca\x27\x88\x04\xab\x37\x27\xf9\x4b\xd8\x91\x5b\x89\x0a\x77\xca\xf3\x46\xbf\x0f\x65\xce\xb9\x7f\x81\xb5\x44\x60\x65\xaf\x78\x2f\xbf\x12\x75\x9b\xa3\x2c\x5b\xf7\x4c\xce\x34\xc3\x2b\x4c\xa4\xb8\xd7\xf8\xcc\x38\xc1\xcb\xc6\xfa\x3e\xae\x82\xf4\x09\x28\xe6\x35\xf4\xcb\x09\x24\x3f\xef\x81\xb6\x05\x1f\xf9\x80\x5f\x47\x19\xa9\x23\xf8\xa8\x43\x7b\xe7\xf2\x37\x40\x33\x81\x11\x82\x5f\x97\xd7\xb2\xb8\x9c\xa5\x70\x90\x15\xbb\x70\x94\x5a\x36\xd3\xc5\xff\xc2\x6b\xc7\xf9\xfd\xbe\x33\xe6\x46\x45\xe3\x13\x17\xc6\x40\x59\x3f\x75\x21\x92\xa4\x15\xdd\xfe\x43\x85\x73\xb6\x21\x9c\x9d\x8a\xca\x82\xb6\x97\x45\x82\xd5\xa3\xad\x29\xf5\x37\x77\x43\x5e\x79\xbc\xc2\xb3\xd2\x51\x6e\x40\x7a\x78\x8d\xd7\xdd\x9e\x86\xe0\x44\x38\x80\x4c\x5c\x47\xf4\xcc\x4f\xba\xac\xa9\x9c\xf1\xe5\x24\xdf\x9f\x9c\x9c\x74\xbd\xc7\xdb\x74\x6a\x0b\xd2\x26\x53\x9c\x6a\x79\xdf\x69\x4e\x48\xb7\x98\xea\xa4\xd5\xf9\x74\xe7\x6b\x1a\x8e\xfb\xd6\x91\xe7\x39\xdf\xa0\x14\xbe\x87\x51\x17\x01\xf0\x0e\xd3\x15\x9c\x6f\x02\x58\x83\x37\x0c\x3e\x64\x18\x26\x55\xea\x72\x1d\xa0\x09\x4a\xf3\x00\x94\x7d\xe9\x33\x03\x4a\x7b\x93\x66\x41\x29\x94\xc1\x6a\x4a\x7a\xa4\xbd\xa5\xf9\xc4\xec\xd0\xb7\xdd\x4d\xa6\x47\x19\xda\xfe\x53\xa4\x90\x76\xb9\xda\x80\x8e\xea\xad\x62\xa0\xae\xd6\x75\x79\xb8\x9d\x0a\xd9\xf0\x0c\xeb\xa6\x9e\xaa\x33\xaa\x45\x1c\xe3\xaa\x8a\x38\x5c\x5d\x67\x6e\xcb\x89\x3a\x4b\xd5\x4b\x51\xa9\xff\x84\x46\x48\xfa\x62\x2f\xad\x4f\x59\xf8\xf9\x39\x04\x5c\x01\x7a\x4c\x22\xc3\x1a\x28\x6e\xb3\x4e\x4f\x25\x13\xe6\x3b\x01\xad\x22\xd3\xbd\x44\x4b\xa4\xee\x29\x3e\xd7\x42\x2e\x19\x62\xa9\x57\x1f\x40\xed\x67\x8b\x7f\x6a\xb1\x3d\x17\x21\x5e\xa7\x31\xd6\x13\x67\xfb\x6d\x97\xbb\x6d\x2a\x65\xcc\xb2\xdf\x8e\xf9\xe5\x28\xc1\x16\xd2\x73\x57\xc8\x34\x72\x3c\xaf\x9a\x55\xa0\xc3\xfc\x4d\xab\xef\xde\xac\x1d\x0e\x4d\xef\xb0\x6c\x65\x2e\x81\xed\xb4\xb9\x60\x2a\x32\x3f\x3c\x7f\xbe\xc1\x6a\x49\x3a\xcf\xce\x89\x68\xbc\x4f\xa1\x04\xbf\xa0\x36\xc2\x6f\x7a\x7d\x77\xbc\xb5\xe1\x62\x02\x7f\x34\x9c\xef\x8c\xed\x77\xee\xc8\x96\x9e\xcc\xa7\x45\xb6\xca\x39\xa5\x87\x1d\xda\x3a\x03\xda\x26\x90\x95\x91\x69\x22\x59\xe9\x78\xc4\x3b\x8d\x64\xa5\x7e\x0f\x22\x92\xed\x0f\x53\xd5\xd3\x00\xe5\xbb\x43\x0b\x54\xad\x63\x1a\xd9\x03\x54\x0f\x46\x87\xb4\xbb\xd8\x54\x39\xbf\xf5\x34\xe8\x3e\x90\xd0\x3b\x3a\xb5\xc5\x9d\xf7\x16\x3f\x52\xfa\xcb\xf1\xe3\xb7\x1d\x3b\x3e\x7f\x8c\x1d\x77\x14\x3b\x92\x91\x57\xc3\x39\x2a\x1d\x77\x1a\x40\xb2\x65\x06\x8f\x11\xe4\x7d\x47\x90\x3d\x3a\xa4\xdb\x97\xde\x38\xc8\xdc\x44\xc7\xc8\x7e\x2a\x55\x29\xf1\x6d\x22\x25\xf6\x7d\x1f\x9b\x3b\xde\x7f\x76\xb5\x9c\x6e\x1b\x2a\x6d\xa3\xa4\x46\x23\x26\xb1\xed\x2c\x26\xfe\x32\x4f\x4b\x6c\xdf\xa1\x29\x92\xaf\x95\x57\xc0\xdb\xc8\xc3\x65\xff\x19\xbb\x36\x58\x75\x1c\x3c\xdd\xbd\x46\x5a\xe2\xd8\x41\xb3\x03\xda\xe6\x37\x0c\x8c\x63\x90\x65\x02\x8e\x46\x6f\xaf\x80\x5c\x25\x26\x21\x7d\x80\x72\xad\x6a\x5a\xd3\xec\xac\xc4\x28\x59\xd3\x97\xc6\xc9\x00\x58\x2d\x2f\x88\x37\x0e\x2e\x47\x30\xc3\x28\xaf\xc8\x25\xaa\x03\x14\xe4\x78\x15\xc0\x17\xa0\x53\x58\x55\x08\x4a\x4c\x9c\xb4\x82\x93\x61\x2b\xa7\x3c\x98\xf0\x72\x39\x94\xad\x8c\x2d\x0a\xcd\x17\xbe\xdb\xac\x17\x46\x07\xea\x51\x26\x86\xff\xc3\x4b\x39\xbc\x23\x69\xcb\xa2\xf4\x6e\x5d\x7b\xad\xde\xb7\xff\x91\x3f\x6f\x59\x91\x2e\x2f\xaa\xe8\x6e\x39\xa3\x11\xba\xbf\x1c\x1a\xa1\x17\x26\x24\xae\xcd\x98\x0a\x89\x28\x3f\x9d\x35\x44\x35\x33\x5f\x3c\xb1\x7d\x6e\x8a\xab\x57\x55\xb0\xba\xd4\xaf\xf5\xa3\x55\x14\xf0\xa8\xff\x63\x12\xa0\xab\xe4\x3d\x73\x16\x86\xa0\x91\xe6\x33\xb6\x26\xb2\x3d\xf9\xff\x24\x38\xfe\xca\xbf\xac\xa6\xca\x59\x17\xa1\x55\x19\x75\x9c\x03\x24\x93\x28\xd2\x9c\x42\x0d\x17\xaf\x03\xb1\xfa\x0c\x1a\x5d\xcf\xf0\x55\xe1\x50\x7a\x48\x41\x20\x8e\xa8\x19\xdb\xfa\x56\x03\x1c\xab\xfa\xb1\x05\x63\xea\xc7\x5a\xec\xa0\x31\xfb\x21\x7d\x89\x25\x80\x49\x8e\x93\xbf\x74\x1c\xd1\xe7\x20\xfa\x30\x59\xc0\xe6\x26\xc6\xac\x9b\x7c\x03\x15\x12\x76\x9d\xcf\xde\x01\xc6\x7e\xbe\x30\xa3\xe6\xf4\xb0\x3e\xdf\x1d\x59\x64\xc9\x4f\x6c\xd4\xc4\x30\x3e\x08\x39\x60\xd0\x3b\x44\xa0\x17\xa7\xfd\xc9\x83\xd9\xf5\xd8\x01\xc4\xa3\x60\xd8\xc0\x38\x00\xc1\x30\x0d\x10\xb3\x0f\xba\xa9\xd9\x8a\x6f\x2d\x8d\xeb\xf1\xfc\xfe\x98\x33\xd3\x39\xf3\xe8\x68\xa7\x7c\x77\xeb\xd1\xeb\x1b\x27\xc3\x17\xfd\x51\x3e\x5c\x68\x33\x6f\x54\x77\x40\x81\xac\x03\xed\xc8\x9c\x81\x76\x26\xce\x40\x3b\xf4\x06\xdc\x52\xc3\xa9\x81\x63\x82\xc5\xa7\x49\x1f\x96\x7f\x67\x94\xba\xad\x83\x67\x9c\x8c\x71\x37\x7e\x9c\x45\x42\x1c\x76\x0b\x4a\xee\xcd\x5e\xa9\xbc\x64\xba\x68\x14\xcc\x3e\x69\x6f\x42\xc8\xdd\xb9\x67\xec\x00\x29\xf8\xff\x68\x75\x18\x18\x07\x60\x75\x3a\xdc\xb1\x03\x61\x67\x55\x15\xf6\xb8\x5b\x77\xc0\xdb\xdd\xae\xd6\x23\x93\xeb\x60\x1c\x00\x93\xf3\xe3\x2e\x6e\xe7\x4e\xa9\x26\x78\x63\xf7\xe9\x76\x4c\xf7\x2d\xb8\x50\x37\xc7\x60\x4e\xff\x0f\x09\x46\x65\x95\x1c\x96\x00\x00")
 
 func userControllerJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -746,12 +918,12 @@ func userControllerJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/controller.js", size: 24561, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "user/controller.js", size: 38428, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _userDirectivesAuthorizationdetailsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x57\x5b\x6f\x9b\x30\x14\x7e\xdf\xaf\x70\x79\x28\xad\x5a\x9a\xf7\x28\x64\xda\xa4\x6d\x4f\xbb\xbc\x4e\x55\x1f\x0c\x1c\x1a\xab\x60\x33\xdb\x54\xeb\xa2\xfc\xf7\xf9\x82\x83\x4d\x20\xbd\x2e\x0b\x52\xdb\xc4\xe7\xfe\x9d\xef\xb3\xca\xa2\x2e\x92\x8a\x08\x99\x10\x09\xf5\xf2\x1d\x52\xcf\xa2\xb1\x7f\xcd\x67\x82\xf2\x0a\x0b\x91\x46\x25\x46\x25\x4e\x5a\x01\x3c\xea\xcd\xc6\x45\x65\x90\x8c\x55\x92\x34\xcb\x9f\xac\xe5\x48\xfb\x50\x5c\xc3\x62\xe6\x59\xfa\x8c\x33\xd2\x7f\x09\xfc\x6d\xf1\x99\xf2\x35\x91\x5e\x57\x41\x93\x88\xde\x26\x1c\x1a\xc0\x32\x8d\xce\x2a\x9c\x41\x75\x89\xc8\x39\x22\x14\x71\xf8\xd5\x82\x90\x50\x5c\x31\x7e\x8b\x29\xf9\x83\x25\x61\x54\x44\xdb\xb1\xc6\xa6\x11\xde\x38\xfe\x28\xdf\xbd\x14\xa8\x86\x3a\x53\xae\x2b\xd2\xec\x0e\x15\x0c\xf4\xd5\x38\x22\x56\xa2\xf5\x1a\xcd\xe7\xa6\x3d\xb4\xd9\xf4\xa3\xb9\x32\xf9\x0a\xf2\xbb\x8c\xfd\x76\x0d\xa9\x23\x01\x39\xa3\x05\xe6\x0f\x51\x80\xaf\x79\xd4\xcc\x35\x2b\xa0\x4a\xa3\x89\x21\xaf\x4d\xa9\x9b\x68\x69\x1a\x74\xd9\x9f\x01\xa5\x6d\x35\x40\x11\x6a\x4c\xaa\xe8\x71\x52\x60\xb9\x87\x12\x9f\x74\x12\x84\x8b\x82\x83\x10\x4f\xa0\x44\xe8\x2f\x1a\x4c\x75\x97\xa4\x74\x1d\x9e\x9e\x22\xfb\xe1\x24\x4d\x51\xac\x9c\x69\x3c\xa8\x3e\xf6\x94\x8c\x87\x1b\x59\xcc\x74\xea\xe5\xee\x62\x08\x6d\x5a\x99\xa8\x4d\x48\x95\x1a\xb8\xd7\xa5\x09\xb5\xf3\x2c\x66\xf6\x4b\xc0\x1c\x01\x15\xe4\xd2\x5b\x15\x6e\xe5\x8a\x71\xb7\x21\x0b\xe7\x76\x4f\x3b\x80\xb1\xc6\x50\xed\x1e\x57\x2d\xa4\x51\xb4\xfc\xc6\xa8\x95\x90\x35\x4c\x06\x8c\xc8\xc1\x54\x32\x92\xd0\x0c\xef\xf6\xe8\x32\xaf\xd7\x1d\x0a\x9b\xcd\x08\xd1\xb6\x74\xcb\x08\x2d\xd2\x68\x3e\xef\x48\x30\xda\x89\x39\xb4\x63\x3b\x24\xc7\x10\x7c\x0d\x07\x9b\x95\x82\x61\x5a\xc1\xbe\x79\xc8\xbc\x1f\xda\x86\x68\xab\x15\x39\x2e\x5b\xe4\xbb\xa0\x23\x24\x5b\xd0\x9f\x4a\x39\x60\x94\x99\xfe\x3a\x86\x7b\x50\x57\x14\xe3\x80\x44\x8e\xeb\xf8\xc6\x54\x7c\x01\x41\x6d\xba\x43\x10\xd4\x54\xea\x09\x6a\xb7\x38\x46\xd0\x5d\xb0\x3d\x60\x2f\x50\x8c\x12\xf5\x73\x61\xf3\xb9\x7b\x36\x60\xe7\x7f\xa0\x6c\x77\x77\x4d\x93\x16\xe8\x3d\x54\xac\x81\x84\x4d\x30\xf7\xc3\xd4\x6d\x69\x48\xdb\x59\x8f\x91\xaf\xdb\xc6\x5f\xc0\xbe\x0e\xb6\x83\xf0\xaf\xab\xd5\x33\xd0\xed\xec\x15\x1c\xec\x52\x5c\x09\xc9\x01\xe4\x4e\xcc\x05\x3a\x73\x1e\x94\xbf\x57\x11\x7e\x0c\xe5\x68\x8e\xe2\xf8\x7c\x5f\x54\x4e\xe4\xc3\x30\x4e\x9f\x4d\x44\x1e\x89\x18\x32\x4c\xef\xa6\x95\xe0\x59\xdd\xd2\x1c\xdf\x3f\x2a\x13\xc2\x79\xce\x5a\x2a\x1f\xf9\xa7\xcb\x77\x1d\x53\xc5\x89\xd2\x42\xec\x6b\xe3\xe9\xd2\xd0\xcf\xdb\xc9\x23\x9c\xe9\x05\x1a\xd1\x78\x1d\x44\x20\xba\x50\xaf\x0e\xb3\xa6\x57\x48\x43\xc7\x5f\x65\x24\xd7\x47\x97\xfd\x09\x51\xbf\x07\x47\x06\x1a\xfe\x70\x50\xf6\x6a\xa2\xf4\x84\xbd\x25\x72\xd5\x66\xd3\x94\x0d\xec\x43\xd2\x7e\x31\xc6\x27\xd2\xd6\xbc\xfc\x84\x11\x6f\xf8\x9e\x30\x60\x8e\x6b\xfb\xb9\xaf\x07\x21\x38\x25\xce\x21\x63\x6c\x8f\xa2\x9d\x47\xc2\xca\x92\xe4\x04\x57\x13\x48\x7d\xee\xfc\x9e\x83\xd5\x30\xe6\xdf\xa1\xd5\xcf\xb9\x1f\xaf\xbf\x01\x00\x00\xff\xff\x36\xe4\x6e\x69\x3e\x0f\x00\x00")
+var _userDirectivesAuthorizationdetailsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x97\x5d\x6f\x9b\x30\x14\x86\xef\xfb\x2b\x4e\xb9\x28\xab\x5a\x9a\x6a\x97\x19\x30\x75\xd2\xb6\xab\x7d\xdc\x4e\x55\x2f\x0c\x1c\x1a\xab\x60\x33\xdb\x74\xcd\xa2\xfc\xf7\x19\x03\x05\x13\xc8\xd2\xb4\x4b\x87\xd4\x2a\xf1\xf9\xf4\xeb\xe7\x58\xc1\x97\x6a\x99\x61\x78\x04\xfa\x21\xa5\x5a\x70\x41\x7f\x13\x45\x39\xf3\x12\x54\x84\x66\x12\xf2\xc4\x93\x98\x61\xac\xbc\x7b\x92\x95\x08\x21\xc8\x82\x30\x58\x99\x90\xea\xf9\x45\x13\xb5\x98\xc3\xdb\xcb\xcb\xe2\xe1\x9d\x59\x5d\x1f\xf9\xb3\x26\xaf\xaf\xc3\x33\x2a\x95\x47\x15\xe6\x75\x19\xbf\x08\x1f\x63\x7d\x0a\x71\x46\xa4\x0c\x9c\x94\x40\x4a\xbc\x52\xa2\x70\x3a\xb3\x71\xd1\x19\x14\xe7\x99\xa2\x45\xf8\x83\x97\x02\x2a\x1f\x46\x72\xf4\x67\x3d\x4b\x97\x71\x46\xbb\x2f\x96\x7f\x5d\x7c\xa6\x7d\x4d\x64\xaf\x2b\xab\x49\x60\xb7\x1e\x4d\x03\x47\xe0\xcf\x12\xa5\xc2\xe4\xa2\x0a\x76\x5e\xa6\xf7\xa7\xf4\x6d\xf7\xdc\xa6\x8b\x17\x18\xdf\x45\xfc\xa1\xad\x6d\x8e\x27\xe6\x2c\x21\x62\xe9\x54\xbd\xe7\x3c\xc1\x2c\x70\xac\xc3\x94\xcd\x1e\x4c\xe9\x36\xc3\x0e\x3a\x08\x2c\x90\xa8\xc0\x79\x93\x91\x08\xb3\x73\xa0\xa7\x40\x19\x74\xca\x70\x71\x4b\x58\x5b\xa3\x93\x68\x4c\x19\xd9\x93\xa6\x2f\xcb\xb7\x5e\x0a\xc8\x31\x8f\xb4\xeb\x82\x16\x9b\x22\x59\x02\x7d\x31\x8e\xc0\x53\x58\xad\x60\x3e\x37\xed\xc1\x7a\xbd\xbf\x5c\x13\x7b\xba\x36\x99\x6f\xf6\x57\xae\xee\xcc\x12\x0d\x73\x3d\x57\x3b\xf0\x44\xd4\x16\x9a\x3e\x56\x49\x80\x24\x89\x40\x29\x77\x20\xca\xf2\x07\xdf\x8c\x70\x03\x7a\xdd\xe2\xc9\x09\xd4\x1f\x8e\x83\x00\x5c\xed\xcd\x5c\x27\x4c\xb9\xb0\x05\xd6\x73\xad\x23\xc3\x4d\x9d\x29\x2b\x4a\xe5\x69\x61\xf5\xa5\xc1\x50\xf4\xba\x30\xa1\x75\xbf\xfe\xac\xfe\x62\x81\x50\x5f\x2e\xd3\xe4\x1a\xb9\x1e\xcf\x61\x43\x10\x5e\x18\x72\xcc\xdd\x14\x38\x4e\xf8\x95\xb3\x7a\xc2\x6a\xc3\x64\xc0\x08\xdd\xa6\x92\x21\xbc\x02\xb6\x39\xa7\x36\xf3\x6a\xd5\xa8\xb0\x5e\x1b\x6e\x22\xca\x92\xc0\x99\xcf\x9b\xd3\x1c\x2d\x69\x16\xeb\xfd\xb5\x92\x8d\x49\xf5\x1c\x98\x8a\x85\xde\xef\xf4\xe4\xf5\xcd\x43\x84\xbe\x57\x36\x60\x65\x35\x49\xe3\xe3\x06\x7d\x97\x57\x80\xc6\x2a\xaf\x53\x0e\xc8\x30\x9b\xbb\x76\xf1\x1e\xf5\xcd\xc1\x05\x82\x8c\x49\xee\xde\x98\x8a\x7b\x80\x56\xa7\x3b\x04\x68\xa6\x52\x07\x5a\x7d\x48\x63\xa0\xd9\x49\xab\xa7\x27\xec\x19\xb8\xe0\xe9\xbf\xb3\x3a\x5f\x7b\xfd\x59\xf0\xbd\x02\x91\xcd\x1d\x33\xcd\x24\xb2\x7b\xcc\x78\x81\x1e\x9f\x00\xf3\x6a\xea\x56\x33\x4c\x5e\xbd\xda\x1d\xf6\xd8\xd7\x1e\x70\x35\xaa\x1c\x04\xaf\xa6\x56\x07\x58\x7b\x24\xcf\x40\xac\x49\x71\x21\x95\x40\x54\x1b\x31\x67\xf0\xa6\xf5\x60\xe2\xbd\x8e\xe8\xc7\x30\x01\x73\x70\xdd\xd3\x6d\x51\x31\x55\xcb\x61\x5c\xb5\x36\x11\xf9\x9f\xb0\x1e\x11\x76\x37\x0d\x7a\xcf\x3a\x44\xfc\x83\x36\x01\x89\x63\x5e\x32\xf5\x97\x9f\x3a\x7d\xd7\x31\xe8\x8f\x35\xea\x6e\x1f\xfd\x7f\x40\xbe\xdd\xee\x1e\xf8\x57\x52\x1c\x84\xfd\xaa\x50\x07\xbe\x39\x81\x67\x50\x5f\xc5\x5f\x44\x34\xae\x96\xce\xbb\x15\xaa\xff\x0f\x96\x8c\x34\x62\x79\x50\x30\xed\xb7\x94\x5b\xaa\x16\x65\x34\x4d\xa3\x65\x1f\xf2\xf8\xd9\x18\x77\x24\xd2\xbc\x9d\xd8\x11\x2f\xf6\x9e\xd2\x76\xf9\xd4\xdf\xdb\xb6\x16\x29\x89\x31\xe2\x7c\xcb\x6c\xb6\x1e\x1e\x4f\x53\x1a\x53\x92\x4d\x08\xf3\xa9\xf1\x7b\x8a\x34\xc3\x98\x17\x13\xa7\xdb\xd6\x76\x79\xfe\x00\x6e\x1d\x9e\xb0\xd5\x0f\x00\x00")
 
 func userDirectivesAuthorizationdetailsHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -766,7 +938,7 @@ func userDirectivesAuthorizationdetailsHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/directives/authorizationDetails.html", size: 3902, mode: os.FileMode(436), modTime: time.Unix(1464680470, 0)}
+	info := bindataFileInfo{name: "user/directives/authorizationDetails.html", size: 4053, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -791,7 +963,7 @@ func userDirectivesAuthorizationdetailsdirectiveJs() (*asset, error) {
 	return a, nil
 }
 
-var _userServiceJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x59\x4f\x8f\xa3\x36\x14\xbf\xef\xa7\x40\xa3\x48\x10\x35\xca\xdc\x19\xf5\x30\x5d\x75\xdb\x4a\xab\x55\xb5\xed\x9c\xaa\x1e\x1c\x78\x93\xb8\x25\x36\x63\xcc\xac\xda\x55\xbe\x7b\x6d\x03\x13\x1b\x3f\x12\x93\x85\x68\xa4\x0e\x07\x02\xef\xff\xfb\xf9\xf9\xd9\x26\xc9\x63\xcd\x32\x49\x39\x4b\x96\xd1\xd7\x77\x91\xba\xe2\xba\x82\xa8\x92\x82\x66\x32\xbe\x7b\x67\x48\x84\x6d\xeb\x82\x08\xf3\xac\xaf\xf5\x9e\xe7\x75\x01\xc9\x0d\x95\xd5\x3f\xbc\xe6\xac\xa0\x0c\xee\xcb\xf2\x66\x79\x14\xa9\x40\x3c\xd3\x4c\xc9\x3c\xa8\xa7\xdf\x9a\x97\x9b\x55\x64\xbd\x61\xc2\x9f\xb8\xa4\x8f\x34\x23\x3a\xa2\xa3\x12\x42\x5d\xb6\xa1\x59\xf6\xd6\x0b\xca\xfe\x82\x4c\x46\xdf\x47\x7f\xc4\x8b\x9d\x94\x65\xbc\x8a\x17\x4f\xf1\x9f\x77\x46\x12\x31\x72\x42\xc3\xa8\x74\xd8\x60\xba\x89\x91\x5f\x45\x8b\xa7\x0e\x38\x7d\x3d\x13\x11\x91\x92\x3e\x7c\xfe\xa8\x6c\xc6\xea\xe9\x56\xa1\x29\xaa\x0e\xc8\x4e\xa4\xcd\x57\xc9\x1c\x55\xf5\xb5\x05\x99\xea\xdb\xca\xa1\x92\x2c\x83\x52\x31\x9a\x5f\x97\x27\x40\x27\x90\xb6\xbf\x2f\xac\x83\xe5\x50\x80\xac\x05\xeb\x7c\x5a\x8c\x97\xf4\x94\xc7\x44\xc7\xc9\xc8\x1e\x96\xbd\x90\x74\xb8\xb5\x28\x54\xa8\x6d\x5e\xdf\x45\xf1\x6d\xac\xee\xc0\x32\x9e\xc3\xc3\xe7\x5f\xde\xf3\x7d\xc9\x19\x30\xdb\x86\x16\x62\x16\x68\x0e\x02\x56\x50\x06\x44\x87\x61\xea\xc1\x04\x24\x8a\xa5\xcf\x91\x3b\x60\x89\x47\xb6\xb3\x49\x04\x54\x2a\x9e\xca\xcb\x04\x71\xdf\x89\xae\x73\x22\xc9\x1d\x2a\x7d\x58\x9d\xf3\x46\x2a\xce\x02\x7c\x2d\x9e\xd6\xcd\x20\x75\x2a\x03\xfe\x3c\xaa\x25\x78\x40\x06\xaf\xa9\x8a\x84\xb2\x67\x2a\x0d\xd6\xdf\x32\x82\x47\x2b\x6b\x3d\x98\xcd\x40\x72\xb1\x25\x8c\xfe\xdb\x0c\x64\x80\xa6\x2d\xdf\x58\x10\xbc\x80\x10\x4d\x2d\xb7\x8c\x46\x95\x4a\xc9\x2b\x53\x2b\xab\xc8\x42\xe0\xad\x6e\xa2\xb3\x75\xd3\x1a\xfd\xbf\xd6\x4d\x0e\x05\x48\x78\xeb\x32\xdd\xe5\x54\x4b\x73\x77\xd7\x40\x6b\xa5\xbd\x78\xed\x6b\x83\x0c\x59\xf4\x04\x6c\x69\x25\x41\x7c\x82\x2f\x3f\xee\x09\x2d\xee\xf3\x5c\xe1\x58\xa5\x43\x0c\x57\xbb\x2e\x15\xd6\xe0\x2a\xfa\x34\x57\xa7\xa9\x08\x57\xc7\xa7\x0d\x46\xf9\xeb\x4e\x97\x65\xbd\xdf\x80\x48\x07\xe8\x58\x8c\x8e\x9a\x47\xc2\x22\x74\x34\x3c\xd2\x60\x7c\x18\x80\x27\xb0\xeb\xc1\x76\x02\xb1\x1e\x58\xa8\xa4\x1a\xde\xfb\x5a\xee\xb8\xe8\xda\x41\xea\x93\x5c\x8d\x8a\x3c\x83\xc3\x4f\x7d\x12\x1a\x8d\xab\x83\x10\x07\x11\xfa\x81\xb0\xbf\xef\xb3\x8c\xd7\x4c\xa6\x03\x74\x0c\x29\x47\xcd\x23\x61\x31\x3a\x1a\x1e\x09\xd3\xf8\x40\x32\xd8\x70\xde\xd7\xea\x91\x31\xcd\x9f\xa8\xdc\xd5\x9b\x9e\x9e\x43\x44\x37\x8d\xd6\xde\x90\x81\x3a\x0b\xfc\xac\x26\xfc\x7b\x52\x14\x89\x9e\xf9\x1f\x5a\xe6\x2a\x32\x0b\xaf\x6e\x6b\xfd\xd6\x44\x1f\xa3\xc4\xd0\xfd\x8e\xd5\x36\x01\xdb\x50\x72\xb4\x83\xb6\xab\x13\x0d\xd9\x0e\x36\xa8\x29\x5b\x21\x04\x34\x66\x83\x0b\xde\x9c\x7b\x9e\xcf\x35\x68\xcb\x6f\x60\x93\x36\xbe\x51\x4e\x4f\xc1\x15\x82\x42\x1d\xe0\x82\x61\x7f\x03\xbc\xe7\x7b\x1c\xe0\xd8\xce\x6a\xf2\xe3\xd4\x1d\xb6\xaf\xe9\x4f\x4c\xb3\x26\xeb\x93\x93\x99\x95\x67\x36\x7f\x4d\x9b\x7a\xf1\xa0\x54\xcc\xae\xed\xba\xb1\x96\xb5\x6c\x3b\x88\xf1\x7e\x6e\xbb\x8a\x2e\xfb\x56\x0a\x05\xd9\x80\xb2\x05\x9a\x4b\x1a\xee\x74\x87\x59\xdb\x2a\xe8\x3d\x4d\x78\x96\xea\x80\xd2\xa6\xf9\xd5\x84\x98\x62\x91\xa6\xce\xdb\x21\x68\xf8\x06\x70\xe0\x45\xde\x3a\x60\xf0\xe5\x8a\xa0\x0c\xe9\x74\xf1\xe8\x0d\xfa\x05\xb5\xd1\x81\x86\x27\x33\x0e\x37\x7f\x3b\xd7\xaf\x9f\x6b\x83\x33\x1e\x99\x26\x87\x90\x49\x8e\x6f\x42\xfd\x19\x53\x1e\x99\xd3\xa5\x6f\x19\x9d\x68\xba\x94\xf6\xbe\xd7\x7a\x09\x9b\x2c\x38\x02\xd8\x5c\x99\x1d\x8e\xab\x4d\x94\x4b\x11\xf3\x0e\x15\xf3\xcd\x92\x10\x5c\xae\x34\x47\x06\x57\x94\xc9\xfb\xe6\xc4\xeb\xc8\x4b\x2b\x1c\xb5\x7a\x84\x2d\x1c\xf3\xe5\x7e\xb5\x59\x30\x16\x1e\xe7\x18\x3b\x5f\xe5\xbf\x96\xa5\xc1\x3a\x79\xfa\xa5\xbf\x39\x32\xa7\xcb\x5c\x1b\x9d\xa8\xf4\xb5\xa9\xd4\x8e\x32\xac\xf6\xf1\x9c\x55\xe5\x7d\x6c\xcc\xce\x9e\xfd\x89\xe2\x37\x21\x5c\xb6\x8d\xfe\x56\x70\xbc\x4f\x11\xf3\x55\xff\x49\x14\x8a\xb1\x10\x84\x17\xbe\xf7\xd5\x69\x86\x3f\xbe\x88\xe3\xe0\x76\x4c\xa5\x07\x1e\xdf\xbc\x2f\x61\x89\xe3\x33\x38\x15\x2c\x17\xc7\xd2\xfa\x74\x66\x38\x1a\xae\x85\xad\x20\x4c\x42\xfe\x3b\xbf\xac\xa6\xdd\xc4\x82\x3a\xf7\x04\xc0\xbc\x56\x5c\xc2\x0b\x1d\xfd\x40\x38\x43\xb1\x3f\xb6\x1e\xc6\x54\xf9\xd8\x2c\x9c\xcf\x95\x33\xe4\xb0\x35\xf6\xa7\xc8\xa0\xb9\x1f\x96\x89\xa2\xfe\x17\x00\x00\xff\xff\x36\xa2\x9a\xf2\x5b\x21\x00\x00")
+var _userServiceJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5a\x4b\x6f\xe3\x36\x10\xbe\xef\xaf\x10\x02\x03\x96\x51\xc3\xb9\x2b\x08\x16\x69\xd0\x6d\x8b\x2e\x82\x62\xb7\xe9\xa5\xe8\x81\x96\x68\x5b\x5d\x99\x54\x28\xca\x41\xba\xc8\x7f\x2f\x1f\x92\x45\x8a\x23\x9b\x72\x24\xc3\x40\xa3\x83\x23\x0d\x67\x86\x33\x1f\x67\x38\x7c\x24\x5c\x95\x24\xe6\x29\x25\xe1\x2c\xf8\xfe\x21\x10\xcf\xb4\x2c\x70\x50\x70\x96\xc6\x7c\x7a\xf3\x41\x91\x10\x59\x97\x19\x62\xea\x5d\x3e\x8b\x2d\x4d\xca\x0c\x87\x57\x29\x2f\x5e\x68\x49\x49\x96\x12\x7c\x97\xe7\x57\xb3\x86\xa5\xc0\x6c\x97\xc6\x82\xe7\x51\xbc\x7d\xd5\x1f\x57\xf3\xc0\xf8\x82\x98\x1f\x28\x4f\x57\x69\x8c\xa4\x45\x8d\x10\x40\x9d\x55\xa6\x19\xfa\x16\x93\x94\xfc\x83\x63\x1e\xdc\x06\x7f\x4d\x27\x1b\xce\xf3\xe9\x7c\x3a\x79\x9a\xfe\x7d\xa3\x38\x01\x25\x07\x24\x94\x48\x8d\x0d\x24\x1b\x2a\xfe\x79\x30\x79\xaa\x81\x93\xcf\x0e\xb1\x00\xe5\xe9\xe3\x97\xcf\x42\xe7\x54\xbc\x5d\x0b\x34\x59\x51\x03\x59\xb3\x54\xfe\x0a\x9e\x46\x54\x3e\x6b\xcc\x23\xf9\x33\xb7\xa8\x28\x8e\x71\x2e\x1a\xf4\x5f\xbb\x8d\x61\xe9\x40\x54\xfd\xdd\x37\xbd\x1a\x1d\x32\xcc\x4b\x46\xea\x3e\x8d\x86\xbd\x7b\xa2\xc7\x50\xda\x49\xd0\x16\xcf\x5a\x26\x49\x73\x4b\x96\x09\x53\x2b\xbf\x7e\x08\xa6\xd7\x53\xf1\x8b\x49\x4c\x13\xfc\xf8\xe5\xd7\x7b\xba\xcd\x29\xc1\xc4\xd4\x21\x99\x88\x01\x9a\x85\x80\x61\x94\x02\xd1\x6a\x50\xf1\xa0\x0c\x62\xd9\xcc\x6d\xe1\x1b\x4c\x42\x87\x6c\x7a\x13\x32\x5c\x08\x7b\x0a\xc7\x13\xa0\xfb\x9a\x75\x91\x20\x8e\x6e\x40\xee\xd7\xf9\xb1\xde\x50\x41\x89\x47\x5f\x93\xa7\x85\x1e\xa4\x5a\xa4\xa3\x3f\x87\x6a\x30\xbe\x02\x83\xa7\xa3\x22\x4c\xc9\x2e\xe5\x0a\xeb\xb7\x8c\x60\xa3\x65\x21\x07\x53\x0f\x24\x65\x6b\x44\xd2\x7f\xf5\x40\x7a\x48\x9a\xfc\x5a\x03\xa3\x19\xf6\x91\x94\x7c\xb3\xa0\x57\xa8\xe4\xb4\x50\xb1\x32\x0f\x0c\x04\xde\xe3\x26\x38\x1a\x37\x95\xd2\xff\x6b\xdc\x24\x38\xc3\x1c\xbf\xcf\x32\xf5\x63\x45\x8b\xfe\xb5\x6b\xa0\x51\x69\x4f\xae\x7d\x95\x91\x3e\x45\x8f\xe1\x75\x5a\x70\xcc\x1e\xf0\xf3\x4f\x5b\x94\x66\x77\x49\x22\x70\x2c\xa2\xae\x06\x5b\xba\xcc\x05\xd6\xd8\x16\x74\x69\xb6\x8c\x8e\x08\x5b\xc6\xa5\x75\x5a\xf9\xfb\x46\x86\x65\xb9\x5d\x62\x16\x75\xd0\x21\x1b\x2d\x31\x87\x04\x59\x68\x49\x38\xa4\x4e\xfb\x20\x00\x0f\x60\xd7\x82\xed\x00\x62\x2d\xb0\x40\x4e\x31\xbc\x77\x25\xdf\x50\x56\x4f\x07\x91\x4b\xb2\x25\x0a\xb4\xc3\x56\x7b\xe4\x92\x40\x6b\x6c\x19\x80\xe8\x58\xf6\x55\x98\xbb\xb7\xa9\xfe\x80\x74\x57\x6d\x91\xfd\x09\x5a\x91\x65\x8d\x56\x87\xd4\x39\x46\x3f\x22\xf2\xed\x2e\x8e\x69\x49\x78\xd4\x41\x87\xc6\xca\x12\x73\x48\x90\x7d\x96\x84\x43\x82\x24\x3e\xa1\x18\x2f\x29\x6d\x4b\xb5\xc8\x90\xe4\xcf\x29\xdf\x94\xcb\x96\x9c\x45\x04\xf3\x02\x15\xc5\x33\x65\x49\xd4\xfa\x86\x78\x1f\xc4\x9a\x33\x32\xde\x9d\x11\xfe\x13\x33\xb1\x14\xc5\x89\xca\x13\x3d\xce\x36\xa9\x15\x7b\x98\x68\xba\x66\xd2\x4b\xd8\x7b\x51\x7f\xa2\xee\x26\x5b\xc3\x4e\xb6\xbe\x28\xc6\xc8\xfc\xe8\xb4\x4c\x4d\x31\xb6\x65\x9a\xe4\x5a\xa6\xe8\x66\xf7\x11\x4c\x76\xfa\x02\x04\x21\x2a\xb8\x89\x30\xf6\x0a\x44\xf0\xc6\xbf\x88\x02\x70\x8f\xb2\x2c\x94\x95\xe0\x53\xd5\x38\x0f\xd4\x42\x4c\x96\xb9\x76\xa9\x4a\x57\x41\xa8\xe8\x6e\x05\xab\x8a\x82\xa9\x28\x6c\xf4\x80\xe5\xeb\x40\x81\x36\x8d\xf5\x2a\xd2\x86\x09\x1e\x85\x5a\xe1\x02\x17\xeb\x56\xcf\xc7\x0a\xb6\xd1\xaf\x67\xd1\x56\x7d\x83\x2d\x2d\x01\x9b\x09\x67\x62\x43\xef\x0d\xfb\x3b\xe0\xad\xbe\xfb\x01\x0e\xad\xb4\x07\xdf\x5e\xdb\x9d\x57\x3e\xb5\x13\x53\xad\xd1\xe4\x4e\x5a\x65\xe5\x91\xcd\x80\x9e\x3b\xf7\x3d\x08\x11\xb5\x8a\x3f\xaf\xad\x79\xc9\xab\x19\x44\xf5\x7e\x6c\xfb\x02\x2e\x03\x0d\x17\x32\xb4\xc4\x42\x17\x96\xad\x48\xb7\x0e\x77\xb8\x61\x6a\xc5\x72\x8d\xeb\xef\xa5\xd8\xb0\x56\x6e\x7e\x57\x26\x46\x90\xa5\x91\xf5\xf5\xea\x35\x7c\x1d\x38\xd0\x2c\xa9\x3a\x20\xf8\xf9\x8c\xa0\x74\xc9\xd4\xf6\xc8\x0d\xdb\x09\xb1\x51\x83\x06\x3b\xd3\x0f\x37\x77\x79\xdf\x8e\x9f\x73\x83\xd3\x1f\x19\xed\x83\x4f\x92\xc3\x9b\x12\x37\x63\xf2\xa6\x71\x38\xf7\x0d\xa5\x03\xa5\x4b\x6e\xee\x83\x8c\x0f\xbf\x64\x81\x11\x80\x72\x65\x74\x38\xce\x96\x28\xa7\x22\xe6\x6c\x32\xc7\xcb\x12\x1f\x5c\xce\x94\x23\x9d\x15\x65\xf0\x79\x73\xe0\x3a\xb2\x9f\x0a\x7b\x55\x0f\xbf\xc2\x31\x9e\xef\x67\xcb\x82\xbe\xf0\x58\xc7\x1a\xe3\x45\xfe\xa5\x94\x06\xe3\x1c\xc0\x0d\xfd\x65\xd3\x38\x9c\xe7\x52\xe9\x40\xa1\x2f\x55\x45\xa6\x95\x7e\xb1\x0f\xfb\x2c\x22\xef\xb3\x56\x3b\xba\xf7\x07\x82\x5f\x99\x70\xda\x32\xfa\xad\xe0\x38\x07\x43\xe3\x45\xff\x41\x14\xb2\xbe\x10\xf8\x07\xbe\x73\x0a\x39\xc2\x45\x28\xb2\x3a\xb8\xee\x13\xe9\x9e\xdb\x37\xe7\x64\x34\xb4\xfa\xf4\x76\x05\xf2\xc5\xd2\xb4\x38\xec\x19\x8c\x86\xad\x61\xcd\x10\xe1\x38\xf9\x83\x9e\x16\xd3\xb6\x63\x5e\x33\xf7\x00\xc0\x5c\x2a\x2e\xbd\x02\xbd\x3e\x7e\x1e\x21\xc4\x8b\x4a\xf5\x08\xb1\x6d\x9d\xb5\x1b\x13\x50\xd5\x65\x9a\x0c\xef\x45\x17\x77\xd3\xe5\x28\x43\xe4\xdc\x13\x5c\xc8\x40\xf5\xf5\xa0\x75\x27\x30\x82\x17\xab\xaa\x87\x31\xbd\xb0\x6e\x28\x46\xf0\x61\xad\xf4\x8f\xe3\x81\x7d\x65\x62\x24\x4d\x5c\x32\x26\x4c\xd9\x5f\xa6\xc8\x65\x71\xfd\x31\xe0\x26\xae\xd2\xd8\x72\x4e\x2a\x94\x07\xbd\xce\xbf\x3d\xc9\xa7\xb2\x2c\xdf\x5f\xfb\xb4\x4d\x75\x24\x84\xe9\x0d\xb7\xe1\x87\x7d\x32\x7b\x52\x91\x51\x37\x0f\x3e\x10\xcb\xdb\x26\x03\xde\x55\xca\x0a\x5e\xaf\x8f\xf4\xdb\x80\xff\x4f\x25\xde\xfa\x20\xba\x37\x26\x32\xec\x72\xb8\x6a\x3b\xa3\xfd\xdb\x79\xf0\x73\xae\xe0\x46\x48\x31\xf3\x30\xe1\xe3\x0e\x65\xa9\x1c\xb2\xe4\x96\xb3\xb2\x0d\xe4\x20\x8b\xb0\xae\xab\xc1\x8b\x38\x2d\x91\xec\x35\x04\x27\xed\xb5\x8e\x78\x6f\x5c\x72\xba\xdb\xc6\xaa\x63\xc1\xf7\x1b\x7e\x11\xb3\x10\x25\x22\x22\xb7\x7b\x80\x2e\x0d\x90\x83\x69\x55\x6c\x8b\x58\x5d\x05\xb7\xbd\x70\x73\xab\x71\xfb\x1b\x7e\x89\x6c\x14\xce\x9e\x65\xfa\x3a\x79\x84\x2c\xb3\x0f\xb6\xcf\x93\x67\xce\xa5\xf5\x85\x9c\xdb\x8f\x9d\x65\xd0\x7d\xfd\x91\x74\xbb\x00\x24\x3e\x5a\x89\x70\xdb\x21\x68\x5b\xfd\xd6\xb8\xd1\xbf\xaf\xb3\x50\x50\xff\x03\x0e\x30\x1c\x05\xe5\x2e\x00\x00")
 
 func userServiceJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -806,7 +978,7 @@ func userServiceJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/service.js", size: 8539, mode: os.FileMode(436), modTime: time.Unix(1464680470, 0)}
+	info := bindataFileInfo{name: "user/service.js", size: 12005, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -951,7 +1123,7 @@ func userViewsGithubdialogHtml() (*asset, error) {
 	return a, nil
 }
 
-var _userViewsHomeHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x5b\x6f\xdb\x38\x16\x7e\xdf\x5f\xc1\x6a\x17\x9b\x04\x88\xec\xa4\xd9\x16\x8b\x34\x36\x90\xb4\xbb\x8b\x3e\xf4\x82\xed\xcc\xc3\x3c\x0d\x28\x89\xb6\x39\x91\x49\x41\xa2\xd2\x64\x52\xff\xf7\x39\xa4\x28\x9b\x94\xe4\x8b\x2e\x4e\xe3\x56\x7c\x48\x6c\x99\xfa\x0e\xcf\xe1\xb9\x7c\x14\x69\x5f\x05\xf4\x0e\xf9\x21\x4e\x92\x91\x33\x49\xc3\x30\xf1\x63\x42\xd8\x84\xc7\x73\x67\xfc\x37\x04\x4d\x75\x98\x84\xe4\x1e\x85\xf8\x81\xa7\x62\xe4\xc4\xfc\xab\xa3\xdf\xb8\x13\x1a\x86\x28\x11\x0f\x21\x19\x39\x11\x0e\x02\xca\xa6\xae\xe0\xd1\x25\x3a\x3f\x3b\x8b\xee\xdf\x68\x0c\x0b\x67\x7c\x35\x84\x97\x85\x0f\x72\x6c\x9f\x87\xe9\x9c\x39\xaa\xa3\x3b\x15\xee\x3c\x18\x39\xff\x3e\xcb\xde\x8f\x1c\x00\xb5\x24\xaf\x40\xd6\x01\xd9\x3d\xac\x71\x28\xe0\xf2\xe7\xaa\x4f\x34\xfe\x8d\xa7\x57\xc3\x68\xcd\xc7\xb3\x73\x04\x7a\x7a\x94\xc1\xe8\xee\xe6\x83\x34\x21\x31\xc3\x73\xe2\x80\x6a\xb3\xf3\x0a\x91\xb6\xc2\x5b\x2d\xb2\xe6\x9e\xf2\x4c\xac\x57\x72\x1e\xb8\x3e\x8e\x03\xc3\x6e\x6b\x54\xd1\x1d\x5d\x9f\x33\x41\x98\x28\x1b\x7a\x83\x14\x13\x44\x60\x2f\x31\x6f\x86\x4b\x09\x09\x89\x2f\x48\x66\xa3\xfc\xcd\x2f\xd8\x7b\xcf\x02\x72\xbf\x01\xce\x80\x94\x30\x9c\x69\x24\x85\x13\x72\x1c\x7c\xe4\x82\x4e\xa8\x8f\x05\xe5\x2c\x39\x3e\xd9\x02\x65\xc0\xb9\x21\xf6\x48\x38\xbe\xa2\x4b\x8f\xc7\x68\x82\x5d\xb8\x16\xca\xb9\xa3\xe3\x7f\x32\x2f\x89\xde\x58\xf8\x57\x43\xeb\xde\x9d\x45\x79\x3c\x78\xd8\xdd\x84\x16\x82\xe9\xc7\x66\xb0\xe1\x90\x4e\x19\x80\xc1\x3c\x91\x18\x65\xff\x1c\xe9\x89\x74\x32\x72\x5e\x68\xe3\x90\x60\xc0\xcc\xf1\xef\x28\x34\x1f\x7a\x14\xf3\x69\x4c\x92\xc4\xf5\x69\xec\xa7\x21\x8e\xe5\x14\xcc\x79\x00\xf1\x0d\xee\x4e\x40\xe2\x9c\x32\x2c\x88\xb3\x33\x6a\x75\x03\xd4\x80\x42\xcc\x00\xa0\xf6\x4f\x65\xe8\x92\xf8\x1d\x4d\x56\x1d\x61\x95\x5d\x8b\xd6\xad\x61\x9f\x9d\x12\xcc\x46\x04\x2f\x1e\xd6\xbc\x63\x76\x61\xe5\x1a\x73\x6e\x3f\x80\xa1\xf0\x34\x4b\x3b\x17\x35\xd4\xd8\xdd\x58\xaa\x7b\x12\x61\x96\xe7\x29\xf9\x7a\x3f\x53\xa2\xdd\x18\x54\x9c\x12\xf1\x99\x30\x59\x4a\xde\xf2\x94\x89\xe3\x82\xd6\xc9\x80\xb2\x3b\x2a\xb2\xd7\x27\x68\x8c\xd6\xe5\xb6\x4a\x51\x60\x28\x0d\x8e\x78\x3c\xc5\x8c\xfe\xa9\x80\x90\xc2\x24\x49\x4d\x4b\x82\xc7\x86\x34\x11\x35\xa7\x54\xdf\xe5\x82\xc0\x79\xa3\x30\x02\x5b\xc5\x24\x22\x58\xc8\xa0\xcc\x6d\x01\x2a\xa0\x4d\xa6\x42\xdf\x10\xd4\x4c\x88\xb7\x4b\xf4\x98\xc0\xb5\x34\xb9\x44\x47\x51\x66\x8b\xa3\x45\xb3\x78\xd6\x69\x94\xf1\x98\x4e\x67\xa2\x66\x30\xc8\x06\x95\xb6\x98\x8d\x65\x35\x4d\xb2\x74\x8c\x1e\x1f\xd1\x4a\x83\x81\x35\x61\x8b\xc5\xda\xfa\xbc\x51\xa0\x2c\x78\x33\xe2\xdf\x7a\xfc\x3e\x97\xab\x6a\x15\x14\xc0\x00\xc7\x0f\x2a\x9f\xca\x7c\x17\x9a\xb6\x5d\x96\xaf\x96\x69\x0f\xc7\x14\x67\x85\x64\xe4\x7c\x51\x90\x86\x7e\x4d\xcc\x37\x34\xd4\xa9\xe9\x85\x43\xd3\x0d\xeb\x64\x8f\xda\x4e\xbf\xad\xa0\x81\x0f\xe6\xd5\xac\x9e\x05\x60\xaa\x92\x19\xff\xba\xf7\xac\xa1\x94\x00\xb5\xbd\x54\x08\xce\x9a\x86\xac\x1f\x52\xff\x56\x8d\x15\xfb\x3e\x89\x04\x30\x97\xa6\x50\x01\x4d\xb0\x17\x6a\x6a\xa5\xe6\xff\x8b\x76\xd0\xe3\x13\x34\x1a\x8d\x20\x8e\xc2\x84\x34\x70\xa8\x6b\x35\xb2\xfa\x8e\x94\x59\xe6\xfb\x59\x34\x26\x7f\x80\xfa\xcf\xd1\xa2\xff\x57\x23\xdb\xbf\x45\xeb\xd4\xdb\xdd\xba\x2e\x99\xaf\xa4\xb2\x5b\xe8\xba\xee\xda\x82\xd4\x7f\x32\x92\x7b\x17\xa4\xde\x28\x23\x19\xab\xb7\x04\xb4\x61\xf5\x4f\xc4\xe2\xcd\x6a\xd7\xb3\xf8\x62\xd7\x95\x75\xad\x2a\xd0\xd6\x76\xcb\x25\x76\xfe\x00\xe2\x1e\x1c\xea\x55\x93\x5a\x51\x9f\x17\xe6\x77\x26\xa9\x37\x23\xa0\x46\x3c\xfe\xf4\x95\x91\x58\x59\x74\x75\xad\x11\x64\x3b\xba\x29\x9b\x41\x39\x0b\xbc\x59\x92\x4e\x2e\x07\xea\x14\xe9\x60\x1b\x61\xb3\x98\x40\x38\xfc\x7d\x68\x0a\x1b\x02\x1d\xbc\xbc\x2c\xb0\xc0\x06\x29\x59\x36\x20\x9e\x92\x5d\x76\x42\x29\x1b\x32\x29\xeb\xde\xfd\x2d\xdf\x9e\x97\x4b\x7f\x20\x73\xef\x30\x7c\x7a\xae\x46\x7a\x68\x4e\x5d\x85\xf6\x83\x79\xf5\xb3\xa4\x2f\xd7\xa9\x98\x81\x87\x74\xc7\x5f\x04\x50\x5b\x22\x4c\x02\x63\x8b\x38\x00\x06\x83\xad\x01\xf7\x14\xa6\xd0\x55\x3f\xf5\x2a\x72\x97\x56\x56\xfb\xce\x4f\xa3\xac\xb1\xeb\x3c\x6a\xeb\x83\xbe\x41\xcd\x85\x9c\x7f\xf3\x80\x2e\xd1\xd1\x34\xc6\x4c\x6e\x0a\xf0\xa3\xc6\x0b\xb7\xd5\x1a\x50\x1a\xd2\x8a\x91\x77\x44\x60\x1a\xbe\xa3\x38\xe4\xd3\x63\x6b\x18\xa7\xe8\x1f\xe4\x0e\xfc\x76\x97\x30\x2d\xb6\x3c\xc9\x5a\x80\x83\xa5\x26\x4d\xf2\xed\x13\x3d\x8b\xd9\x67\xe2\xdc\xdc\xed\x85\xeb\xea\xfc\x33\x76\xdd\x2d\x90\xab\xbe\x6b\x32\xe3\x84\x86\xc4\x15\xe4\x5e\xb8\xdc\x4c\x8f\x6f\x39\x13\x31\xf6\x45\x31\x33\xd6\x11\xa8\x34\xde\xe9\x86\x61\xd3\x3b\x54\xe7\xe6\x75\xe6\x57\x58\xd6\x76\xb4\x3a\x36\x8d\xa7\xb6\x3b\x9f\x7d\x41\xc9\x46\xdd\x97\x11\xab\x6b\xc9\xa8\x8a\xec\x57\x14\x96\x06\xd6\x93\xb9\xc5\x5e\x3c\x24\xf3\x91\xf3\xba\xe9\xe2\xa1\x01\x95\x54\xb7\x2f\x77\xa0\x96\xcb\x17\xa7\xd6\x5e\x94\x05\xb6\xda\xff\x3f\x97\x30\x85\xc0\x78\x79\x2f\xff\x62\x4d\xbc\x9a\xb1\x70\x19\x18\x9c\x87\x82\x46\xe3\xff\xcc\xa1\x02\x21\x1c\x04\xd2\x09\x88\x4e\x4d\xfa\xb3\x06\x94\xbc\xd6\xca\x6f\x79\xdb\x4a\xe3\xd7\x67\x8e\x65\xc7\xd7\xaf\x9a\xae\x34\x1a\x2f\x07\x8b\x08\xca\x27\x8c\x9d\x98\x97\x70\x95\xb5\x0e\xc7\xbc\x19\xdc\xe4\x58\xe5\xb5\x53\x44\xe4\x94\x9c\x68\x72\x22\x63\x62\xa0\xae\x74\x28\xd1\xa6\x24\xca\x05\x2c\x2a\x92\x71\x8f\x53\x64\x0d\xa8\xe1\x44\xe4\xcd\x3c\xc7\x63\x5a\x56\x15\xca\x96\xd8\x0a\x7f\xf6\x2f\x49\x7b\xd4\x58\x15\xc5\x81\xf7\xed\x41\x15\x95\x52\x66\x68\xba\x4c\xb5\xe0\x9a\xc5\xc7\xea\xf6\x76\x69\xca\xc2\xf8\x1e\xd1\xfd\xd2\x38\xb4\xb3\xb5\xbc\x36\x8f\xfb\x6c\x67\xc2\x70\xb6\x28\xa6\x73\xb9\x75\xda\xd6\x1f\xca\x6c\x3e\x08\xb2\xe8\x31\xe3\xa6\x4d\xa4\x14\xb3\x7d\x14\xa6\xf9\x56\x33\xc8\x6a\x3e\xe3\x4d\xb6\xbf\xb2\x9b\x6b\xcf\x79\xd1\x4b\x6b\x17\x62\x90\x48\x61\x01\x26\x19\x0b\x65\x09\x11\x19\x51\xd1\x57\x1b\xe0\x1d\x54\x61\x8f\x66\x9c\x91\x8e\x6a\xfb\x67\x89\x85\x58\x2a\x1f\x13\xf6\x95\xbd\x8c\xf0\xe4\x95\x5d\x4d\xae\x55\xd9\xb3\xe9\xde\x57\x65\x57\x0e\x90\xcd\xff\xa6\xfa\x9e\x0d\xeb\x30\xea\xbb\x1a\x6b\x5f\xdf\xb7\x63\xf4\xf5\xbd\x7e\xab\xaa\xef\x66\x0c\xf5\x55\xde\x96\xd7\x57\xf9\x0a\xb0\x5d\xaa\xfc\x04\xfb\xc4\xe3\xfc\xd6\xe5\x93\x09\xf5\xc1\xad\x3a\xaa\xf8\xff\xd5\xb8\x08\xfb\xbe\x3c\x64\xd6\x17\xfd\x12\x42\xb1\xe8\x9f\x77\x5d\xf4\xed\x0c\x92\xcf\x48\x21\x77\x74\x27\x4e\x9f\x49\x56\x6c\x22\x77\xab\x01\x6d\x7b\x0e\x74\xd9\xcc\x03\xa1\x50\x2a\x4b\x92\xe4\xd7\x4a\x9a\xef\x07\xe7\xed\x8a\xce\xa7\xea\x89\x60\xec\x4b\x31\x25\x29\x11\xf5\x45\x1a\x13\x90\x63\xcc\x1c\xbe\xc3\x02\xd7\x3d\x91\x59\xa9\x63\x28\x2a\xa5\x4a\xdd\x16\x0d\x0f\x1e\x5b\x6d\xc3\x34\x0d\x6b\xa6\xba\x62\xdb\x4c\xbb\xda\x7d\x19\xa0\x52\x5e\xe1\xb8\x7f\xd9\x62\x75\x4f\xfb\x57\x4a\xe9\x89\xd4\x13\x12\xa9\x7c\x1f\xa3\x1c\xdd\x1d\xe4\x11\xfb\x5c\x71\x10\xe4\x09\xf1\x3a\xab\x50\x4d\x4f\xc4\x1a\xad\xcc\x03\x4b\x55\xb7\x27\x58\x3f\x19\xc1\x9a\x52\x31\x4b\xbd\x8e\x58\xd5\xff\x14\x58\xcf\xa9\xd6\x22\xac\xe1\x54\xc5\xba\x97\x4d\x4a\x87\xe4\xa4\xc4\xb6\xb2\x99\xea\x6c\x9d\xa6\x74\xac\xa6\x26\x5a\x97\x8c\x83\xfc\x9e\xc6\x61\x27\x44\x61\xff\xe4\x46\x8f\x1b\xec\x43\x19\x0c\xb9\xe6\x97\xfd\x8a\x6d\xff\x0f\x7d\x2e\xc6\x59\xf2\x28\x71\x0e\xad\x48\xce\x38\x54\x2e\x41\xc7\xaa\x73\x47\xde\x85\xd6\x0a\x55\xd6\x5b\x4a\x3d\xe9\xf9\xce\x61\xf2\x9d\x72\x5e\xda\xf7\x33\x25\xe0\x3f\x59\x8a\x5a\xb1\x1f\x28\x60\xad\x44\xf4\x3c\xe7\x67\xe6\x39\x84\xdd\x91\x90\x47\x24\x3f\x69\xd6\x9a\xeb\x5c\xf7\x27\x41\xd6\x22\x3c\xf9\x7e\x91\x3e\x96\x63\xed\x18\xe9\x6b\x7b\xa3\x50\xda\x01\x36\xed\x17\xe5\xc3\x3a\x80\x1d\xa3\x4e\xac\xf4\xf8\x98\xab\x3c\x60\x31\x5a\x2c\xcc\x0b\x89\x88\x09\x11\x8b\xc5\x69\xd7\x92\x22\x9e\x08\x1c\xfa\x3c\x20\x4a\x60\x7e\xd9\xa7\xe2\xa1\x3b\x69\x4b\x54\x59\x8c\x62\x00\x6e\x6f\xf4\x7e\x5f\x6e\x33\xc6\x8f\x4f\xaf\x9e\x66\x5f\x2e\xcf\x53\xfd\x9e\x9c\x2d\xaf\xa7\x52\x15\x60\xbb\x50\x29\x0f\xb3\xdb\x8e\x48\xd4\x0d\x40\xe5\x8f\x8b\x7a\x22\x55\x46\x28\x12\xa9\x8b\x7d\x13\x29\x39\xb7\x16\x8b\x52\x93\xbd\x2f\x0a\x25\xa7\x5f\x2f\xf0\xaa\x09\x94\x1a\xce\xf3\x67\x4f\xea\x1b\xa1\x54\x7e\x13\x41\x0e\x78\x40\xe1\xaf\xfa\xa5\x89\xa3\x23\x27\x7b\x2a\x33\x7e\x7f\x73\xfd\xf1\x12\xe5\x4f\x5f\x1e\x1f\x3b\xb1\xe8\x4a\x58\x67\xc7\x7c\x4a\xba\x78\xd4\x2f\xa8\x72\xf3\xfe\xed\x7e\x34\x91\xa2\x3a\x56\x24\x7b\x28\xa5\xd0\x35\x73\x53\x9b\x6e\x9d\x10\xaf\x15\xbe\x72\x56\x09\xdc\xb3\xaf\x9e\x7d\xed\x92\xdf\x7a\xfa\x75\xe8\x5f\x92\xdc\xd6\x23\xa9\xee\x92\xfd\xca\x97\xf1\x2b\x9d\xe5\x6e\xcb\x2e\xf6\x47\xc5\x9f\x5a\xad\xf8\xe5\xd5\xe2\x0f\x90\xea\x97\xd9\xbf\xbf\x02\x00\x00\xff\xff\xa8\x56\x97\xef\x1e\x56\x00\x00")
+var _userViewsHomeHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x5d\xeb\x6f\x1b\xb9\x11\xff\x9e\xbf\x82\x56\x8b\xd8\x06\xb2\xb2\x1d\xdf\x05\x07\x9d\x25\xc0\xc9\xa5\x45\x80\x5e\x2e\x68\xda\x02\x45\x51\x14\xd4\x2e\x25\xb1\x5e\x91\x0b\x2e\x57\xb6\xea\xe4\x7f\x2f\x5f\xab\x25\x77\xf5\xd8\x67\x4e\x76\x56\x1f\xfc\xe0\x92\x33\x9c\xe1\x70\xe6\x47\x72\x96\xba\x09\xf0\x0a\xf8\x21\x8c\xe3\xf1\x60\x96\x84\x61\xec\x33\x84\xc8\x8c\xb2\xe5\x00\xcc\x42\xf4\x00\x42\xb8\xa6\x09\x1f\x0f\x18\xbd\x1f\x98\x7f\xbc\x19\x0e\x43\x10\xf3\x75\x88\xc6\x83\x08\x06\x01\x26\x73\x8f\xd3\x68\x04\x7e\xba\x8c\x1e\x7e\x1e\x4c\x5e\x00\xf1\xb9\x91\x94\x25\x89\xc9\xcd\x85\xf8\xd3\x2a\x4c\x49\xfa\x34\x4c\x96\x44\xf3\xf1\xe6\xdc\x8b\x97\xe3\xc1\x4f\x97\xfa\xff\xf1\xe0\xea\xf2\xd2\x61\xa8\x09\xec\x22\x92\x3d\x75\x78\x2b\x82\xee\x33\xf5\x3c\x9a\xfc\x93\x26\x37\x17\xd1\x96\x47\x8b\x2b\x20\xc4\x99\x62\x12\x8c\x07\xab\xe5\x30\x89\x11\x23\x70\x89\x06\x42\x8c\xc5\x55\x8e\x4d\x26\x58\x81\xf3\x24\xf7\x30\xff\x6f\x5a\xef\x80\x20\xcb\xc0\xf3\x21\x0b\x2c\x9d\x6c\xe9\xb2\xa9\xe4\xf9\x94\x70\x44\x78\x51\x81\x3b\xa8\xdb\x04\x38\x9c\xc6\x76\x43\x51\x14\xa3\x10\xf9\x1c\x69\x3d\xa4\xff\xfc\x0d\x4e\x3f\x90\x00\x3d\xa8\x1a\xc1\x5a\xa8\x06\xfb\xde\x02\xe1\xf9\x82\x4f\x5e\xbc\xd8\x4a\xde\x62\x21\x1b\x51\x62\x28\x2b\xba\x21\x85\xc1\xdf\x85\x8e\xcf\xce\x77\x74\x2e\x47\xc1\x0b\xe1\x14\x85\x93\x1b\xbc\xb1\x5a\x08\x66\xd0\x93\xc3\x24\x87\x08\x4f\x5e\x92\x69\x1c\xfd\xac\x86\xd7\x69\x51\x8a\xf8\x94\x06\xeb\xfd\x35\x55\x6d\xdb\x00\xed\x89\x01\x43\x3c\x27\x42\xd9\x62\x10\x10\x03\xfa\xd7\x40\x9a\x13\x9e\x8d\x07\x27\x46\x5a\x14\x0c\x75\x6f\x0f\xf2\x49\x7b\x16\x31\x3a\x67\x28\x8e\x3d\x1f\x33\x3f\x09\x21\x93\x6a\x5c\xd2\x40\x4c\x3f\x61\xa6\x48\x30\x59\x62\x02\x39\x1a\x94\xa2\xb8\xfd\x23\x47\x13\x0b\x3b\x17\xc4\x8c\x9d\x29\xfd\x15\x58\x97\xd0\x4e\x71\x56\x6c\xad\x56\x50\xa2\x9a\x0e\x42\x59\xf1\x82\xde\x6f\x6c\xa3\xba\xb6\x42\x1c\xeb\x29\xe0\x38\x96\x37\xdb\x66\xce\x21\x32\x1e\xe6\x68\x59\xbe\x99\x6a\x1a\x47\x90\x6c\x38\x3f\x08\x03\xfd\x51\xaa\x52\x96\x56\x24\x94\xf9\xb0\x2b\x49\x22\x67\xf0\xaf\x1f\x5c\xb3\xaf\x3c\xf4\xca\xe4\x29\x0d\x39\x8e\x26\x7f\x12\x9e\x1f\x48\x2f\xa7\xe7\x8c\x29\xad\xd6\xdd\x72\xa3\xee\x34\xc9\x24\x7c\x73\x39\x70\x74\xf6\xe6\xc7\x0a\xa3\xb5\xa1\x67\x46\xad\x7a\x4b\xbb\xb5\x1a\xf3\x54\xd7\xa2\xec\xb5\x28\x25\x8d\xa6\x56\xfa\x11\xa6\xed\x87\xd8\xbf\xd3\xfe\x54\x18\xf9\xfb\x00\xf3\x8f\x42\xeb\xbf\x60\x18\xd2\xf9\xd9\x1f\xd1\x4a\x78\x8c\x43\x7e\x70\xaf\x0c\x56\x34\xb7\xc5\xf1\x38\x7a\xe0\x0d\xe8\x2a\xda\x8b\x1f\x26\x8f\x8f\xc0\x84\xc4\xe1\x0c\xb3\x98\x4b\x93\x01\x5f\xbf\x02\xab\x5c\x30\x4f\x8b\x45\xc0\xfc\xa1\x21\x4f\xc7\x34\x2b\x5a\xa4\x43\xa8\xba\x75\x66\x4d\xeb\x3b\x03\xa7\xfd\xb7\x9c\x4f\xaf\xad\xd0\x7f\x30\x40\xd5\x9b\x69\xd3\x84\x73\x4a\x2c\x6b\x8b\x18\x5e\x42\xb6\x6e\x34\x53\xac\x50\x99\xb3\xb2\x2f\x5f\xc0\x49\xde\xc6\x9a\xb2\xea\x70\x36\xe6\xdd\x75\x14\x26\xb1\x76\xd4\xe0\x36\x08\xea\x19\x91\xd6\x78\xa7\x66\x54\xd7\xd8\x6f\x14\x7c\x58\xe1\x00\x29\x6c\x82\x49\x8c\xb8\x06\x10\xa6\xf4\x19\xc6\x5e\xc8\x5b\x88\xbc\xef\x97\x10\x87\x40\xac\xa1\x24\xc8\x42\xf1\xf7\x1c\x7f\x65\x6f\xc4\xa4\x64\x28\x42\x50\xb8\xad\x33\x05\xdb\x5f\x01\x24\x35\x74\x0e\x30\xd9\x84\x18\x55\xe2\xfa\xb7\x06\x91\xa1\xf3\xb0\x2f\x3f\x45\x67\x23\x65\xf8\x05\x71\xf9\xd3\xf6\x37\xaf\x80\x23\x76\x5b\xfc\xd5\x8a\xb4\x59\x28\xee\x10\x5a\x28\xfa\x1a\x5e\x28\xb1\x5b\xc1\x0e\x8a\x68\x24\x69\x2a\x8d\x2a\x9a\x0d\x10\x44\x46\x32\x0d\x52\xa9\x39\xae\x10\xc3\x33\x8c\x02\x35\xa4\xf1\xbf\x14\xb7\x7f\x83\xf1\x78\xac\x85\x91\xee\xa3\xa5\x51\x04\xae\x1b\xf2\x17\xc8\xbf\x03\x73\xb9\x61\x63\x42\xcb\x3f\x4c\x57\x1a\x4b\xda\x00\x2f\xe9\xe6\xcd\x30\xd3\x86\xce\xae\xdd\x94\xca\x84\x36\xe1\xb3\xf1\x50\x38\x53\x59\x8d\xfd\x5a\x8d\x7c\x57\x13\x78\xcb\x82\xd8\xb5\x38\xf0\xf2\x25\xd8\x6f\x8b\x27\x99\x2d\x36\xea\x8e\x32\xaf\x75\x33\xab\xa8\x03\x62\x32\x02\xb5\x8c\xa0\x47\xe0\x15\x3e\x85\x48\x25\xe0\xaa\x0e\x56\x3d\x2c\xee\x61\x71\x09\x58\x1c\x2d\x28\x41\x2d\x20\xe3\x4f\x92\x0e\x20\xc9\x72\x8a\x58\x8f\x8b\x8b\xb8\x58\xe9\xd9\xc1\xc5\x5a\xf3\x4f\x1b\x17\xab\x51\xd7\x83\xbe\x0f\x1d\x6b\xe1\xbf\x27\x74\x7c\x2d\x91\xec\x68\xa4\x04\xd7\xf8\xf8\xba\x35\xd0\x3d\x1a\x65\x10\xb9\x1d\xd8\xbd\x0b\x23\xab\xe1\x4d\x71\x49\x8f\x8c\x9f\x3d\x32\x56\xe3\xdd\xd5\xe4\xdd\x83\x8c\xb5\x9d\x49\x64\x7c\x72\xc0\x04\x7b\x40\xdc\x25\xb7\x67\x0a\x88\xed\x28\xd5\xc3\xe2\x1e\x16\x97\x80\xc5\x33\xe8\xa3\x29\xa5\x77\x1e\x9d\xcd\xb0\x2f\x8c\xa6\x8d\x63\x5b\x43\x13\x40\xdf\xa7\x09\xe1\xdf\x33\x4a\xde\x01\x57\xaf\xba\x3b\xbd\x4d\xb5\x9f\xf3\x00\xed\xb0\xb2\xc1\x53\x6a\x3a\x43\x1c\xb4\x41\x1d\x32\x0c\x75\x4e\xcc\x78\x60\x1f\xe8\xa6\x5c\xcc\xe9\x6d\x93\xb5\x03\x5e\xce\x55\x74\x66\xbe\x64\x51\xe0\x10\x61\x9f\x27\x0c\x09\x1e\xd6\x48\xc1\x15\xe4\x90\x35\x14\x10\x86\x7c\x2b\x47\x29\x93\x60\xd7\x8c\xf8\x9e\x61\xb9\x98\xec\x4e\x7d\x3a\xf4\xd9\xbf\x76\x28\x97\xc1\x55\x9a\xd7\xe2\xba\x90\xdd\xe6\x6a\x49\xe5\xb9\x35\x58\x5a\xf4\x27\xed\x5d\xc1\x9a\xf4\x6c\xbc\x38\x63\x1b\xfa\x05\xc7\xb3\xc1\x20\x48\x1d\xdb\xad\x8e\x2a\x67\xcd\x7c\x5a\x11\x8d\x15\x22\xe4\x13\x85\x3b\x6d\xe2\x9d\xe7\x07\x78\xe6\x98\x2f\x92\x69\x0b\x28\xe7\xcf\x8a\x50\x8f\x71\x0e\x61\x9c\x7c\x7c\xd2\x03\xd0\x12\x68\x28\xa0\x1f\x3d\x2a\xed\x65\xae\x6d\x87\x0c\x46\x06\x8d\x0d\xfe\x93\xb0\xb0\x71\x10\xef\x16\x70\x98\xfe\x0a\x9d\x60\x22\xba\x7a\x71\xac\xa9\x7c\xd7\x13\xed\x0c\x0a\x58\xc0\x08\x90\x22\x01\xe5\x1b\xc0\x99\xaa\xdc\xca\x56\xdd\x2e\x86\x4a\x63\x1b\x8e\xe7\x3d\x06\x39\xdc\xe4\xf7\xc4\x20\x45\x1f\xd3\xe5\xae\x8b\xc0\x24\xda\xdd\x64\x88\xa4\xc9\xd6\x71\x8f\x3d\x9e\x3b\xf6\x40\x64\x85\x42\x1a\x21\x8f\xb6\x80\x3f\x6e\xfb\xe4\xbc\x6f\x93\x1c\x9f\x3f\xe1\x34\x59\x91\xce\x19\xa7\x29\xeb\x04\xd2\x98\x81\xde\x77\xee\x98\x76\xe9\x88\x73\xf4\x1b\x6b\xe6\xf1\x31\x15\x73\x48\x98\xc9\xee\x4f\x0b\x62\xce\x10\xe2\x5f\xbf\xbe\x6a\x93\x4b\x44\x63\x0e\x43\x9f\x06\x48\x31\x4b\x8b\x7d\xcc\xd7\xed\x70\xda\x50\x94\xc1\x83\x09\xa2\x0d\x4f\x00\x5b\x78\xad\xa1\xad\xb4\xc4\x1e\xea\x3c\xdd\x53\xa4\xd4\xdf\xf4\x27\x48\x3d\xac\x29\x05\x6b\xa6\x90\xdc\xb5\x00\x68\xde\x0a\x32\xe9\x76\x4a\x0f\x6a\x8a\xa0\xe6\xba\x4b\x50\x23\xc7\xd0\x41\x34\x6a\x50\xbb\x80\x33\x72\x98\xcd\x82\x69\x3b\x98\x51\x5d\x39\x5e\x24\x23\x25\x5a\x60\xf9\x92\xb3\xec\xe8\x10\x8b\x9f\x2a\xab\xfe\xf4\x74\xa0\x77\x2f\x26\x1f\xde\xde\x7e\x1c\x81\x74\x97\xe2\xf1\xb1\xb1\x16\x33\x46\xad\xbc\xbd\x58\x90\x61\x8a\xfd\x9c\x08\x6f\x3f\xbc\x6b\x5f\x02\xc9\xa6\x45\x01\xf4\x86\x8d\xa2\x6c\x10\x94\x3a\x24\x6a\x0c\x82\x32\xda\xca\x20\x25\xd1\x1e\x09\x1d\x68\xf2\x0c\x91\xd0\x2e\x3f\xf5\x9d\x43\xa1\xd2\xed\xea\x22\xa8\x1a\xfc\x2a\x18\x7a\x09\xe9\x37\x17\x74\xec\xbf\x75\x23\xad\x56\xfb\x7a\x91\x8f\x94\xe3\x19\xf6\x21\xc7\x94\xc4\x0d\xef\x19\x11\x65\xa1\x7d\xcf\x88\x43\xbb\xee\x8d\x23\xd5\xcf\xf8\x1b\xdf\x40\x42\xec\x7e\xf7\x57\x91\xe4\xb4\x59\x52\x1f\x07\xef\x21\xda\xdb\x7a\xca\x2a\x1c\x0f\xe5\x13\x37\xec\xf1\xfb\x55\x28\x05\xce\x2b\x65\x6e\x54\xf0\x4d\xd9\x12\xa7\xf4\xc2\xa6\x8a\xda\xb3\x43\xcb\x39\xe2\x9f\x10\x91\x97\x49\xbd\x53\x9b\xfc\x39\x29\xe3\x21\x26\x2b\xcc\xf5\xdf\xe7\x60\x02\xca\x5e\xe8\x22\x4f\xbb\x0c\x61\x40\xd9\x1c\x12\xfc\x3f\x45\x04\x28\x7a\x72\x5b\xb7\xb4\xd6\x2a\x87\x79\xc7\x39\x57\x9e\x12\xd6\xf2\x21\x93\xdd\xac\x1d\x76\xaa\x06\x7c\x01\x33\x1c\x8a\xb9\x33\x02\x8f\xb1\x28\x4b\xe2\x11\x38\x8d\xb4\xfc\xa7\x35\x4e\x4f\x8d\xfb\x23\x94\xc9\x3b\x9e\x2a\xc6\xe4\x9b\x68\xeb\x4d\x4d\x69\x3c\x7e\x7c\x04\x59\xcf\x87\xce\xe0\xd4\xd8\x17\x53\xf7\x60\xc9\xcc\xff\x29\x7d\xb0\x40\x4b\x8c\x7c\x4a\x02\x09\x5b\xa4\x42\xa5\xaf\x0a\x6d\x7d\x6e\x6e\xb6\x6a\xe0\xb2\xec\x94\xba\xcf\x8a\x9c\x25\x57\x55\x95\x5d\x58\x62\x74\x0b\x1f\x2a\xe2\xd6\x43\x41\x47\xd8\x58\x1a\x71\xca\x4b\x6c\x27\xf1\x77\xe5\x01\x54\xe7\xeb\xbf\xe7\xe0\x9e\x45\xfa\x3e\x8a\x6a\xe5\x44\x09\x32\x01\x8e\xe1\x34\x34\x37\xaa\xa9\x31\xfe\x6c\x8c\xef\xec\x5c\x2d\x0c\x67\x30\x8c\x51\x45\x83\xb9\x55\x3d\xaa\x66\x28\x55\xf1\x6c\x5b\xda\x63\xe8\xbf\x42\xdc\x63\xd2\xde\x5f\x55\x8f\xba\xd3\x5e\xd9\x58\xd8\x01\x5c\xde\x59\x63\x1f\x58\xfe\xcd\x72\xc2\x4d\xc1\xb2\xe5\xea\x35\x5a\x76\x88\x1f\xf1\xfd\x7c\x76\x24\xea\xd1\x71\xa6\xcd\x6d\xaf\x5c\xd5\xd2\xd5\xe6\xf6\x4b\x67\xeb\xbc\xce\x15\x7d\xd5\x21\x42\x9c\x4c\x17\x08\xca\x23\x82\xdf\xee\x09\x62\x4a\x7b\x59\x59\x65\x72\xf5\xe1\x9d\xfc\x58\x10\x2f\x87\x4d\x25\xc8\xa3\xb2\x83\x83\x3c\x04\xab\xcb\x68\xc1\x90\x30\xf3\x3f\x5c\xd8\x8c\x2e\xd4\xeb\x9f\x39\xe4\x55\x67\xbb\x28\x52\x68\xae\x31\x84\xab\xbb\x09\x52\x11\xc9\x54\x58\xfe\x1c\x87\xa9\xfe\x8a\xe4\xcb\x66\xc7\x6c\xab\x4b\xd5\xc3\xa7\x62\xac\xdb\x28\x3d\x71\x6b\x3d\x1a\x08\x71\x9b\xf0\x85\x18\xfd\x76\x30\x04\x17\xd0\x11\x71\x1b\x44\xb8\xe4\x8f\x18\x45\x40\xa7\xa3\x3d\x8c\xd0\xbb\x3d\x79\xfc\x50\x5b\x4b\xbf\xd3\x4e\x8c\xd3\x5f\xe3\xfb\x5c\x19\xc0\x17\x11\x07\x85\x7f\x7e\xbb\x06\x23\x70\x3a\x67\x90\xc8\x7b\xb3\xe9\x69\xad\x45\x4f\x2e\x6b\xc4\x66\xe4\x24\xaa\x39\x5d\x78\x05\xea\x1d\xa1\xa4\x8e\xd1\x21\x36\xdc\x48\x50\xd5\x47\x3e\xc1\x23\x86\x9d\x35\xf6\x39\xbc\xcf\x62\xc2\xb4\xe0\xea\x02\x14\xdf\x71\x1a\xd9\xbe\x2e\xa5\x7c\xc4\x5e\x2e\x36\x5d\xec\xfd\xdb\x76\xff\x56\x43\x3f\x0d\x3c\x5b\x31\x37\xd7\xf6\x5d\xa6\x2f\xc6\x6b\x55\xec\x99\x23\x68\x8b\x69\x1f\xe6\x6e\x1b\xd3\x9b\x61\x80\x56\xd8\xaf\x7f\xc5\x8d\x76\x61\x29\x31\x1c\xc9\x04\x56\x0f\xc8\x1b\x83\x01\xf4\x39\x5e\x21\x60\x3d\x96\xc5\xaa\x14\xf3\xb5\xf0\xda\x81\xb0\xb9\xd1\xe9\x12\x05\x38\x59\x9e\xd6\x03\x84\xd5\x4f\xf4\xb7\x9d\xb3\xbb\x5b\xd6\x59\x08\x08\x84\xdf\xe1\xc8\x38\x85\x33\x23\x85\x70\x3a\x7f\xa1\x73\x20\xe6\x6c\xbd\xad\xbd\x6f\xb0\x71\xbc\x4d\xc6\x7b\xc8\x48\x4e\x3c\x11\xc7\x84\x14\xef\x57\x88\xad\xef\x17\x88\xa1\xb3\x4c\x34\x80\x36\xa5\x95\xa4\xec\xe4\x18\x7a\x67\x95\x13\xcf\x33\xde\x77\xe2\x79\x7b\x48\x65\xf5\x76\x84\x82\x19\x0e\x91\x9a\x4f\xe9\x1b\x0c\x3a\x1c\xbc\xa3\x84\x33\x61\xb0\xf9\x78\x50\x96\x99\x92\xf0\x60\xe5\x8b\x3a\xb5\x55\xc5\x7a\xe1\xb3\x85\xaf\xff\x08\xa9\x7f\xe7\xc6\x4d\x3f\x61\x62\x52\x1f\x71\xdc\xec\xbf\x03\x64\x77\xcc\xac\xf1\x8d\x1f\xb5\x13\x66\xca\x36\x53\x4d\xbb\x48\xf7\xbd\x74\xbf\x01\x69\x67\xf6\xef\x1d\x5a\xb7\x90\xfc\xfb\x6e\x01\xc9\x1c\x81\x48\x90\xbf\x17\xab\x94\x27\x9f\xfe\x9b\xbd\x98\xe8\xae\x94\xb4\x9c\x9f\x8c\x98\xad\x24\x96\xe5\x54\x57\xbd\xbb\x47\x9c\x49\x76\x84\xcb\xae\xf4\x69\x5c\x7c\xac\xcf\xc8\xad\xaf\xbc\x72\xab\x6c\x1e\x67\xc5\xf9\xaf\xdf\xc2\x3c\xf6\x84\xbb\x16\xd1\x48\x82\x64\x6f\x46\xa9\x70\x7a\x72\x76\x6d\x2d\x7f\x91\x23\x51\xb8\x7d\xcf\xfc\xfa\x3f\x91\x07\x78\x95\x4e\x6d\x00\x00")
 
 func userViewsHomeHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -966,7 +1138,27 @@ func userViewsHomeHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/views/home.html", size: 22046, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "user/views/home.html", size: 27982, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _userViewsNamedialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa5\x54\x4d\x73\xdb\x20\x10\xbd\xfb\x57\x50\xa6\x87\xf6\x20\x2b\xe3\xc9\x29\x95\x74\xc9\x4c\x4e\xb9\x75\x7a\xce\xac\x00\xcb\x4c\x10\x28\x80\x1c\xfb\xdf\x77\x41\xd8\x96\x65\xdc\x43\xaa\x83\x84\x60\xf7\xb1\xef\xed\x47\xd5\xf3\x82\x4b\x50\xa6\x23\xba\x2b\x98\x32\xf0\xde\xac\x08\x3e\xd5\xd6\xd8\x9e\x68\xe8\x45\x4d\xc3\xfb\x05\x7f\x69\xb0\x71\x63\xdb\x4b\x5f\x53\xe6\xad\x5a\x3b\xd8\x8b\x1f\x3f\xe9\xe4\x12\xdd\x10\xcf\x1b\xa3\x5a\xb0\x97\xcd\x78\xc0\xe5\x9e\x30\x05\xce\xd5\xf4\x62\x13\xbf\x8e\x5e\x9b\x46\xf3\xdd\xa6\xf9\x33\x70\xf0\x22\xc6\x50\x95\xf8\x7f\x6b\xe4\x06\xd0\x64\xab\xc4\xa1\xa9\xca\xb0\xce\x98\xe0\x5d\xed\xe8\xbd\xd1\xb3\xcb\x25\x33\x3a\xed\xd2\x89\xb6\x64\xef\x89\x11\x03\xcd\x84\xba\xe2\xb4\xc4\x0b\xee\x04\xbf\x6e\x8f\x6a\x58\x56\x53\xc4\x15\xde\x95\xb2\xef\x4a\xc9\xde\x50\x44\x27\xde\x36\x8f\xc3\x61\x8d\x16\x94\x80\x95\x50\x28\x68\x85\xaa\xe9\x73\x38\x23\x93\xe0\x14\x83\x4e\x68\x99\xb8\xcb\x73\xe0\x0b\x1d\x4b\x14\x72\xa6\x77\x99\x13\xbc\x3a\x67\xb5\x40\x74\x2f\xb4\x27\x0a\x8e\x66\xf4\xc5\x00\x9c\x4b\xdd\x11\xe7\x8f\x0a\x33\xdb\x4b\x5d\x7c\x4a\xee\x77\x4f\xe4\xf1\xe1\x61\x38\xfc\x5a\xd0\x0e\x40\x27\x84\x8b\x7e\xd7\xd0\x34\x61\xa3\x80\x46\x8d\xbd\xce\x65\x33\xf0\xd4\x03\xde\x1f\x7c\x40\x6a\x61\xef\xc8\x1b\x75\x6a\x5e\xa4\x75\x3e\x25\x7e\xda\xc9\x5b\x47\xcc\x90\xc1\xde\xf0\x20\x6f\xcc\xe0\x36\x38\x07\x5f\x4a\xac\xf8\x18\xa5\x15\x3c\xd5\xf1\xec\xc4\x1f\x07\xdc\xf0\xe2\xe0\x73\xe1\x96\xb9\x78\xff\x9b\xd5\x2b\x7c\x9d\x14\x6a\x9f\xe7\x74\x39\xf8\x02\xa5\x1b\x8b\x94\xd2\x45\x7d\x5d\xe7\x3b\x5b\x66\xc0\xbc\x34\xda\x9d\x4b\xc1\x9a\xcf\x4c\x29\xa5\x4e\xbc\xdb\x72\xcf\x71\x75\xbf\xf6\xff\xdd\xef\xd9\x5e\x1f\xac\xec\xc1\x1e\x4f\xf2\x4c\xd3\x2b\x76\x3d\x97\x0e\x5a\x25\x78\x4d\xbf\x9d\x06\xdc\xfa\xfb\x1e\x94\xe4\x34\x9b\x18\x7c\x4e\x5d\x03\xb6\xc3\xc6\xb1\xb2\xdb\xf9\xa7\xcd\xd4\x36\xe4\x37\x8e\xc2\x5b\x41\x97\x34\xe6\x7a\x26\xcd\xd2\xbc\x2d\xc3\xc0\x6d\x56\x33\x83\x66\xf5\x17\x13\x93\x66\x8e\x9e\x05\x00\x00")
+
+func userViewsNamedialogHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_userViewsNamedialogHtml,
+		"user/views/nameDialog.html",
+	)
+}
+
+func userViewsNamedialogHtml() (*asset, error) {
+	bytes, err := userViewsNamedialogHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "user/views/nameDialog.html", size: 1438, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -991,6 +1183,66 @@ func userViewsPhonenumberdialogHtml() (*asset, error) {
 	return a, nil
 }
 
+var _userViewsResetpassworddialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x57\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x70\x42\x0f\x1b\xb0\xd4\x45\x51\xec\xd0\xc5\xbe\xe4\xb4\xcb\x30\x60\xc5\x80\x9d\x02\xc6\x52\x6d\xa1\xb2\xe4\x49\x72\xd2\xfc\xfb\x51\xfe\x48\x62\x47\xe9\xb6\x64\xd8\x7c\x71\x20\x51\x8f\xe4\x7b\x14\xcd\xcc\x2b\x3e\xe3\x12\x95\x29\x40\x17\xb3\x5c\x19\x7c\xce\xae\x80\x9e\xf9\x93\xb1\x15\x68\xac\x44\xca\xf2\x12\x75\x21\x6a\x74\x6e\x63\x2c\x0f\x1b\x2c\x58\xbb\x66\x55\x49\x4f\xdb\xde\xaa\x9b\xa6\xe6\xe8\xc5\x97\xde\xe6\xed\x3b\xd6\xc1\xb4\x50\xe4\xc3\x1b\xa3\x56\x68\xf7\x8b\xed\x06\x97\x6b\xc8\x15\x9d\x49\xd9\xde\xa6\x7d\x3b\x36\x36\x6d\xcd\xcb\xbb\x6c\xd1\x86\x02\x43\x2c\xf3\x84\xd6\x8e\x0d\x5d\x8d\x1a\x9e\x94\x78\xc9\xe6\x49\xf8\x1d\x31\x21\x7f\xab\xc6\x7b\xa3\x0f\x02\x90\xb9\xd1\xfd\x2a\xeb\xe8\x90\xf9\x73\x9f\x5f\x8e\x3a\x17\x6a\x94\xd7\x14\x2f\x1c\x07\x7a\xbb\x35\x71\x63\xf3\x94\x11\xae\xf0\x2e\x91\x55\x91\xc8\x7c\x49\xe4\x3a\xb1\xbc\xbb\xaf\x5f\x6e\xc8\x82\x01\x5a\x89\x33\x85\x2b\xa1\x52\xb6\x08\x7b\xd0\x09\xc1\x28\xe8\x1e\x2d\x12\x77\xb2\x0b\x7c\xc2\x65\x42\x64\x1e\x70\x9e\xc4\x48\x9f\xef\xd4\x9e\x11\xba\x17\xda\x83\xc2\xad\x69\xfc\xac\x46\xce\xa5\x2e\xc0\xf9\xad\x22\xc5\x2b\xa9\x67\x1b\xc9\x7d\xf9\x00\xf7\xb7\xb7\xf5\xcb\xc7\x49\xda\x01\x68\x40\xd8\xf3\x37\x86\x66\x3d\x36\x11\x68\x54\x53\xe9\x98\xa2\x21\x4f\x5d\x93\xff\x70\x06\xa5\x16\xf6\x04\xbd\x2d\x4f\xd9\xa2\xb1\x36\xb8\xdc\xcb\xdf\xad\xc7\xcf\xb4\xc8\x41\xc7\xca\xf0\x40\x72\xa7\x63\x07\x31\x14\x2a\x03\x2b\x7e\x34\xd2\x0a\x3e\xd4\xfa\x74\xdf\x6f\x6b\x5a\x1e\x3c\xb2\xa8\xab\xfe\x09\x25\xd3\xd6\x67\xef\xcb\x0a\xd2\xff\x1b\x2a\x49\x57\x43\x1a\x7d\xba\x78\xc2\x3d\x08\x71\x0a\xe7\xb0\x10\x2e\x76\xe3\xa6\x81\xdf\x5c\x0b\x6b\x8d\x3d\x81\x18\x41\x4d\x99\xd4\xb9\x21\x8c\xdc\x2f\x77\xd9\x64\x9f\x86\xb5\x03\x4e\x47\x85\x34\x42\x8c\x6f\x75\xe5\x3a\x95\xf1\x62\xb1\x3f\x8b\xcd\x25\x42\x6b\xb1\x39\x29\xf2\x68\x6f\x22\x70\x8b\x23\xb5\x12\xba\xf0\x65\xca\x3e\xfc\x3f\xc5\x0f\xa2\x3c\x43\xed\x5d\x0e\x2c\x1b\x50\xc0\x95\xa6\x51\x1c\x7a\xfe\x01\xe9\xfa\x0b\x74\x1e\x3e\x00\xb9\xb7\x98\x7b\x61\xdd\x2b\xfa\x47\xfd\x0c\x21\x2f\xa9\xdb\x2c\xc9\x81\xf5\x2c\x7b\x2c\xf7\x2d\x1a\xa4\x03\xda\x82\x76\xeb\x6c\xf0\x3e\x64\xb7\x6c\x9c\xb0\x41\xc4\x89\x13\x6a\xcf\xa0\x8d\xdf\xa5\x46\x8d\xc7\xc2\x60\x7b\xb9\x57\x51\xa1\x54\xbf\xe3\xb2\x35\x04\x6a\xa6\x54\x09\xe7\x53\x69\x05\x85\x4e\xb7\xf3\xbb\x69\x42\x12\x1c\x7c\x49\x2c\xee\x3c\xd3\x7d\xa5\x4e\xa0\xb6\xef\x49\x36\x13\x3e\x1c\x48\x71\x94\xc2\x82\xd1\xe7\xe7\xba\xb2\x02\xf3\x32\x78\x7d\x1c\x39\xc3\xba\x16\x18\xae\x4e\x28\x18\xa0\xba\x46\xe8\x4c\xff\xd0\xfb\xbf\x6d\x1e\x0b\xa3\x9f\x64\x18\x60\x2e\x6b\x22\xc3\xd1\xfd\x95\x3e\xea\x25\x11\x93\x5f\x74\x8c\x1a\x3d\x5d\x34\x1d\xeb\x53\x93\x5e\x74\x41\xf3\x38\x0e\xeb\x8c\x1e\xd2\x47\x3a\x2e\x7c\x07\xdc\xb4\x85\x5f\xa1\xcf\xcb\xbf\xa5\xf8\x91\x45\x3f\x45\x4c\x46\x9a\xf1\x88\x11\x9d\x6c\xa8\x8d\x51\xb6\x6e\x37\x7d\x58\xb3\x89\x4c\x2f\xfd\xf0\x77\x72\xca\x5b\xb4\xbf\x4e\x8f\x5b\xaf\x8f\x98\xd1\xf1\xb2\xb6\xb2\x42\xbb\x1d\x54\xee\xc6\xe7\xf6\x7b\xc3\xa5\xc3\x95\x12\x3c\x65\x6f\x22\x52\x5e\xaf\x83\x86\x27\xab\x6a\x18\xd9\xd0\x16\x34\xb5\x59\x59\x94\xfe\xe1\x2e\x32\xb3\x85\xe7\x2b\xae\xc5\x31\xd5\xd3\x04\x0f\x99\xee\xd9\xec\xff\x14\x24\x21\xa0\xec\xea\xc0\x20\xbb\xfa\x09\x85\xe6\x2f\xba\x43\x0c\x00\x00")
+
+func userViewsResetpassworddialogHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_userViewsResetpassworddialogHtml,
+		"user/views/resetPasswordDialog.html",
+	)
+}
+
+func userViewsResetpassworddialogHtml() (*asset, error) {
+	bytes, err := userViewsResetpassworddialogHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "user/views/resetPasswordDialog.html", size: 3139, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _userViewsVerifyemaildialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x92\xc1\x6e\xc2\x30\x0c\x86\xef\x3c\x85\x95\x13\x1c\x4a\x24\xb4\x13\x6a\x7b\xe1\x1d\x76\x45\x69\x1a\x8a\x45\x9a\x54\x49\x60\x20\xc4\xbb\xcf\x4d\x3b\x08\x1d\x4c\xeb\x25\x95\xed\xfc\xf9\xfd\xd9\x79\x5b\x67\x35\x0a\x6d\x1b\x30\x4d\x26\xb5\x15\x87\x72\x06\xf4\xe5\x94\x08\xd6\xea\x4a\xb8\x21\x10\x83\x35\x9e\x40\x6a\xe1\x7d\xc1\x1e\xf9\x78\x7a\xf6\x28\x8b\xa5\xfb\x55\xf9\xa9\x1c\xee\x2e\xa0\x5a\x81\x1a\x44\x5d\x3b\xe5\x7d\xce\x29\xf1\x5c\xe9\x3b\x61\x60\xa7\xd5\xb9\xcc\x79\xff\x3f\x49\xd3\x43\xd5\x31\x04\x6b\x92\x97\x51\x5a\x33\x46\xd9\x60\x1c\xe5\xa1\x60\x32\x38\xbd\xa4\x26\xbc\x9a\x2f\x26\x7e\x7e\xa4\xfa\x9b\x40\xa7\x3f\x35\x99\x77\xb2\x60\x24\xa9\x82\xe7\xd8\x36\x1c\xe5\x36\x5e\xde\xae\x3e\xba\xf3\x92\x2a\x18\x08\x87\x22\xd3\xa2\x52\xba\x60\x9b\x3e\x07\x03\x2d\x46\x5e\x47\xb5\x89\x5d\x7e\xf7\x9b\x70\xe3\x04\x6e\xe4\xca\xa7\x60\xf3\xfb\x08\x32\x52\x0b\xca\x84\xb7\xc0\x9f\xcb\x18\x68\x71\xb1\xc7\x40\x7d\x5b\x7d\x6c\xcd\x74\x02\x5d\xb9\xe9\xb1\x40\xd8\x2b\xd0\x68\x0e\x80\x26\xfe\x0f\xf3\xf0\xa4\x00\xc1\x42\x5e\x39\xfe\x1b\xd5\xf5\x0a\xeb\x75\xc4\x19\xab\xc7\xe1\xc1\xed\x06\xf3\x47\x2a\x72\xa1\xd8\xe2\x8d\x08\xa9\x93\xd5\x1d\xba\x16\xc8\xa7\x9b\x2e\x42\xf7\x1e\xd0\x2b\x1e\x09\x27\x21\x03\x5a\xe3\xef\xfd\x3b\xfb\x95\x34\xff\xc7\x42\xbd\x5c\xa6\xce\x61\x2b\xdc\xe5\x9f\x8b\x14\xb7\x20\x31\x3e\x19\x77\x6a\x7f\xb4\x59\xce\x92\x60\x39\xfb\x06\xae\xc4\x4b\xf0\x73\x03\x00\x00")
+
+func userViewsVerifyemaildialogHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_userViewsVerifyemaildialogHtml,
+		"user/views/verifyEmailDialog.html",
+	)
+}
+
+func userViewsVerifyemaildialogHtml() (*asset, error) {
+	bytes, err := userViewsVerifyemaildialogHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "user/views/verifyEmailDialog.html", size: 883, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _userViewsVerifyphonedialogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\x4d\x6f\xa3\x30\x10\xbd\xf7\x57\xcc\x5a\x3d\xb4\x87\x14\xa9\x5a\xf5\x50\x01\x97\x9c\xf6\xbc\xab\x5e\x23\x03\x03\xb1\xea\x0f\x64\x9b\x34\x51\xd5\xff\xbe\x63\x43\x52\xca\x9a\x54\xcb\x05\x33\x9e\x79\x9e\x79\xf3\xc6\xe4\xaa\xd9\x34\x82\x4b\xd3\x81\xee\x36\xb5\x34\xfc\xb5\xbc\x01\x7a\xf2\xd6\x58\x05\x9a\x2b\x2c\x58\x58\xb2\xb0\xef\x86\x4a\x09\x5f\xb0\xda\x5b\xf9\x30\x7e\xdc\xdd\xb3\x31\x20\x06\x11\x9a\x37\x46\x56\xdc\x7e\x1a\xe3\x46\x23\x0e\x50\x4b\xee\x5c\xc1\x3e\x7d\xe2\xdb\xb1\xaf\xae\xd1\x7d\xff\x58\xbe\xa0\x15\xed\x09\xfa\xbd\xd1\x98\x67\x64\xf8\xd7\xcb\xf5\x5c\x43\x2b\xf1\x58\xe6\x59\x58\x27\x5c\xe8\xb0\x6a\xf0\xde\xe8\xd9\xe9\xa2\x36\x7a\xb2\xb2\xb1\x6a\x51\xbf\x4e\x45\x11\x03\x0e\xbf\xd4\xb4\x84\x0b\xd1\x40\x6f\x77\x20\x3e\x6c\x5d\x30\x82\x45\xef\x32\xa1\xba\x4c\xd4\xbb\x08\xb0\x7b\xfc\xd9\x1f\x1f\xc8\x83\x01\xb7\x82\x6f\x24\xaf\x50\x16\x6c\x1b\xf6\x60\xa4\x9b\x51\xce\x13\x5a\x22\xed\xec\x92\xf7\x82\xc7\x8c\x88\x9c\xf1\x9d\xa5\x08\xcf\x2f\x3d\xdd\x10\xba\x47\xed\xaf\x36\xe3\xab\x2b\x03\xc9\x4f\x66\x08\x4d\x36\x72\x50\x3a\xd5\x9d\xbe\xdc\x06\xca\xc0\xef\x11\xa4\xd0\xaf\x20\x74\x5c\x3b\xe5\xc0\x11\x06\x78\x03\x84\x61\xc7\xe6\x41\x5e\xd9\x2c\xcd\xa7\xb1\x40\xee\x68\x63\x74\x6d\x1a\x84\xd6\x1a\x75\xc1\xda\xa3\xc5\x80\x45\xa9\xb5\x82\xd4\xb8\x0e\x34\x3b\x4d\x0f\xaa\x22\xc4\xf7\x77\x78\x7e\x8e\x2d\x8d\xe6\xc9\xfa\xf1\x01\x77\x71\x07\xe2\x56\xec\x0b\x19\xef\xf3\xac\x4f\x8b\x47\xe8\x7e\xf0\x91\x1b\x2e\x34\xda\x15\x59\x44\x9c\x72\x4b\x05\xe4\xd9\xb8\x4e\xfb\x45\xb4\x20\x39\x45\xae\xf2\x3c\x47\xca\x85\xd2\x59\x10\x95\xe2\x47\x89\xba\xf3\xfb\x82\x3d\x45\x6d\x2a\xa1\x67\x86\xc5\x76\x1c\xce\x73\x78\xf2\xc4\xe9\xe1\x83\x37\xb5\x51\xbd\x44\x4f\x11\xa6\x6d\x47\xdd\xef\xb9\xee\x70\xca\xc2\x22\xa9\xf8\x85\x4b\xd1\x70\x2f\x8c\x5e\x1f\x81\x20\x9e\x90\x18\x3a\xc7\x3b\x74\xe3\xed\x70\x2e\xe2\xe1\x16\xad\x35\x76\x25\x36\x11\x4f\x0a\x3c\x57\xc8\xca\x3f\x67\x15\xa8\xc1\x79\xa8\x10\xb8\x07\x89\x9c\xd6\x4f\x40\xc9\x5a\x5e\x93\x56\x1c\x48\xa3\xbb\xc5\x20\x7c\x7b\x8a\xd0\x87\x50\xdb\x2e\x52\x55\xfe\x1a\xbf\xc6\xc3\xdc\xd0\xf7\x52\x60\x73\x05\x72\x65\x6b\x9c\xe0\x6b\x0a\x49\xcd\xeb\xda\x68\xce\xc6\x96\x0a\xa5\x1e\xb8\xcb\x28\x5a\xf3\xb6\xa0\x34\x79\xb1\xbd\x71\xfb\x3f\x37\xda\x96\xeb\x1a\xe5\x22\xdf\xb5\x8b\xe7\xfa\x5d\x9b\x4c\xa7\xb7\x42\x71\x7b\x62\xe0\x4f\x7d\x10\x6a\xfc\x5f\x2c\xbf\x28\xdb\x46\x38\x5e\x49\x6c\x0a\xf6\x23\x6a\xe9\x36\x36\x87\x95\xbf\xf9\x01\x93\x09\xcd\x69\x9c\xa8\x9a\x7e\x5a\x59\x00\x28\x6f\x66\x0e\xe5\xcd\x5f\xce\x08\x51\x28\xe3\x06\x00\x00")
+
+func userViewsVerifyphonedialogHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_userViewsVerifyphonedialogHtml,
+		"user/views/verifyPhoneDialog.html",
+	)
+}
+
+func userViewsVerifyphonedialogHtml() (*asset, error) {
+	bytes, err := userViewsVerifyphonedialogHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "user/views/verifyPhoneDialog.html", size: 1763, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -1048,13 +1300,17 @@ var _bindata = map[string]func() (*asset, error){
 	"company/service.js": companyServiceJs,
 	"company/views/detail.html": companyViewsDetailHtml,
 	"company/views/new.html": companyViewsNewHtml,
+	"login/forgotPasswordController.js": loginForgotpasswordcontrollerJs,
 	"login/loginApp.js": loginLoginappJs,
 	"login/loginController.js": loginLogincontrollerJs,
 	"login/loginSmsController.js": loginLoginsmscontrollerJs,
 	"login/loginTotpController.js": loginLogintotpcontrollerJs,
+	"login/resetPasswordController.js": loginResetpasswordcontrollerJs,
+	"login/views/forgotpassword.html": loginViewsForgotpasswordHtml,
 	"login/views/loginform.html": loginViewsLoginformHtml,
 	"login/views/loginsmsform.html": loginViewsLoginsmsformHtml,
 	"login/views/logintotpform.html": loginViewsLogintotpformHtml,
+	"login/views/resetpassword.html": loginViewsResetpasswordHtml,
 	"organization/controller.js": organizationControllerJs,
 	"organization/service.js": organizationServiceJs,
 	"organization/views/apikeydialog.html": organizationViewsApikeydialogHtml,
@@ -1066,13 +1322,17 @@ var _bindata = map[string]func() (*asset, error){
 	"registration/registrationApp.js": registrationRegistrationappJs,
 	"registration/registrationController.js": registrationRegistrationcontrollerJs,
 	"registration/registrationResendSmsController.js": registrationRegistrationresendsmscontrollerJs,
+	"registration/registrationService.js": registrationRegistrationserviceJs,
 	"registration/registrationSmsController.js": registrationRegistrationsmscontrollerJs,
 	"registration/views/registrationform.html": registrationViewsRegistrationformHtml,
 	"registration/views/registrationresendsms.html": registrationViewsRegistrationresendsmsHtml,
 	"registration/views/registrationsmsform.html": registrationViewsRegistrationsmsformHtml,
 	"shared/configService.js": sharedConfigserviceJs,
+	"shared/directives/footer.html": sharedDirectivesFooterHtml,
+	"shared/directives/footer.js": sharedDirectivesFooterJs,
 	"shared/directives/header.html": sharedDirectivesHeaderHtml,
 	"shared/directives/header.js": sharedDirectivesHeaderJs,
+	"shared/shared.js": sharedSharedJs,
 	"user/authorizeController.js": userAuthorizecontrollerJs,
 	"user/controller.js": userControllerJs,
 	"user/directives/authorizationDetails.html": userDirectivesAuthorizationdetailsHtml,
@@ -1086,7 +1346,11 @@ var _bindata = map[string]func() (*asset, error){
 	"user/views/facebookDialog.html": userViewsFacebookdialogHtml,
 	"user/views/githubDialog.html": userViewsGithubdialogHtml,
 	"user/views/home.html": userViewsHomeHtml,
+	"user/views/nameDialog.html": userViewsNamedialogHtml,
 	"user/views/phonenumberdialog.html": userViewsPhonenumberdialogHtml,
+	"user/views/resetPasswordDialog.html": userViewsResetpassworddialogHtml,
+	"user/views/verifyEmailDialog.html": userViewsVerifyemaildialogHtml,
+	"user/views/verifyPhoneDialog.html": userViewsVerifyphonedialogHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -1139,14 +1403,18 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 	}},
 	"login": &bintree{nil, map[string]*bintree{
+		"forgotPasswordController.js": &bintree{loginForgotpasswordcontrollerJs, map[string]*bintree{}},
 		"loginApp.js": &bintree{loginLoginappJs, map[string]*bintree{}},
 		"loginController.js": &bintree{loginLogincontrollerJs, map[string]*bintree{}},
 		"loginSmsController.js": &bintree{loginLoginsmscontrollerJs, map[string]*bintree{}},
 		"loginTotpController.js": &bintree{loginLogintotpcontrollerJs, map[string]*bintree{}},
+		"resetPasswordController.js": &bintree{loginResetpasswordcontrollerJs, map[string]*bintree{}},
 		"views": &bintree{nil, map[string]*bintree{
+			"forgotpassword.html": &bintree{loginViewsForgotpasswordHtml, map[string]*bintree{}},
 			"loginform.html": &bintree{loginViewsLoginformHtml, map[string]*bintree{}},
 			"loginsmsform.html": &bintree{loginViewsLoginsmsformHtml, map[string]*bintree{}},
 			"logintotpform.html": &bintree{loginViewsLogintotpformHtml, map[string]*bintree{}},
+			"resetpassword.html": &bintree{loginViewsResetpasswordHtml, map[string]*bintree{}},
 		}},
 	}},
 	"organization": &bintree{nil, map[string]*bintree{
@@ -1165,6 +1433,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"registrationApp.js": &bintree{registrationRegistrationappJs, map[string]*bintree{}},
 		"registrationController.js": &bintree{registrationRegistrationcontrollerJs, map[string]*bintree{}},
 		"registrationResendSmsController.js": &bintree{registrationRegistrationresendsmscontrollerJs, map[string]*bintree{}},
+		"registrationService.js": &bintree{registrationRegistrationserviceJs, map[string]*bintree{}},
 		"registrationSmsController.js": &bintree{registrationRegistrationsmscontrollerJs, map[string]*bintree{}},
 		"views": &bintree{nil, map[string]*bintree{
 			"registrationform.html": &bintree{registrationViewsRegistrationformHtml, map[string]*bintree{}},
@@ -1175,9 +1444,12 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"shared": &bintree{nil, map[string]*bintree{
 		"configService.js": &bintree{sharedConfigserviceJs, map[string]*bintree{}},
 		"directives": &bintree{nil, map[string]*bintree{
+			"footer.html": &bintree{sharedDirectivesFooterHtml, map[string]*bintree{}},
+			"footer.js": &bintree{sharedDirectivesFooterJs, map[string]*bintree{}},
 			"header.html": &bintree{sharedDirectivesHeaderHtml, map[string]*bintree{}},
 			"header.js": &bintree{sharedDirectivesHeaderJs, map[string]*bintree{}},
 		}},
+		"shared.js": &bintree{sharedSharedJs, map[string]*bintree{}},
 	}},
 	"user": &bintree{nil, map[string]*bintree{
 		"authorizeController.js": &bintree{userAuthorizecontrollerJs, map[string]*bintree{}},
@@ -1196,7 +1468,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"facebookDialog.html": &bintree{userViewsFacebookdialogHtml, map[string]*bintree{}},
 			"githubDialog.html": &bintree{userViewsGithubdialogHtml, map[string]*bintree{}},
 			"home.html": &bintree{userViewsHomeHtml, map[string]*bintree{}},
+			"nameDialog.html": &bintree{userViewsNamedialogHtml, map[string]*bintree{}},
 			"phonenumberdialog.html": &bintree{userViewsPhonenumberdialogHtml, map[string]*bintree{}},
+			"resetPasswordDialog.html": &bintree{userViewsResetpassworddialogHtml, map[string]*bintree{}},
+			"verifyEmailDialog.html": &bintree{userViewsVerifyemaildialogHtml, map[string]*bintree{}},
+			"verifyPhoneDialog.html": &bintree{userViewsVerifyphonedialogHtml, map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -7,6 +7,7 @@
 // error.html
 // apidocumentation.html
 // smsconfirmation.html
+// device.html
 // DO NOT EDIT!
 
 package html
@@ -74,7 +75,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x57\xdb\x6e\xdb\x38\x13\xbe\x2f\xd0\x77\x98\xaa\x17\xbe\x89\xa4\x36\xc9\xff\x77\xd1\xda\x5e\x04\x49\x51\x04\x58\xa0\x45\x1d\xa0\xe8\x55\x40\x4b\x23\x91\xa8\x44\xaa\x24\x65\xd7\xd8\x5d\xa0\x0f\xb2\xfb\x72\x7d\x92\x9d\x21\xa5\xda\x8e\x9b\xad\xf7\x84\xc0\x90\x28\xce\xf1\xfb\x66\x86\xcc\xf4\xd1\xd5\xeb\xcb\x9b\xf7\x6f\x5e\x82\xf4\x6d\x33\x7f\xf8\x60\xca\x4f\x68\x84\xae\x67\x09\xea\x24\x7c\x41\x51\xd2\x13\x60\xda\xa2\x17\x24\xe8\xbb\x14\x3f\xf6\x6a\x35\x4b\x2e\x8d\xf6\xa8\x7d\x7a\xb3\xe9\x30\x81\x22\xae\x66\x89\xc7\x4f\x3e\x67\x43\x2f\xa0\x90\xc2\x3a\xf4\xb3\xde\x57\xe9\x0f\x09\xe4\x3b\x86\xb4\x68\x71\x96\xac\x14\xae\x3b\x63\xfd\x8e\xfa\x5a\x95\x5e\xce\x4a\x5c\xa9\x02\xd3\xb0\x38\x01\xa5\x95\x57\xa2\x49\x5d\x21\x1a\x9c\x3d\xcd\x9e\x24\xd1\x92\x57\xbe\xc1\xf9\xb5\x9f\x38\x78\x6f\x7a\x78\xad\x1b\xa5\x71\x9a\xc7\xcf\x41\x82\x3e\x7c\x00\x8b\xcd\x2c\x71\x7e\xd3\xa0\x93\x88\xe4\x4c\x5a\xac\x66\x89\x70\x14\x9b\xcb\x0b\xe7\xf2\xb0\x99\xd1\x5b\x92\xff\x25\xbd\x8a\xa2\x4e\xc5\x1a\x9d\x69\x31\x6b\x95\x3e\x30\x11\x34\x26\x8c\x9a\x7b\x9e\x07\x71\x97\xd5\xc6\xd4\x0d\x8a\x4e\xb9\xac\x30\x2d\xdb\xf9\xb1\x12\xad\x6a\x36\xb3\x77\xd8\x34\x55\x43\xae\x7e\xb9\xb0\x2b\xf3\xfc\xfc\xc9\x93\x93\x67\xf4\xa3\xa7\xf2\xa2\x51\x05\xaf\xe2\xdb\x24\x04\x37\xd9\x06\x37\x01\x4f\x34\xcc\x26\x01\x7d\x32\x39\x89\x41\x04\x81\xf0\x2a\x4f\xe1\x67\xe0\xdd\xd4\x49\x51\x9a\xf5\x73\x38\xed\x3e\x85\xdf\xe3\xb3\x4b\xfe\x7b\x01\xbf\x3e\x7c\x10\x94\xf2\x51\x6b\x9a\x0f\xf4\x4f\x97\xa6\xdc\x8c\xe5\x80\x16\x54\x39\x4b\xe2\xeb\xc0\x44\xa9\x56\x50\x34\x84\xcc\x2c\x19\x98\x8c\x1b\xc3\x16\xcb\x37\xa6\x36\xc9\x7c\x2a\x06\x14\x1f\x27\x73\xb8\xf6\x8e\x78\xcb\x22\x6f\xe4\x57\xcc\xa7\x39\x89\xef\x6a\x0e\x46\x9d\xaa\x75\xba\xb6\xa2\x1b\xcd\xd2\xf6\x68\xc9\x62\xad\x9c\xe7\x50\xa6\x6a\x94\xaf\x04\x54\x22\xed\x1d\xda\xb4\x6b\x7a\x47\x5b\xb9\x9a\xbf\x1d\x04\xd9\xd1\x81\x15\x0a\x4f\xe9\x43\x13\xc1\x71\xd8\x20\x03\x0b\x5a\x50\x31\x6e\xf5\xb7\xe1\x8e\x6f\x11\x32\xb4\x73\xc6\x72\xfa\x28\x4d\x61\xf1\xd3\xf5\xd5\xcb\x05\x2c\x6e\x2e\xde\xde\x40\x9a\xb2\xcc\x08\x89\x6b\x54\x89\x4f\xbf\x0f\xa1\x3c\x9d\x13\x4e\x16\x4c\x04\x8a\x94\x34\xd5\xf8\x86\x7c\x9d\xee\x88\x28\x0d\x9b\x20\xb5\xd6\x20\x85\x2e\xdd\xd7\xfd\x6d\x70\xc3\x73\x2f\x80\xd3\xfb\x03\x80\x6f\x50\xf1\xb1\x37\x1e\xdd\x2d\x8b\x08\x8a\xc6\xee\x30\x22\xcf\xf6\x85\x92\xf9\x40\x6d\x67\xd1\xa1\x2e\x10\x5a\x14\xda\x11\x84\xc4\x82\x28\xbc\x32\x3a\x83\x4b\xd3\x76\x42\x2b\x74\xa0\x11\xcb\x98\xc2\x98\x21\xac\xa5\x2a\x24\x7f\x1b\x4c\x78\xd2\x85\x52\x55\x15\x5a\x5e\xac\xc5\xc6\x9d\x40\x89\x1d\xea\x52\xe9\x9a\x00\x02\x2f\x91\x86\x09\x9b\xdc\x9c\x40\x87\xd6\xd1\x37\x63\xe3\x7c\xf9\xe4\x33\xc2\xe4\xec\x4f\xc8\xfb\x16\x3e\xff\x3b\x96\x20\xf6\xdb\xf6\x5a\x15\xc2\x23\x98\x15\xf5\xc9\x4a\x58\x65\x7a\xc7\x83\x50\x6b\x6c\x1c\x10\x2d\x87\xac\x6d\xf3\x19\xa2\x74\xb0\xec\x29\x53\x9e\x6b\xa2\xe1\x1c\x19\x81\x23\xd9\xfc\xff\x7f\xc8\xe6\x97\xcf\xbf\xdd\x48\x8c\x18\xbf\xb2\x4a\x13\x35\x5e\x9a\xbe\x96\x1e\x4c\x05\x3c\x04\xbd\x64\x1a\x68\x9b\x66\x8c\x9e\x78\x58\x62\x65\x2c\x3e\x82\x77\x52\x50\x42\x15\x5c\x4a\x4b\x2d\xd8\x0a\x62\x8d\x84\x06\x65\x62\xd0\xa0\x23\xf1\xd1\x35\x30\x92\x08\x95\x35\x2d\x08\x70\x9e\x4c\x64\x87\x26\xb2\x2c\x63\x82\xa5\xe8\xc2\x6b\x2c\x2d\x01\x8d\xf2\x34\xfe\x61\xa9\x3c\xb4\xec\xfb\xcb\xe7\xdf\x77\x48\xa7\xbc\x54\x5b\x83\xb3\xc5\xd7\x41\x4e\xeb\xbc\xb4\xa9\xc3\xde\xb9\xac\xd3\x75\x42\xa0\x53\xa3\xd3\x48\xc0\xca\x0f\xd3\x3c\x02\x72\x3e\x02\x22\x7a\x0a\xdd\xde\xf2\x21\x76\x5b\x5b\xb1\x49\xe6\x57\x36\x83\x05\x5b\x20\x7a\xe4\xf9\x56\x67\x69\xf3\x7b\x16\x87\xf0\x5e\xe8\xdd\xc2\x27\x9c\xb7\xf5\xc4\xa8\x2a\xea\x10\xe3\x41\x2c\x0d\x15\x47\x28\xf3\x58\x55\x5c\xde\xbc\xec\xac\x59\x91\xbe\x3d\x19\x2a\x27\xc8\xad\xa5\x09\xed\x23\x2c\xc1\x2d\x9a\x0f\x6c\xc8\x1b\xee\xa2\xd8\x5a\xff\xa0\x2f\xce\x8e\xea\x8b\x85\x64\xd7\x7d\xc7\x5e\x4b\x6e\x0c\xa5\xa9\x24\x5a\xc1\xcd\x7f\xa7\x15\xb8\xe8\x69\xc4\x6d\x40\x38\x68\x7b\x0a\x4e\x84\xc2\xa7\x2e\xd7\xfe\xc8\xea\x3f\x3f\xee\x3c\xfa\x3b\xc5\x7f\x65\x62\x41\x83\xa8\xac\x50\x25\xe7\xe3\xa4\x59\xc7\x81\x55\x09\x1a\x6e\x34\x72\xda\x50\xaa\x31\x67\xe6\x64\x27\xd9\x98\x8a\x72\x32\x6a\x06\x09\xc3\xe5\x8d\x96\xef\x3a\x5f\xe9\xe3\x19\xc1\x6e\x5c\x4f\x12\x71\xa0\x93\x25\xbb\x67\x8a\x4a\xc1\x89\x0a\x8f\x63\x6d\xe7\x0c\x0e\x18\x3d\xfb\x16\x14\x77\x50\xba\xbb\x4d\xd4\x78\xa3\xd3\x02\x79\x72\xc7\x6b\x44\x7c\xdf\x55\xd8\x57\x29\x4d\xb1\xbf\x19\x0e\x5b\x2f\x6c\x4d\x37\xc2\xe4\x76\x49\xd7\xcc\x0f\xc9\xf6\x32\xb0\x2f\x09\x70\x65\x8a\xbe\x25\x17\x21\xe1\x3b\x66\x76\xce\xef\xfd\xd4\xef\x89\x83\xe6\x4b\x71\x7a\x74\x30\x74\x33\x2b\x77\x9d\x1f\xc6\x76\xf1\xe6\xfa\x5f\x8f\xef\xec\xe8\xf8\xc6\xdb\x64\xad\xbc\xec\x97\xe1\x0e\xa9\xbc\xa3\x4a\x89\xd7\x83\xc3\x70\xa7\x8e\x4e\xc3\xd1\x5d\xd4\x4a\xf9\x76\x7a\x28\x09\xb0\xa0\x82\x2b\xf8\x04\x2d\x91\x4f\xd3\x57\x41\xfa\xc0\x60\xce\x16\xef\xc6\xfb\x9d\xac\xf7\xae\x76\xf7\x8f\x98\x7c\xbc\x6a\xe6\xc3\x3f\x25\x7f\x04\x00\x00\xff\xff\xa5\xdd\xaf\x8b\xa6\x0c\x00\x00")
+var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x57\xdd\x6e\xdb\x36\x14\xbe\x2f\xd0\x77\x38\x51\x2f\x7c\x13\x49\x69\x92\xad\x43\x6a\x79\x08\x92\xa2\x08\x30\xa0\x45\x1d\xa0\xe8\x55\x40\x4b\x47\x22\x11\x89\x54\x49\xca\x8e\xb1\x0d\xe8\x83\x6c\x2f\xd7\x27\xd9\x21\x29\xc5\x72\x9c\xac\x5e\xb7\x21\x30\x24\x8a\xe7\xf7\xfb\xce\x39\x64\xa6\x07\x97\xef\x2e\xae\x3f\xbd\x7f\x03\xdc\x36\xf5\xec\xf9\xb3\xa9\x7b\x42\xcd\x64\x95\x45\x28\x23\xff\x05\x59\x41\x4f\x80\x69\x83\x96\x91\xa0\x6d\x63\xfc\xdc\x89\x65\x16\x5d\x28\x69\x51\xda\xf8\x7a\xdd\x62\x04\x79\x58\x65\x91\xc5\x3b\x9b\x3a\x43\xaf\x21\xe7\x4c\x1b\xb4\x59\x67\xcb\xf8\xa7\x08\xd2\x91\x21\xc9\x1a\xcc\xa2\xa5\xc0\x55\xab\xb4\x1d\xa9\xaf\x44\x61\x79\x56\xe0\x52\xe4\x18\xfb\xc5\x21\x08\x29\xac\x60\x75\x6c\x72\x56\x63\xf6\x32\x39\x8a\x82\x25\x2b\x6c\x8d\xb3\x2b\x3b\x31\xf0\x49\x75\xf0\x4e\xd6\x42\xe2\x34\x0d\x9f\xbd\x04\x7d\xb8\x05\x8d\x75\x16\x19\xbb\xae\xd1\x70\x44\x72\xc6\x35\x96\x59\xc4\x0c\xc5\x66\xd2\xdc\x98\xd4\x6f\x26\xf4\x16\xa5\xff\x48\xaf\xa4\xa8\x63\xb6\x42\xa3\x1a\x4c\x1a\x21\x77\x4c\x78\x8d\x89\x43\xcd\x9c\xa5\x5e\xdc\x24\x95\x52\x55\x8d\xac\x15\x26\xc9\x55\xe3\xec\xfc\x5c\xb2\x46\xd4\xeb\xec\x23\xd6\x75\x59\x93\xab\xdf\xce\xf5\x52\x9d\x9d\x1e\x1d\x1d\xbe\xa2\x1f\x3d\x85\x65\xb5\xc8\xdd\x2a\xbc\x4d\x7c\x70\x93\x4d\x70\x13\xb0\x44\x43\x36\xf1\xe8\x93\xc9\x49\x08\xc2\x0b\xf8\x57\x7e\x0c\xbf\x82\xdb\x8d\x0d\x67\x85\x5a\x9d\xc1\x71\x7b\xe7\x7f\x2f\x4e\x2e\xdc\xdf\x6b\xf8\xfd\xf9\x33\xaf\x94\x0e\x5a\xd3\xb4\xa7\x7f\xba\x50\xc5\x7a\x28\x07\xd4\x20\x8a\x2c\x0a\xaf\x3d\x13\x85\x58\x42\x5e\x13\x32\x59\xd4\x33\x19\x36\xfa\x2d\x27\x5f\xab\x4a\x45\xb3\x29\xeb\x51\x7c\x11\xcd\xe0\xca\x1a\xe2\x2d\x09\xbc\x91\x5f\x36\x9b\xa6\x24\x3e\xd6\xec\x8d\x1a\x51\xc9\x78\xa5\x59\x3b\x98\xa5\xed\xc1\x92\xc6\x4a\x18\xeb\x42\x99\x8a\x41\xbe\x64\x50\xb2\xb8\x33\xa8\xe3\xb6\xee\x0c\x6d\xa5\x62\xf6\xa1\x17\x74\x8e\x76\xac\x50\x78\x42\xee\x9a\xf0\x8e\xfd\x06\x19\x98\xd3\x82\x8a\x71\xa3\xbf\x09\x77\x78\x0b\x90\xa1\x9e\x39\x2c\xa7\x07\x71\x0c\xf3\x5f\xae\x2e\xdf\xcc\x61\x7e\x7d\xfe\xe1\x1a\xe2\xd8\xc9\x0c\x90\x98\x5a\x14\xf8\xf2\xdb\x10\xf2\xe3\x19\xe1\xa4\x41\x05\xa0\x48\x49\x52\x8d\xaf\xc9\xd7\xf1\x48\x44\x48\x58\x7b\xa9\x95\x04\xce\x64\x61\xee\xf7\x37\xc1\xf5\xcf\xad\x00\x8e\x9f\x0e\x00\x1e\xa1\xe2\x73\xa7\x2c\x9a\x1b\x27\xc2\x28\x1a\x3d\x62\x84\x9f\x6c\x0b\x45\xb3\x9e\xda\x56\xa3\x41\x99\x23\x34\xc8\xa4\x21\x08\x89\x05\x96\x5b\xa1\x64\x02\x17\xaa\x69\x99\x14\x68\x40\x22\x16\x21\x85\x21\x43\x58\x71\x91\x73\xf7\xad\x37\x61\x49\x17\x0a\x51\x96\xa8\xdd\x62\xc5\xd6\xe6\x10\x0a\x6c\x51\x16\x42\x56\x04\x10\x58\x8e\x34\x4c\x9c\xc9\xf5\x21\xb4\xa8\x0d\x7d\x53\x3a\xcc\x97\x3b\x9b\x10\x26\x27\x7f\x43\xde\x63\xf8\xfc\xb0\x2f\x41\xce\x6f\xd3\x49\x91\x33\x8b\xa0\x96\xd4\x27\x4b\xa6\x85\xea\x8c\x1b\x84\x52\x62\x6d\x80\x68\xd9\x65\x6d\x93\x4f\x1f\xa5\x81\x45\x47\x99\xba\xb9\xc6\x6a\x97\xa3\x43\x60\x4f\x36\x7f\xfc\x1f\xd9\xfc\xfa\xe5\x8f\x6b\x8e\x01\xe3\xb7\x5a\x48\xa2\xc6\x72\xd5\x55\xdc\x82\x2a\xc1\x0d\x41\xcb\x1d\x0d\xb4\x4d\x33\x46\x4e\x2c\x2c\xb0\x54\x1a\x0f\xe0\x23\x67\x94\x50\x09\x17\x5c\x53\x0b\x36\x8c\x58\x23\xa1\x5e\x99\x18\x54\x68\x48\x7c\x70\x0d\x0e\x49\x84\x52\xab\x06\x18\x18\x4b\x26\x92\x5d\x13\x49\x92\x38\x82\x39\x6b\xfd\x6b\x28\x2d\x06\xb5\xb0\x34\xfe\x61\x21\x2c\x34\xce\xf7\xd7\x2f\x7f\x8e\x48\xa7\xbc\x44\x53\x81\xd1\xf9\xfd\x20\xa7\x75\x5a\xe8\xd8\x60\x67\x4c\xd2\xca\x2a\x22\xd0\xa9\xd1\x69\x24\x60\x69\xfb\x69\x1e\x00\x39\x1d\x00\x61\x1d\x85\xae\x6f\xdc\x21\x76\x53\x69\xb6\x8e\x66\x97\x3a\x81\xb9\xb3\x40\xf4\xf0\xd3\x8d\xce\x42\xa7\x4f\x2c\x76\xe1\x3d\x97\xe3\xc2\x27\x9c\x37\xf5\xe4\x50\x15\xd4\x21\xca\x02\x5b\x28\x2a\x0e\x5f\xe6\xa1\xaa\x5c\x79\xbb\x65\xab\xd5\x92\xf4\xf5\x61\x5f\x39\x5e\x6e\xc5\x95\x6f\x1f\xa6\x09\x6e\x56\xdf\x3a\x43\x56\xb9\x2e\x0a\xad\xf5\x2f\xfa\xe2\x64\xaf\xbe\x98\x73\xe7\xba\x6b\x9d\xd7\xc2\x35\x86\x90\x54\x12\x0d\x73\xcd\xff\xa0\x15\x5c\xd1\xd3\x88\x5b\x03\x33\xd0\x74\x14\x1c\xf3\x85\x4f\x5d\x2e\xed\x9e\xd5\x7f\xba\xdf\x79\xf4\x3d\xc5\x7f\xa9\x42\x41\x03\x2b\x35\x13\x85\xcb\xc7\x70\xb5\x0a\x03\xab\x64\x34\xdc\x68\xe4\x34\xbe\x54\x43\xce\x8e\x93\x51\xb2\x21\x15\x61\x78\xd0\xf4\x12\xca\x95\x37\x6a\x77\xd7\xb9\xa7\xcf\xcd\x08\xe7\xc6\x74\x24\x11\x06\x3a\x59\xd2\x5b\xa6\xa8\x14\x0c\x2b\x71\x3f\xd6\x46\x67\xb0\xc7\xe8\xd5\x63\x50\x3c\x40\xe9\xe1\x36\x51\x63\x95\x8c\x73\x74\x93\x3b\x5c\x23\xc2\xfb\x58\x61\x5b\xa5\x50\xf9\xf6\xa6\x3f\x6c\x2d\xd3\x15\xdd\x08\xa3\x9b\x05\x5d\x33\x6f\x87\x2b\xd5\x70\x41\xaa\x44\x95\x54\xc2\x2e\x94\xba\x35\x89\x50\xa9\xb0\x86\x10\x08\xc7\x5e\xda\x87\x98\x92\x61\x6a\x5a\x69\xb5\x2a\xba\x70\x86\xb8\xdb\xe6\x43\x5f\x00\x97\x2a\xef\x1a\x52\xf0\x90\x3d\x08\x64\x74\x03\xd8\x06\xef\x89\x4c\x68\x42\xe5\xc7\x7b\xa7\x43\x77\xbb\x62\xec\x7c\x37\xb6\xf3\xf7\x57\xff\x79\x7c\x27\xdf\x01\xb7\xe5\xdd\xc2\xdf\x42\xc7\x48\xef\x86\x3b\x35\x74\x9e\x0e\xee\x82\x56\xec\xee\xb7\xbb\x92\x00\x73\x2a\xd9\xdc\x9d\xc1\x05\xba\xf3\xf8\xad\x97\xde\x31\x98\x3a\x8b\x0f\xe3\xfd\x46\xd6\x5b\x97\xc3\xa7\x87\x54\x3a\x5c\x56\xd3\xfe\xdf\x9a\xbf\x00\xe7\xe8\xe8\xfa\xe8\x0c\x00\x00")
 
 func indexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,12 +90,12 @@ func indexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "index.html", size: 3238, mode: os.FileMode(509), modTime: time.Unix(1463480440, 0)}
+	info := bindataFileInfo{name: "index.html", size: 3304, mode: os.FileMode(509), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _registrationHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x56\x51\x4f\xdc\x38\x10\x7e\xdf\x5f\xe1\xf3\xcb\xbe\xe0\x98\x93\x0e\x81\x96\xcd\x9e\x10\x77\x95\xfa\x44\x05\x54\x15\x6a\x2b\x64\x9c\x49\xd6\x8b\x63\x07\x7b\x76\x61\xd5\xf6\xbf\x77\x9c\x10\x1a\x56\x2d\x02\xb1\x44\x42\xc9\x8c\xbf\xef\x1b\xcf\x30\x1e\xef\xf4\xaf\xff\x4e\x8e\xcf\x2f\x3e\xfc\xcf\xe6\x58\xdb\xd9\x68\x9a\x5e\xcc\x2a\x57\xe5\x1c\x1c\x4f\x0e\x50\xc5\x6c\xc4\xe8\x99\xd6\x80\x8a\x70\xd8\x08\xb8\x59\x9a\x55\xce\x8f\xbd\x43\x70\x28\xce\xd7\x0d\x70\xa6\x3b\x2b\xe7\x08\x77\x28\x93\xd0\x21\xd3\x73\x15\x22\x60\xbe\xc4\x52\x1c\x70\x39\x14\x72\xaa\x86\x9c\xaf\x0c\xdc\x36\x3e\xe0\x80\x7e\x6b\x0a\x9c\xe7\x05\xac\x8c\x06\xd1\x1a\x3b\xcc\x38\x83\x46\x59\x11\xb5\xb2\x90\xff\x9d\xed\xf2\x7b\x29\x34\x68\x61\x76\x0a\x95\x89\x18\x14\x1a\xef\x98\x60\xef\x71\x1c\xd9\x85\x5f\xb2\x13\x67\x8d\x83\xa9\xec\x50\x1d\x83\x3c\xd7\x2c\x80\xcd\xc7\x11\xd7\x16\xe2\x1c\x00\x59\x13\xa0\x04\xd4\xf3\x71\x8b\xe9\x9e\x39\xf9\xf2\x71\xca\x37\x4e\xa4\x54\x0b\x75\x97\x55\xde\x57\x16\x54\x63\x62\xa6\x7d\xdd\xfa\xa4\x35\x57\x51\x52\xc1\x96\x56\x85\xcb\x5a\x21\x04\xda\xa8\xa4\x2d\x66\xfb\xbd\x5b\xf4\xee\xac\x36\x2e\xd3\x31\x8e\x37\xf7\xc2\x7f\xed\x85\x77\x81\xb9\x8a\x54\xb8\x28\x09\x2d\xdb\xc5\xc4\x7b\xa8\xe0\x33\x89\x25\xd5\x54\xa8\x5b\x88\xbe\x86\x3e\xf6\x63\x8d\xc7\x49\x26\x7c\xdc\xcc\x92\x38\xff\x96\xaa\x36\x76\x9d\x7f\x02\x6b\x4b\x4b\xb1\xbe\x1f\x85\x95\x9f\xfc\xb3\xbb\xbb\xb3\x4f\x7f\xf4\x36\xa8\xac\xd1\xc9\xea\xbe\xc6\x9b\x25\x1e\x56\x16\xa9\x5f\xf2\x71\xdb\x26\x83\x62\xb4\xd8\xd9\xe8\x01\xf7\xd9\x55\x5f\x26\xda\x7a\x75\xfd\x75\x27\x19\xa2\xff\xce\xfa\x6f\xf6\x6d\x20\xca\x58\x61\x62\x63\xd5\x7a\xc2\x9c\x77\x70\xf8\xb0\xf4\xa3\x93\x97\xf7\xfa\x53\xd9\xb5\xf4\x68\x7a\xe5\x8b\x35\x23\x2d\xd5\x34\x39\x0f\x83\x16\x3a\x6a\x1a\x9e\x16\xc8\x36\x1a\x45\x61\x12\xda\x60\x14\x6b\xbf\x14\xbe\xed\x29\x91\x44\x20\xb0\x8e\x06\x61\x36\x95\xbf\x05\x24\x66\x61\x56\x4c\x5b\xfa\xb7\xe4\xbc\x5c\x5a\x1b\x75\x00\x70\xa5\x0f\x75\x1b\x24\x9d\x00\x62\x13\x28\x61\x69\xd1\x34\xc8\x62\xd0\x2f\x6c\xbd\x45\xa4\x9e\xdb\xcb\xf6\x7a\x3b\x5b\x50\x61\x29\xe9\x56\x6f\xb6\x45\x61\xa1\x9c\x49\xfd\xdc\xf6\xd3\x9b\x05\xa9\x21\x46\x55\x41\x7c\xdb\x28\xc1\x2f\xdf\x3a\x11\x45\xe7\x7e\xab\x11\x9e\x35\x63\xfe\x1c\x8c\xe3\xdc\x84\xa2\x51\x01\xd7\xf7\x83\xe2\x26\x68\x5f\x80\xa8\xc0\x01\xb5\xbf\x0f\x72\xd1\xfb\x48\x86\x6f\x41\xe6\xf2\xe3\xf9\xbb\x83\x17\x69\xf5\x39\x75\xfc\x0d\xf3\x29\x25\xaa\x58\x43\x87\x9f\xa6\x98\x8c\x74\xf5\x40\x21\xe9\x5a\x29\x4d\x75\x06\x21\x5d\x27\x2f\xa3\x16\x26\x80\x46\xb3\x82\x28\xbb\xc3\xfc\x4c\xfa\x70\x94\xc8\x8d\xb9\xf2\x5a\x89\x74\xe5\x06\x6f\xed\xeb\x37\x73\x56\xc7\xed\x89\x9d\x42\x04\x57\x3c\x29\x29\xd3\xb8\x6d\xc7\x6f\xfb\x4b\xe3\x67\x00\x00\x00\xff\xff\x42\xdc\x61\x76\x7a\x08\x00\x00")
+var _registrationHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x56\x51\x4f\xdb\x30\x10\x7e\xef\xaf\xf0\xfc\xd2\x17\x1c\x33\x69\x08\x54\x9a\x4e\x88\x6d\xd2\x9e\x98\x80\x69\x42\xdb\x84\x8c\x73\x49\x5c\x1c\x3b\xd8\x4e\x21\xda\xf6\xdf\x77\x4e\x1a\x56\xaa\x81\xa8\x28\x91\xaa\xf8\xce\xdf\xf7\x9d\xef\x7a\x3d\x77\xfa\xe6\xc3\xc9\xf1\xf9\xc5\x97\x8f\xa4\x0c\x95\x9e\x8d\xa6\xf1\x45\xb4\x30\x45\x4a\xc1\xd0\xe8\x00\x91\xcd\x46\x04\x9f\x69\x05\x41\x20\x2e\xd4\x0c\x6e\x1a\xb5\x48\xe9\xb1\x35\x01\x4c\x60\xe7\x6d\x0d\x94\xc8\xde\x4a\x69\x80\xbb\xc0\xa3\xd0\x21\x91\xa5\x70\x1e\x42\xda\x84\x9c\x1d\x50\xbe\x2a\x64\x44\x05\x29\x5d\x28\xb8\xad\xad\x0b\x2b\xf4\x5b\x95\x85\x32\xcd\x60\xa1\x24\xb0\xce\xd8\x21\xca\xa8\xa0\x84\x66\x5e\x0a\x0d\xe9\xdb\x64\x97\x2e\xa5\x82\x0a\x1a\x66\xa7\x50\x28\x1f\x9c\x08\xca\x1a\xc2\xc8\xe7\x30\xf6\xe4\xc2\x36\xe4\xc4\x68\x65\x60\xca\x7b\x54\xcf\x40\xcf\x35\x71\xa0\xd3\xb1\x0f\xad\x06\x5f\x02\x04\x52\x3b\xc8\x21\xc8\x72\xdc\x61\xfa\xa7\x44\x5f\x3a\x8e\xf9\xfa\x09\xe7\x62\x2e\xee\x92\xc2\xda\x42\x83\xa8\x95\x4f\xa4\xad\x3a\x1f\xd7\xea\xca\x73\x2c\x58\xa3\x85\xbb\xac\x44\x00\x87\x07\xe5\x78\xc4\x64\x7f\x70\xb3\xc1\x9d\x54\xca\x24\xd2\xfb\xf1\xfa\x59\xe8\xbf\xb3\xd0\x3e\x30\x15\x1e\x0b\xe7\x39\xa2\x79\xb7\x19\x79\xf7\x15\x7c\x26\x31\xc7\x9a\x32\x71\x0b\xde\x56\x30\xc4\x7e\xa8\xf1\x30\xc9\x88\xf7\xeb\x59\x22\xe7\x7d\x2e\x2a\xa5\xdb\xf4\x1b\x68\x9d\x6b\x8c\xf5\xfb\xc8\x2d\xec\xe4\xdd\xee\xee\xce\x3e\x7e\xf0\xad\x82\xd0\x4a\x46\xab\x5f\x8d\xd7\x4b\xbc\x5a\xd9\x80\xfd\x92\x8e\xbb\x36\x59\x29\x46\x87\x9d\x8d\xee\x71\xdf\x4d\xf1\x63\x22\xb5\x15\xd7\x3f\x77\xa2\xc1\x86\x75\x32\xac\xc9\xaf\x15\x51\x42\x32\xe5\x6b\x2d\xda\x09\x31\xd6\xc0\xe1\xfd\xd6\x9f\x5e\x9e\x2f\xf5\xa7\xbc\x6f\xe9\xd1\xf4\xca\x66\x2d\x41\x2d\x51\xd7\x29\x55\xc1\xb7\xb6\xb1\x5d\xc3\x24\x6e\xa5\x9f\x68\x84\xa0\xa1\x64\x60\x99\x8a\x3c\x84\x32\xc4\xb2\x1e\xcc\xa2\x1c\x38\xd2\x73\xc0\xcd\xa6\xfc\xbf\x80\xc8\xcc\xd4\x82\x48\x8d\x5f\x50\x4a\xf3\x46\x6b\x2f\x1d\x80\xc9\xad\xab\xba\x20\xf1\xb7\x80\x6c\x04\x45\x2c\x6e\xaa\x3a\x10\xef\xe4\x86\x4d\x38\xf7\xd8\x7d\x7b\xc9\xde\x60\x27\x73\x2c\x31\xa6\xdf\xe9\xcd\xb6\x28\xcc\x84\x51\xb1\xb3\xbb\xce\x7a\xb5\x20\x15\x78\x2f\x0a\xf0\xaf\x1b\xc5\xd9\xe6\xb5\x13\x11\x38\x01\xb6\x1a\xe1\x59\xd3\xe6\xf1\x60\x34\x94\xca\x65\xb5\x70\xa1\x5d\x8e\x8c\x1b\x27\x6d\x06\xac\x00\x03\xd8\xfb\xd6\xf1\xf9\xe0\x43\x19\xba\x05\x99\xcb\xaf\xe7\x9f\x0e\x36\xd2\x1a\x72\xea\xf9\x6b\xe6\x53\x4a\x58\xb1\x1a\xc7\x00\xce\x33\xee\xf1\x12\x82\x6c\xf9\xda\x8c\x83\x97\x52\xae\x8a\x33\x70\xf1\x32\xda\x8c\x9a\x29\x07\x32\xa8\x05\x78\xde\x0f\x80\x67\xd2\x57\x67\xcf\x03\xe3\xa8\xae\x5f\x2a\xb1\x59\x22\x8f\xca\xc4\x7b\xdf\x59\xad\x5f\x9e\xd3\x59\xe5\xb7\x27\x76\x0a\x1e\x4c\xf6\xa4\x24\x8f\x33\xbf\xbb\x03\xba\xbf\x3b\x7f\x01\x15\xf8\x05\xa2\xff\x08\x00\x00")
 
 func registrationHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,12 +110,12 @@ func registrationHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "registration.html", size: 2170, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "registration.html", size: 2303, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _loginHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x55\x51\x6f\xd3\x30\x10\x7e\xef\xaf\x30\x7e\xc9\xcb\x1c\x0f\x89\x69\xa8\x4b\x82\xa6\xc1\x03\x12\xd2\x90\x98\x84\x26\x40\xc8\x73\x2e\xa9\x3b\xc7\x0e\xb6\xd3\xad\x02\xfe\x3b\x67\x67\x19\xdd\xc4\xc6\x46\x47\xa5\x2a\xf6\xf9\xbb\xef\x7c\x5f\x3e\x3b\xc5\xb3\xd7\xc7\x47\x27\xa7\xef\xdf\x90\x45\xe8\x74\x35\x2b\xe2\x83\x68\x61\xda\x92\x82\xa1\x31\x00\xa2\xae\x66\x04\x7f\x45\x07\x41\x20\x2e\xf4\x0c\xbe\x0d\x6a\x55\xd2\x23\x6b\x02\x98\xc0\x4e\xd6\x3d\x50\x22\xc7\x59\x49\x03\x5c\x06\x1e\x89\x0e\x88\x5c\x08\xe7\x21\x94\x43\x68\xd8\x4b\xca\x37\x89\x8c\xe8\xa0\xa4\x2b\x05\x17\xbd\x75\x61\x23\xfd\x42\xd5\x61\x51\xd6\xb0\x52\x12\x58\x9a\xec\x10\x65\x54\x50\x42\x33\x2f\x85\x86\xf2\x79\xbe\x4b\xaf\xa8\x82\x0a\x1a\xaa\x77\xb6\x45\x08\x61\xe4\x6d\xc8\x3c\x39\xb5\x03\x39\x36\x5a\x19\x28\xf8\xb8\x3e\x1b\xc1\x18\x3a\x27\x0e\x74\x99\xf9\xb0\xd6\xe0\x17\x00\x81\xf4\x0e\x1a\x08\x72\x91\x25\xcc\xf8\x5b\x60\xac\xcc\x62\xab\x7e\xce\xb9\x58\x8a\xcb\xbc\xb5\xb6\xd5\x20\x7a\xe5\x73\x69\xbb\x14\xe3\x5a\x9d\x79\x8e\x5a\x0d\x5a\xb8\xaf\x9d\x08\xe0\x70\x8f\x1c\x77\x97\xef\x4f\x61\x36\x85\xf3\x4e\x99\x5c\x7a\x9f\x55\xb7\xf6\x42\x7f\xef\x85\x8e\x85\xa9\xf0\xa8\x99\xe7\x88\xe6\x69\x31\xe6\x5d\x8b\xf7\xc0\xc4\x06\xe5\x64\xe2\x02\xbc\xed\x60\xaa\x7d\x93\xe3\x66\x93\x11\xef\x6f\x77\x89\x39\xaf\x1a\xd1\x29\xbd\x2e\x3f\x82\xd6\x8d\xc6\x5a\x3f\x0e\xdd\xca\xce\x5f\xec\xee\xee\xec\xe3\x1f\x9f\x2a\x08\xad\x64\x9c\x8d\xa3\xec\xb6\xc4\x9b\xca\x06\xb4\x4a\x99\x25\x87\x6c\x88\x91\xb0\xd5\x35\xec\x93\x69\x3f\xcf\xa5\xb6\xe2\xfc\xcb\x4e\x9c\xb0\x69\x9c\x4f\x63\xf2\x7d\x83\x93\x90\x5a\xf9\x5e\x8b\xf5\x9c\x18\x6b\xe0\xe0\x7a\xe9\xe7\xc8\xce\xaf\xe8\x0b\x3e\x9a\x79\x56\x9c\xd9\x7a\x4d\x90\x4b\xf4\x7d\x49\xb5\x6d\x95\x39\xec\x7b\x1a\x23\x3e\x38\x25\x03\xab\x15\xc2\x55\xf0\x6c\x6d\x07\x66\x93\x97\x58\x4c\x06\x47\x12\xbc\x2a\xf8\x1f\x57\x23\x79\xad\x56\x44\x6a\x7c\x15\x25\x6d\x06\xad\xbd\x74\x00\xa6\xb1\xae\x4b\x05\xa2\xe1\x31\x1b\x41\x88\x9d\x15\xb8\xaa\xfa\x40\xbc\x93\x8f\xf4\xdb\xd2\xa3\xd1\xf6\xf2\xbd\x69\x9e\x2f\x51\x4d\x6c\x35\xf1\x55\x4f\x48\xcc\x84\x51\xd1\xc4\xc9\x44\x77\x17\xa1\xdb\x15\x71\x76\xc0\x12\x4b\x4f\xff\x4f\x0f\x1d\x78\x2f\x5a\xf0\x7f\x69\x62\x5b\xa5\xf0\xa0\x3f\x69\x85\x07\x5d\x2a\xf7\xbc\x13\x24\xed\xf1\x44\xe0\xc9\xe6\x1e\x6f\x62\xa8\xd1\x77\x0e\x64\x50\x2b\xf0\x7c\x74\xec\x3d\x9a\x6f\xa6\x27\xd7\xf3\xe9\xa8\x3c\x3e\x29\x7e\x2a\x9c\xd5\xfa\x5f\x0a\x9e\xd8\xd0\x6f\x93\xff\xa1\xf3\x77\xa7\xf3\x78\x17\xa4\xbb\x21\x7d\x00\x7f\x05\x00\x00\xff\xff\x5e\xac\xc1\x72\x11\x07\x00\x00")
+var _loginHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x55\x51\x6f\xd3\x30\x10\x7e\xef\xaf\x30\x7e\xe9\xcb\x12\x0f\x89\x69\xa8\x4b\x8a\xa6\xc1\xc3\x24\xa4\x4d\x30\x84\x26\x40\xc8\x4b\x2e\x89\x3b\xc7\x0e\xf6\xb5\x5d\x04\xfc\x77\xce\xce\x3a\xba\x8a\x8d\x8d\x8e\x48\x49\xec\xf3\x77\xdf\xf9\xbe\x9c\x2f\xd9\xb3\xd7\x27\x47\x67\xe7\xa7\x6f\x58\x83\xad\x9e\x8e\xb2\xf0\x62\x5a\x9a\x3a\xe7\x60\x78\x30\x80\x2c\xa7\x23\x46\x57\xd6\x02\x4a\xc2\x61\x97\xc0\xb7\xb9\x5a\xe4\xfc\xc8\x1a\x04\x83\xc9\x59\xdf\x01\x67\xc5\x30\xcb\x39\xc2\x15\x8a\x40\x74\xc0\x8a\x46\x3a\x0f\x98\xcf\xb1\x4a\x5e\x72\xb1\x4e\x64\x64\x0b\x39\x5f\x28\x58\x76\xd6\xe1\x9a\xfb\x52\x95\xd8\xe4\x25\x2c\x54\x01\x49\x9c\xec\x30\x65\x14\x2a\xa9\x13\x5f\x48\x0d\xf9\xf3\x74\x97\x5f\x53\xa1\x42\x0d\xd3\xb7\xb6\x26\x08\x4b\xd8\x31\x8e\x3d\x3b\xb7\x73\x76\x62\xb4\x32\x90\x89\x61\x7d\x34\x80\xc9\x74\xc9\x1c\xe8\x7c\xec\xb1\xd7\xe0\x1b\x00\x64\x9d\x83\x0a\xb0\x68\xc6\x11\x33\x5c\x0d\xd9\xf2\x71\x48\xd5\x4f\x84\x90\x33\x79\x95\xd6\xd6\xd6\x1a\x64\xa7\x7c\x5a\xd8\x36\xda\x84\x56\x17\x5e\x90\x56\x73\x2d\xdd\xd7\x56\x22\x38\xda\xa3\xa0\xdd\xa5\xfb\x2b\x73\xb2\x32\xa7\xad\x32\x69\xe1\xfd\x78\xba\xb1\x17\xfe\x7b\x2f\x7c\x08\xcc\xa5\x27\xcd\xbc\x20\xb4\x88\x8b\xc1\xef\x46\xbc\x07\x3a\x56\x24\x67\x22\x97\xe0\x6d\x0b\xab\xd8\xb7\x39\x6e\x27\x19\xf0\x7e\x33\x4b\xf2\x79\x55\xc9\x56\xe9\x3e\xff\x08\x5a\x57\x9a\x62\xfd\x38\x74\x0b\x3b\x79\xb1\xbb\xbb\xb3\x4f\x37\xbd\x15\x4a\xad\x8a\x30\x1b\x46\xe3\x4d\x89\xd7\x95\x45\x2a\x95\x7c\x1c\x2b\x64\x4d\x8c\x88\x9d\xde\xc0\x3e\x99\xfa\xf3\xa4\xd0\x56\x5e\x7e\xd9\x09\x93\x64\x35\x4e\x57\x63\xf6\x7d\x8d\x93\xb1\x52\xf9\x4e\xcb\x7e\xc2\x8c\x35\x70\x70\xb3\xf4\x73\x60\x17\xd7\xf4\x99\x18\x8a\x79\x94\x5d\xd8\xb2\x67\xc4\x25\xbb\x2e\xe7\xda\xd6\xca\x1c\x76\x1d\x0f\x16\x8f\x4e\x15\x98\x94\x8a\xe0\x0a\x7d\xd2\xdb\x79\x62\x63\x2d\x25\xc1\x19\x1c\x8b\xf0\x69\x26\xfe\xb8\x1a\xc8\x4b\xb5\x60\x85\xa6\x4f\x91\xf3\x6a\xae\xb5\x2f\x1c\x80\xa9\xac\x6b\x63\x80\x50\xf0\xe4\x4d\x20\xc2\x8e\x32\x5a\x55\x1d\x32\xef\x8a\x47\xd6\xdb\xcc\x53\xa1\xed\xa5\x7b\xab\x79\x3a\x23\x35\x29\xd5\xc8\x37\x7d\x42\xe2\x44\x1a\x15\x8a\x38\x16\xd1\xdd\x41\xf8\x76\x41\x9c\x9d\x53\x88\x99\xe7\xff\x27\x87\x16\xbc\x97\x35\xf8\xbf\x24\xb1\xad\x52\x74\xd0\x9f\x34\xc2\x83\x9a\xca\x3d\xdf\x04\x1b\xe5\xca\x4e\x3a\xec\xaf\x3b\xc3\x87\x77\xc7\xf7\x88\xcc\x69\x13\x1d\x9d\x20\xea\x04\xc2\x53\xe7\x86\x92\xea\xd4\x41\x81\x6a\x01\x5e\x0c\x15\xfe\x40\xf7\x78\x4a\xc4\xea\x68\x3d\xde\x29\xfc\x5a\x9c\xd5\xfa\x91\x01\xe9\x98\xd5\x16\x4f\x29\xdb\xa5\x75\xe5\x3f\x92\x38\x20\xad\xb6\xe4\x88\xcf\x33\x8b\xdd\x36\xfe\xef\x5b\x7f\xb7\xbb\x08\x4d\x2c\x36\xb5\xf8\xe7\xfe\x05\x2a\xde\xd4\xe0\xca\x07\x00\x00")
 
 func loginHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -129,12 +130,12 @@ func loginHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login.html", size: 1809, mode: os.FileMode(436), modTime: time.Unix(1464871448, 0)}
+	info := bindataFileInfo{name: "login.html", size: 1994, mode: os.FileMode(436), modTime: time.Unix(1792320277, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _baseHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x56\xdf\x6f\xd3\x30\x10\x7e\xdf\x5f\x61\xf2\xc2\xcb\x12\x0f\x89\x69\xa8\x4b\x8a\xa6\x8d\x87\x3d\x0d\xc1\x10\x9a\x00\x21\x2f\xbd\xa6\xde\x1c\xdb\xf8\x2e\xdd\xc2\x8f\xff\x9d\x73\xd2\x4c\xdd\x80\x6a\x41\x6b\xa4\x2a\xf6\xf9\xbe\xef\xb3\x2f\x9f\xed\xe6\xcf\x4e\xce\x8e\xcf\x2f\xde\xbe\x11\x0b\xaa\xcd\x74\x27\x8f\x2f\x61\x94\xad\x8a\x04\x6c\x22\x6c\x95\x2a\xef\x8b\x44\x13\xb6\xae\x71\xd6\x68\x0b\x47\xde\x77\x03\xa5\x71\xea\x3a\x42\x40\xcd\xa6\x3b\x82\x9f\xbc\x06\x52\xcc\x44\x3e\x85\x6f\x8d\x5e\x16\xc9\xb1\xb3\x04\x96\xd2\xf3\xd6\x43\x22\xca\xbe\x57\x24\x04\xb7\x24\xa3\xd4\xa1\x28\x17\x2a\x20\x50\xd1\xd0\x3c\x7d\x95\xc8\x15\x11\x69\x32\x20\xbc\xaa\xa0\x6b\x4d\x4f\xe9\x39\x8a\x0b\xd7\x88\xb3\x6e\x0a\xb9\xec\xc3\x7d\x32\x47\xae\x45\x00\x53\x24\x48\xad\x01\x5c\x00\x90\xf0\x01\xe6\x40\xe5\x22\xe9\x72\xfa\x67\xc1\xb1\x22\x89\xf3\xc3\x89\x94\xea\x4a\xdd\x66\x95\x73\x95\x01\xe5\x35\x66\xa5\xab\xbb\x98\x34\xfa\x12\x25\x97\xa0\x31\x2a\x7c\xad\x15\x41\xd0\xca\xc8\x17\xd9\x5e\x76\x30\x84\xd3\x21\x9c\xd5\xda\x66\x25\x62\xb2\x61\x2e\xc9\x4a\x58\x21\x2f\x14\x25\x67\xcb\x6e\xb0\xc3\xc9\x71\xc0\x39\x97\x30\x55\x37\x80\xae\x86\x3b\xed\x7b\x1c\xf7\x17\x19\xf3\xf1\xe1\x2a\x19\xf3\x7a\xae\x6a\x6d\xda\xe2\x23\x18\x33\x37\xac\xf5\xf3\x28\x2c\xdd\xe4\xe5\xde\xde\xee\x01\xff\xf8\xad\x49\x19\x5d\xc6\x5e\xdf\x4a\xfe\x98\xdd\x5a\x65\x89\xbf\xef\xea\xb3\xae\x15\xa3\xcb\x9d\xde\xa5\x7d\xb2\xd5\xe7\x49\xe7\x9a\x2f\xbb\xb1\x93\x0e\xed\x6c\x68\x8b\x1f\x6b\x9c\x42\xcc\x34\x7a\xa3\xda\x89\xb0\xce\xc2\xe1\xdd\xd0\xaf\x9e\x5d\xae\xe8\x73\xd9\x3b\x70\x27\xbf\x74\xb3\x36\xbe\xd9\xad\x29\xdb\x35\xed\xfd\x9a\xc6\x61\x08\xd3\x5c\xfe\x3d\xce\x80\x99\x5e\x46\x47\x2f\x35\xdc\x70\x1a\xf7\x62\x10\xcb\xa0\x3d\x09\x0c\xe5\x48\xcf\x5c\x21\x9b\x65\x3f\xdb\x1f\xfa\xd9\x15\x57\x84\xa7\xdb\xf1\x4d\x9f\x90\x38\x0d\xae\x21\xd8\x1e\x7d\x0d\x88\xbc\x05\xb1\x33\xda\xd6\x54\x94\xd5\x71\x37\x6d\x59\x84\x37\xeb\x76\x15\x4a\xe7\xae\xf5\x13\xd7\xea\x51\xa7\xcf\x06\x31\x26\xf5\xbc\x75\xf8\x08\x90\xc8\xe7\x2c\xcc\xd8\xdb\x01\x4a\xd2\x4b\x40\xd9\xdb\x7f\x13\x9c\x16\x3a\xcc\xbc\x0a\xd4\xae\x4e\xa0\x0f\xef\x4e\xc7\xc9\xf1\x89\x3f\xd7\xd5\x7b\x08\x4b\x5d\x6e\x34\xea\x1a\x94\x2f\x9c\x47\x66\x36\x08\x61\x7d\x45\xaa\xa1\x85\x0b\xfa\xbb\x22\xed\xec\x09\xdf\x45\xda\xe0\xc9\x30\x3c\x86\x73\x20\x82\x78\x7f\x05\x67\xcc\xe6\x3a\x3d\x84\x97\xff\x85\xc2\x51\x55\x72\xa1\xe2\x8d\xd3\x2f\x75\xbc\xe0\x3d\xf4\x38\xe1\xd8\x54\xb6\x1d\xaf\x39\x00\xff\x21\x27\xfb\xd3\x3b\x97\xfd\x3f\x91\xdf\x01\x00\x00\xff\xff\x32\xc6\x8b\x3c\x9a\x08\x00\x00")
+var _baseHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x56\x4d\x4f\xdc\x30\x10\xbd\xf3\x2b\x5c\x5f\x7a\x21\x31\x95\x8a\xa8\x96\x64\xab\x0a\x7a\xe0\x44\xd5\x52\x55\xa8\xad\x2a\x93\x9d\x24\x06\xc7\x4e\xed\xc9\x42\x68\xfb\xdf\x3b\x76\x36\xab\xa5\x1f\x2b\x82\x20\xd2\xca\xf6\x78\xde\x7b\xf6\x78\xc6\xde\xec\xd9\xf1\xe9\xd1\xd9\xf9\xbb\xb7\xac\xc6\x46\xcf\x77\xb2\xd0\x30\x2d\x4d\x95\x73\x30\x9c\x99\x2a\x29\xb4\x95\x57\x61\x06\xe4\x62\xbe\xc3\xe8\xcb\x1a\x40\x49\x00\x6c\x13\xf8\xde\xa9\x65\xce\x8f\xac\x41\x30\x98\x9c\xf5\x2d\x70\x56\x0c\xa3\x9c\x23\xdc\xa0\x08\x8c\x87\xac\xa8\xa5\xf3\x80\x79\x87\x65\xf2\x8a\x8b\x15\x11\x2a\xd4\xc0\x5a\x59\x41\xec\xcd\x4f\xf0\xb9\x67\xe7\xb6\x63\xa7\x46\x2b\x03\x99\x18\xcc\x83\x33\x59\xae\x98\x03\x9d\x73\x8f\xbd\x06\x5f\x03\x20\x6b\x1d\x94\x80\x45\xcd\xa3\xcf\xf0\xd5\x64\xcb\x79\x58\x9f\x9f\x09\x21\x2f\xe5\x4d\x5a\x59\x5b\x69\x90\xad\xf2\x69\x61\x9b\x68\x13\x5a\x5d\x78\x41\x3b\xed\xb4\x74\xdf\x1a\x89\xe0\x94\xd4\xe2\x45\xba\x97\x1e\x8c\xe6\x64\x34\xa7\x8d\x32\x69\xe1\x3d\xdf\xb2\x16\xbe\x12\x96\x9e\x36\xea\x05\x79\x8b\x38\x19\x71\x62\x1a\xb0\xa4\x10\x26\xf2\x1a\xbc\x6d\x60\xad\x7d\x87\xe3\xee\x26\x83\xbf\xff\x73\x97\x84\x79\x5d\xca\x46\xe9\x3e\xff\x04\x5a\x97\x9a\xb4\x7e\xbe\x71\x4b\x3b\x7b\xb9\xb7\xb7\x7b\x40\x3f\x6a\x15\x4a\xad\x8a\x30\x1a\x7a\xfc\xaf\xd5\x6d\x44\x16\xe9\x7c\x57\xc7\xba\x11\x8c\xe8\x3b\x5f\xbb\x7d\x36\xd5\x97\x59\xcc\x9a\xaf\xbb\x61\x90\x8c\xfd\x74\xec\xb3\x1f\x1b\x9c\x8c\x2d\x94\x6f\xb5\xec\x67\xcc\x58\x03\x87\xeb\xa9\x5f\x03\xbb\x58\xd1\x67\x62\xc8\xc0\x9d\xec\xc2\x2e\x7a\x56\x68\x0a\x17\x05\x80\x02\xc4\x83\x51\xa1\x4f\x7a\xdb\x25\x36\x66\x4e\x12\x7c\xc1\xcd\x33\xf1\x6f\x3b\x01\x16\x6a\x19\xd2\x7b\xa9\xe0\x9a\xdc\x68\x14\x8c\xbe\x70\xaa\x45\xe6\x5d\x31\x31\x81\x2e\x3d\x65\xce\x7e\xba\x3f\x8e\xd3\x4b\x0a\x0f\xad\x3d\xf2\xcd\x1f\x91\x38\x71\xb6\x43\x78\x3a\xfa\x06\xbc\xa7\x7a\xf4\x31\xeb\x9e\x4c\x45\x1a\x15\x4a\xeb\x89\x45\xa8\x72\x1f\x55\xe1\x5e\xb7\xc4\x16\x31\x22\x6d\x29\xc5\xa9\x54\x85\xa7\xfb\x10\x16\x94\x76\x0e\x0a\x54\x4b\xf0\x62\xc8\xcc\x07\xc3\x4b\x6b\x71\x3b\x1c\x6b\xe5\x16\xad\x74\xd8\xaf\x2e\x9a\x8f\xef\x4f\xa6\xc9\x0d\xcd\x34\x0c\x3d\x06\xa5\xaa\x3e\x80\x5b\xaa\x62\x6b\xda\x6e\x40\x65\xdb\xde\xd3\xb3\xf3\xe0\x36\xa3\x20\x3b\xac\xad\x53\xb7\x12\x95\x35\xc7\xf4\x4c\x29\xed\x8f\xc7\xe9\x29\x9c\x23\x11\x84\xa7\xcd\x59\xad\xef\x7d\x34\x11\x5e\x3c\x08\xe5\x27\x45\xc9\xba\x8a\xca\x68\xd8\xea\x74\xc1\x3b\xe8\x69\xc2\xa1\x2b\x4d\x3f\x5d\x73\x04\xfe\x47\x4e\x84\x8b\x3d\x5e\xf4\xf1\xbf\xc8\x6f\xf1\x31\x92\x07\x9c\x08\x00\x00")
 
 func baseHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,12 +150,12 @@ func baseHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "base.html", size: 2202, mode: os.FileMode(436), modTime: time.Unix(1464872557, 0)}
+	info := bindataFileInfo{name: "base.html", size: 2204, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _errorHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\xdf\x6b\xdb\x30\x10\x7e\x1f\xec\x7f\xb8\x6a\x0f\x7e\xa9\xa3\xac\xdb\xe8\xe8\xec\x8c\xd2\x96\x11\x18\xb4\x2c\x81\xd1\xa7\xa2\xd8\x17\x5b\x54\x96\x5c\xeb\xdc\x2e\xb0\x3f\x7e\xe7\x5f\x6d\x9c\x74\xb4\x83\x3d\x18\x9d\x74\xdf\x7d\xf7\xf9\x93\xcf\xd1\xc1\xf9\xe5\xd9\xf2\xfa\xea\x02\x72\x2a\xcc\xec\xed\x9b\xa8\x59\xc1\x28\x9b\xc5\x02\xad\x68\x4f\x50\xa5\xbc\x02\x44\x05\x92\x62\x20\x95\x21\xde\xd5\xfa\x3e\x16\x67\xce\x12\x5a\x0a\x97\x9b\x12\x05\x24\xdd\x2e\x16\x84\xbf\x48\x36\x44\x5f\x20\xc9\x55\xe5\x91\xe2\x9a\xd6\xe1\x67\x01\xb2\x23\x22\x4d\x06\x67\x73\x0a\x3c\x5c\xbb\x1a\x2e\xad\xd1\x16\x23\xd9\x1d\xb7\x08\x3e\xb8\x85\x0a\x4d\x2c\x3c\x6d\x0c\xfa\x1c\x91\x04\xe4\x15\xae\x63\xa1\x3c\x33\x7a\x99\x78\x2f\xdb\xe4\x84\x23\x21\xff\xa9\x6e\xcd\x52\x43\xf5\x80\xde\x15\x38\x29\xb4\xdd\xa3\x68\x2b\x82\xe6\x5d\xfd\x89\x6c\xe1\x7e\x92\x39\x97\x19\x54\xa5\xf6\x93\xc4\x15\x0d\xcf\xd7\xb5\x2a\xb4\xd9\xc4\x3f\xd1\x98\xb5\xe1\x56\xbf\x4f\xab\x7b\x77\xf2\x71\x3a\x3d\x3c\xe6\x87\x57\x4d\xca\xe8\xa4\xd9\x75\x51\xd0\x8a\x0b\x9e\xc4\x05\x40\x6c\x5e\x1c\xb4\x9e\x31\x65\xd0\x58\x2e\x7b\xcf\xa3\x95\x4b\x37\xc3\x1d\x60\x05\x3a\x8d\x45\x17\x8a\x4e\x6b\xaa\xef\x21\x31\xfc\x62\xb1\xe8\xdd\xef\x12\x7d\xaa\xc1\x1b\x97\x39\x31\x8b\x54\x6f\xc2\x44\x8a\x19\xcc\xc9\xb3\xef\x93\xce\x77\x88\xa4\x9a\x45\x92\xf1\x1d\x67\x1f\x75\x22\xb0\xe2\x90\x37\x07\x61\x08\x8b\xef\xf3\xf3\x8b\x05\x2c\x96\xa7\x3f\x96\x10\x86\x0d\x66\x68\xe2\x8d\x4e\xf1\xfd\xcb\xa2\xf2\xa3\xd9\xa7\xe9\x94\xa9\x8f\xb6\x4e\x2e\x5d\xe9\x0f\x81\x72\xed\xe1\x41\x79\xb0\x8e\xc0\xd7\x65\xe9\x3c\xa6\x40\x0e\x72\x55\x96\x68\x1f\x6b\x9e\xf4\x3d\x2a\x1e\xa9\x38\x1e\x39\xf0\xbc\x8e\x71\xf2\xae\x76\x84\xfe\xa6\xc1\x28\xf6\xa3\x7a\x42\x8d\x71\xab\x9a\xc8\xd9\x30\x61\x22\xbe\x8b\xe6\xc2\xfa\x78\xbb\x60\x5c\x92\xba\x64\x9c\xe4\xb4\x02\x52\x55\xc6\x33\x21\x6e\x56\x3c\x68\xb7\xc3\xe7\xf9\x6e\x17\x09\x70\xee\x92\xba\xe0\x16\x8a\xb4\xb3\x3b\x34\x7c\x69\xa3\xae\x8f\x76\xfc\x45\x07\xf0\x73\xf4\x6a\x31\xfc\x95\xa7\xdb\xcd\xf7\xb5\x9d\x5e\xcd\xff\xbb\xbe\x0f\xaf\xd6\x37\x4c\x66\xa6\x29\xaf\x57\xed\x3c\x6a\xf2\x1b\x57\xbb\xf6\x9b\xde\x97\x1b\xf9\x52\xd9\xa1\x5d\x57\x15\x36\x93\xbe\x8f\x04\x58\xb8\xba\x4a\x90\xff\x67\x29\x82\xb3\xf0\xad\x45\xef\x11\xca\x86\x71\x57\xef\x0b\x6f\x3d\xde\x6f\xef\x9e\x1b\xc0\x61\x1d\xfe\x02\xb2\xff\x49\xff\x09\x00\x00\xff\xff\x1c\x94\x6c\xce\xb6\x05\x00\x00")
+var _errorHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x54\x4d\x6b\xdc\x30\x10\xbd\x17\xfa\x1f\x26\xba\xf8\x52\x5b\xdb\xb4\x25\x25\xb5\xb7\x84\x24\x94\x85\x42\x42\x77\xa1\xe4\x14\xb4\xb6\xd6\x16\x91\x35\x8e\x35\x4e\xba\xd0\x1f\xdf\xf1\x57\xb2\xce\xa6\xa4\x2d\x3d\x18\x7d\xcc\x9b\xa7\x37\x4f\x1e\xc5\x07\x67\x17\xa7\xab\xab\xcb\x73\x28\xa8\xb4\xf3\xd7\xaf\xe2\x76\x04\xab\x5c\x9e\x08\xed\x44\xb7\xa3\x55\xc6\x23\x40\x5c\x6a\x52\x0c\xa4\x2a\xd4\xb7\x8d\xb9\x4b\xc4\x29\x3a\xd2\x8e\xc2\xd5\xb6\xd2\x02\xd2\x7e\x95\x08\xd2\x3f\x48\xb6\x44\x9f\x20\x2d\x54\xed\x35\x25\x0d\x6d\xc2\x8f\x02\x64\x4f\x44\x86\xac\x9e\x2f\x28\xf0\x70\x85\x0d\x5c\x38\x6b\x9c\x8e\x65\xbf\xdd\x21\x78\xe3\x06\x6a\x6d\x13\xe1\x69\x6b\xb5\x2f\xb4\x26\x01\x45\xad\x37\x89\x50\x9e\x19\xbd\x4c\xbd\x97\x5d\x30\xe2\x99\x90\x7f\x95\xb7\x61\xa9\xa1\xba\xd7\x1e\x4b\x1d\x95\xc6\xed\x51\x74\x19\x41\x5b\xab\x3f\x96\x1d\xdc\x47\x39\x62\x6e\xb5\xaa\x8c\x8f\x52\x2c\x5b\x9e\xcf\x1b\x55\x1a\xbb\x4d\xbe\x6b\x6b\x37\x96\x8f\xfa\x79\x52\xdf\xe1\xf1\xfb\xd9\xec\xcd\x11\x7f\x3c\x1a\x52\xd6\xa4\xed\xaa\x9f\x05\x9d\xb8\xe0\x51\x5c\x00\xc4\xe6\x25\x41\xe7\x19\x53\x06\xad\xe5\x72\xf0\x3c\x5e\x63\xb6\x1d\xef\x40\xd7\x60\xb2\x44\xf4\x53\xd1\x6b\xcd\xcc\x1d\xa4\x96\x0b\x4b\xc4\xe0\x7e\x1f\x18\x42\x2d\xde\x62\x8e\x62\x1e\xab\xc1\x84\x48\x8a\x39\x2c\xc8\xb3\xef\x51\xef\x3b\xc4\x52\xcd\x63\xc9\xf8\x9e\x73\x98\xf5\x22\x74\xcd\x53\x5e\x1c\x84\x21\x2c\xbf\x2e\xce\xce\x97\xb0\x5c\x9d\x7c\x5b\x41\x18\xb6\x98\xf1\x10\x6f\x4d\xa6\xdf\xbe\x2c\xaa\x38\x9c\x7f\x98\xcd\x98\xfa\x70\x67\xe7\x02\x2b\xff\x06\xa8\x30\x1e\xee\x95\x07\x87\x04\xbe\xa9\x2a\xf4\x3a\x03\x42\x28\x54\x55\x69\xf7\x90\xf3\xa8\xef\x41\xf1\x44\xc5\xd1\xc4\x81\xe7\x75\x4c\x83\xb7\x0d\x92\xf6\xd7\x2d\x46\xb1\x1f\xf5\x23\x6a\x8a\x5b\x37\x44\xe8\xc2\x94\x89\xf8\x2e\xda\x0b\x1b\xe6\xbb\x09\xd3\x94\x0c\xd3\x69\x90\xc3\x0a\x48\xd5\x39\xf7\x84\xb8\x5e\x73\xa3\xdd\x8c\xbf\xe7\xf8\xb3\xe5\x26\x8f\x72\x43\x6b\xc4\x1b\x1f\x19\x94\x86\xfc\x16\x1b\xec\xee\x4a\x0e\x85\x48\x26\xf6\xd2\x38\xaa\x31\x6b\x52\x32\xe8\xa2\xb6\xdf\x9e\x9e\x05\x70\x86\x69\x53\x72\x82\x6a\x31\x4f\x84\xf0\xb5\x4f\x74\x3f\x18\xfa\x9b\x4a\x80\xbf\xc3\x3f\x2e\x87\xfb\x24\xdb\x3d\x7c\x5f\xdb\xc9\xe5\xe2\xbf\xeb\x7b\xf7\x0f\x76\x53\xd1\xac\xbb\x8e\xde\x75\x7a\x5f\x6e\xec\x2b\xe5\xc6\xe3\xfa\xac\xb0\x7d\x2b\xf6\x91\x00\x4b\x6c\xea\x54\xf3\x8b\x98\x69\x40\x07\x5f\x3a\xf4\x1e\xa1\x6c\x19\x9f\xea\x7d\xa1\xea\xe9\x7a\x77\xf5\x5c\x0b\x8f\xe3\xf8\x8e\xc8\xe1\x99\xff\x05\xb8\x7d\xf1\x85\xf8\x05\x00\x00")
 
 func errorHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +170,7 @@ func errorHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "error.html", size: 1462, mode: os.FileMode(436), modTime: time.Unix(1460547626, 0)}
+	info := bindataFileInfo{name: "error.html", size: 1528, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -194,7 +195,7 @@ func apidocumentationHtml() (*asset, error) {
 	return a, nil
 }
 
-var _smsconfirmationHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\xcd\x6e\x9c\x30\x10\xbe\xef\x53\xb8\x8e\xa2\x6d\xa5\x82\x37\x95\x2a\x55\x04\x73\x49\x7b\xc8\x29\x3d\xe4\x92\xa3\x17\x06\x98\xc6\xd8\xd4\x1e\x76\xb3\x5d\xed\xbb\xd7\xfc\x34\x81\x55\x52\x55\xf5\x01\x86\xf9\xfb\xbe\x99\xf1\x90\xbe\xfb\x7a\x77\x73\xff\xf0\xfd\x1b\xab\xa9\xd1\xd9\x2a\xed\x5f\x4c\x2b\x53\x49\x0e\x86\xf7\x0a\x50\x45\xb6\x62\xe1\xa4\x0d\x90\x0a\x7e\xd4\x46\xf0\xb3\xc3\x9d\xe4\x37\xd6\x10\x18\x8a\xee\x0f\x2d\x70\x96\x8f\x5f\x92\x13\x3c\x91\xe8\x13\x5d\xb3\xbc\x56\xce\x03\xc9\x8e\xca\xe8\x0b\x17\xf3\x44\x46\x35\x20\xf9\x0e\x61\xdf\x5a\x47\xb3\xf0\x3d\x16\x54\xcb\x02\x76\x98\x43\x34\x7c\x7c\x64\x68\x90\x50\xe9\xc8\xe7\x4a\x83\xbc\x8a\x37\x7c\x4a\x45\x48\x1a\xb2\x5b\x5a\x7b\xf6\x60\x3b\x76\x67\x34\x1a\x48\xc5\xa8\x1e\x5d\x82\xe6\x91\x39\xd0\x92\x7b\x3a\x68\xf0\x35\x40\x80\xab\x1d\x94\x92\x2b\x1f\xd8\x79\x91\x7b\x2f\x06\x63\x1c\xa4\x67\x9a\xff\x18\x58\x06\xe2\x91\xda\x83\xb7\x0d\xc4\x0d\x9a\x45\x8e\x21\x72\x94\xfb\x13\x87\x2a\x4b\x74\x8d\x22\xb4\x26\x6a\x55\x05\xec\xf8\x6c\xec\xcf\x50\x6f\xc2\xae\x36\x9b\xcb\xeb\x85\x61\xab\xf2\xc7\xca\xd9\xce\x14\x09\xeb\x9c\x7e\xbf\x9e\x18\x60\x53\x89\x6d\x15\xb7\xa6\x5a\x7f\x58\x46\xe4\x56\x5b\x97\xb0\x8b\xb2\x2c\x97\x86\x1a\xb0\xaa\x69\x00\xd9\xd5\x4b\x53\xa3\x5c\x85\x26\x61\x9b\x17\xf5\x69\xf5\x37\xf6\xbd\x8a\x54\xe8\xb9\x3b\x2b\xa4\x40\xdf\x6a\x75\x48\x58\xa9\xe1\x69\x09\xf2\xa3\xf3\x84\xe5\x21\x9a\x26\x9e\xb0\x3c\x3c\xc1\x2d\x9d\x94\xc6\xca\x44\x48\xd0\xf8\xd7\x1d\xde\xec\xd4\xac\xbc\xcb\xff\x28\xa3\x3d\x2b\x64\x18\xaf\xc7\x5f\x90\xb0\x4f\xf1\x67\x68\x5e\x6f\xf2\x66\x38\x73\xb8\xfe\x99\x8a\x69\xfe\xa9\x18\xf7\x28\xdd\xda\xe2\x30\x6d\x55\xc0\xc2\x42\xf2\x51\xfc\x73\x9f\x0b\xdc\xb1\x5c\x87\xd9\x4a\x3e\xb5\x87\xbf\xdc\x9e\xc1\xda\xc7\x68\x5b\x59\x9e\xa5\x6a\xba\x8c\x17\x3c\x63\xb7\xe4\xc3\x02\xc4\xe3\x02\x04\x60\x95\xa5\x22\xb8\x4f\x69\x47\x71\x64\x01\x2e\x48\x4b\x9c\x65\x37\xde\xe0\x32\xb4\x67\xce\xa6\xcd\x8e\x47\xd6\x2f\x3b\x3b\x9d\x52\xd1\x9e\x41\x4d\xaf\xa9\x60\x31\xfe\x5f\x7e\x07\x00\x00\xff\xff\x11\x52\x1a\x23\x70\x04\x00\x00")
+var _smsconfirmationHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x54\x4b\x8f\x9b\x30\x10\xbe\xe7\x57\xb8\xae\x56\x69\xa5\x82\x49\xd4\x43\xc5\x02\x3d\x6c\x7b\xd8\xd3\xf6\xb0\xd2\x6a\x8f\x0e\x0c\xc6\x5d\x3f\xa8\x6d\x92\xa0\x28\xff\xbd\x06\xbc\xbb\x21\xdb\x54\x55\x7d\x80\x61\x5e\xdf\xcc\xe7\x19\xb2\x77\xdf\xee\x6e\xee\x1f\x7f\x7c\x47\x8d\x93\xa2\x58\x64\xc3\x0b\x09\xaa\x58\x8e\x41\xe1\x41\x01\xb4\x2a\x16\xc8\x9f\x4c\x82\xa3\xde\xcf\xb5\x11\xfc\xea\xf8\x36\xc7\x37\x5a\x39\x50\x2e\xba\xef\x5b\xc0\xa8\x9c\xbe\x72\xec\x60\xef\xc8\x90\xe8\x1a\x95\x0d\x35\x16\x5c\xde\xb9\x3a\xfa\x82\xc9\x69\x22\x45\x25\xe4\x78\xcb\x61\xd7\x6a\xe3\x4e\xc2\x77\xbc\x72\x4d\x5e\xc1\x96\x97\x10\x8d\x1f\x9f\x10\x57\xdc\x71\x2a\x22\x5b\x52\x01\xf9\x2a\x4e\x70\x48\xe5\xb8\x13\x50\xdc\xba\xa5\x45\x8f\xba\x43\x77\x4a\x70\x05\x19\x99\xd4\x93\x8b\xd7\x3c\x21\x03\x22\xc7\xd6\xf5\x02\x6c\x03\xe0\xe1\x1a\x03\x75\x8e\xa9\xf5\xd5\x59\x52\x5a\x4b\x46\x63\xec\xa5\x97\x32\xff\x31\xb0\xf6\x85\x47\x74\x07\x56\x4b\x88\x25\x57\x6f\x73\x4c\x21\x03\x73\x36\x25\xa3\xbf\x8d\x99\xd6\x4c\x00\x6d\xb9\x8d\x4b\x2d\x87\x44\x5f\x6b\x2a\xb9\xe8\xf3\x07\x10\xa2\x16\x1e\x2b\xfd\x9c\x24\xf8\x6d\x01\xce\xb3\x1d\x48\x1e\x90\x02\xd0\xe8\x31\xc9\xc3\xf1\x49\x55\xcd\x8d\xa4\x8e\x6b\x15\xb5\x94\x01\x3a\xbc\x18\x87\x33\x12\x9b\xa2\x55\x92\x5c\x5d\xcf\x0c\x1b\x5a\x3e\x31\xa3\x3b\x55\xa5\xa8\x33\xe2\xc3\x32\xb4\xca\x25\x23\x1b\x16\xb7\x8a\x2d\x3f\xce\x23\x4a\x2d\xb4\x49\xd1\xfb\xba\xae\xe7\x86\x06\x38\x6b\xdc\x08\xb2\x6d\xe6\x26\x49\x0d\xe3\x2a\x45\xc9\xab\xfa\xb8\xf8\x5b\xf5\x83\xca\x51\x7f\xb9\xe6\xac\x91\x8a\xdb\x56\xd0\x3e\x45\x9e\xb3\xfd\x1c\xe4\x67\x67\x1d\xaf\xfb\x28\x8c\x56\x8a\x4a\xff\x04\x33\x77\xa2\x82\x33\x15\x71\x07\xd2\xfe\xd9\xe1\x22\x53\x27\xed\x5d\xfd\x47\x1b\xcd\xfa\xac\x93\x67\x1e\x93\xf1\x5c\xe0\x0b\xad\x93\x76\x7f\x8a\x36\x3c\x33\x12\xae\x3f\x23\xd3\xbe\x66\x1b\x5d\xf5\x61\x7b\x3d\x14\xaf\xfc\xf8\x8d\xe2\xf3\xb8\x54\x7c\x8b\x4a\xe1\xaf\x36\xc7\x81\x1d\xfc\x3a\x3c\xa3\x75\x88\x11\x9a\x69\x5c\x64\x34\x4c\x30\xc1\x05\xba\x75\xd6\x2f\x5a\x3c\x2d\x9a\x07\xa6\x45\x46\xbc\x7b\x48\x3b\x89\x53\x15\x60\xbc\x34\xc7\x99\x93\x71\xa1\x96\x91\x9d\xd3\x6a\x3c\x51\xc1\x2c\xab\x28\x5c\x77\xb4\xc2\xc5\xe1\x80\x86\x2d\x40\xc7\xa3\x07\x5c\x9f\x55\x10\x5e\x81\x07\x32\xfd\xde\x7e\x03\x6b\xec\xe6\x0e\xef\x04\x00\x00")
 
 func smsconfirmationHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +210,27 @@ func smsconfirmationHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "smsconfirmation.html", size: 1136, mode: os.FileMode(436), modTime: time.Unix(1464618696, 0)}
+	info := bindataFileInfo{name: "smsconfirmation.html", size: 1263, mode: os.FileMode(436), modTime: time.Unix(1792322337, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _deviceHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x55\x3d\x6f\xdb\x30\x10\xdd\xf3\x2b\x58\x16\x41\x5a\xa0\x12\x15\xa3\x43\xe1\x48\xea\x90\x74\xc8\x94\x0e\x05\x8a\x4c\x01\x2d\x9d\x24\x36\x14\xa9\x92\x94\x12\x37\xc8\x7f\xef\x51\x62\x6c\xcb\x8e\xd1\x0f\x0d\x34\x7d\x3c\xbe\x77\x77\x7c\x3c\xa6\x6f\xae\x6e\x2e\xbf\xdd\x7e\xfd\x42\x1a\xd7\xca\xfc\x24\xf5\x3f\x44\x72\x55\x67\x14\x14\xf5\x06\xe0\x65\x7e\x42\xf0\x4b\x5b\x70\x1c\xfd\x5c\x17\xc1\xcf\x5e\x0c\x19\xbd\xd4\xca\x81\x72\xd1\xb7\x75\x07\x94\x14\xd3\xbf\x8c\x3a\x78\x74\xcc\x03\x5d\x90\xa2\xe1\xc6\x82\xcb\x7a\x57\x45\x9f\x28\xdb\x05\x52\xbc\x85\x8c\x0e\x02\x1e\x3a\x6d\xdc\xce\xf6\x07\x51\xba\x26\x2b\x61\x10\x05\x44\xe3\x9f\x0f\x44\x28\xe1\x04\x97\x91\x2d\xb8\x84\xec\x3c\x4e\x68\x80\x72\xc2\x49\xc8\xaf\xdd\x99\x25\xb7\xba\x27\x37\x4a\x0a\x05\x29\x9b\xcc\x93\x0b\x5a\xee\x89\x01\x99\x51\xeb\xd6\x12\x6c\x03\x80\x74\x8d\x81\x2a\xa3\xdc\x62\x74\x96\x15\xd6\xb2\x71\x31\xc6\xd9\x26\xcc\xbf\xdc\x58\x61\xe0\x11\x7f\x00\xab\x5b\x88\x5b\xa1\x0e\x31\xa6\x2d\xbe\x72\x76\xc9\x46\x7f\x1b\xd7\x5a\xd7\x12\x78\x27\x6c\x5c\xe8\xd6\x03\x7d\xae\x78\x2b\xe4\x3a\xfb\x0e\x52\x56\x12\xb9\x96\x1f\x93\x84\x1e\x06\xe0\xb0\xda\xa1\xc8\x9e\x29\x10\x8d\x1e\xd3\xdc\x7f\x71\xa8\x5f\xc7\x6b\x20\x4f\x1b\xb3\xff\xc6\x92\x2e\xc9\x79\x92\x9c\x5e\xcc\x16\x56\xbc\xb8\xaf\x8d\xee\x55\xb9\x24\xbd\x91\xef\xce\x42\x92\xa2\xad\xd9\xaa\x8e\x3b\x55\x9f\xbd\x9f\xef\x68\x40\xd4\x8d\x1b\xb1\x86\x66\xbe\xd4\x72\x53\x0b\xb5\x24\xc9\xd6\xfc\x7c\xf2\x7a\x78\xb1\x3f\x7a\x8e\xe7\x66\xf6\x22\x2d\x85\xed\x24\x5f\x2f\x09\x96\xe3\x71\x0e\xef\x2d\x51\x29\x0c\x14\x4e\x68\xa4\x29\xb4\xec\x5b\x35\xf7\xf9\xd1\x5b\x27\xaa\x75\x14\x94\x85\x4e\x38\x82\x99\x3b\x71\x29\x6a\x15\x09\x07\xad\x7d\xdd\xe1\x68\xb9\x76\x92\x3f\xfd\xa7\x24\x9b\xc5\x5e\x9e\x18\xbc\x36\x4b\xf2\x36\x19\xbf\x23\x75\x24\x8b\xa4\x7b\xdc\x0e\x7f\x66\x14\xaa\xeb\xdd\x1e\xd3\xa8\x55\x2b\x7e\xc1\x92\x2c\xa0\x9d\x33\x49\x70\x98\x7c\x64\x3b\x5e\x08\x55\x23\x63\x7c\xe0\xe2\x45\x17\x8d\x15\x7b\xbd\x56\xe3\xba\x33\x5c\xd9\x4a\x9b\x16\x55\xd4\x75\x60\x0a\x6e\xe1\x48\x49\xf7\xf1\xa7\x64\xa3\x95\x76\x4e\xe3\xf6\xfd\x3c\xfd\x98\xb2\xa0\xf4\x94\x4d\xad\x29\x5d\xe9\x72\x1d\x1a\x15\x16\x57\x94\x78\xd3\xc6\xe9\xcb\xcd\x28\xc5\x40\x0a\x89\x5a\xce\x68\x50\x02\xdd\xde\x93\x71\xd5\xef\x91\xba\xd6\x34\x4f\x79\xb8\xac\x8c\xe6\xe4\xda\x59\xec\x29\xf1\xd4\x53\x90\x98\xe7\x29\x43\xf7\x00\x3b\x4d\xa7\x28\xc0\xe0\x6c\x87\x67\xe7\x18\x5e\xa2\xf0\x05\xd9\x0d\x63\x94\x02\x25\xd8\x0a\x1b\x8d\xf4\x9d\xb6\x78\xb5\xf9\xa8\x66\x64\x1f\xce\x99\xe6\xbd\x6b\xd8\x84\xc4\x06\x30\xa8\xe4\xdd\xb8\x51\x44\x01\xad\x2d\xa3\x70\x51\xa2\x73\x9a\x3f\x3d\x8d\xa7\x40\x9e\x9f\x31\xb4\xc5\xce\x86\x49\x0e\xdb\xe6\x41\x43\x07\xee\x2d\x98\xbb\x42\x97\xd8\xc1\x07\x2e\x7b\xb4\x20\x84\x37\x7a\x1b\xc2\x60\x54\xbd\xd3\xd8\xa3\x3a\x14\x08\xae\xea\xaa\x9a\x4c\x95\x2e\x7a\x8b\xed\x09\xdf\x02\x03\x25\x3b\x42\xd5\x88\xb2\xc4\x97\x24\x90\x15\xd6\x54\x77\x4e\xdf\x7b\xcb\x96\xcd\x5b\x47\xa3\xa7\x63\xf3\xc3\xc9\x67\x02\x49\x57\x3d\x4a\x43\x05\x6c\xdb\xaf\x5a\xb1\x49\x64\x2a\xde\x06\x97\x77\x9d\xd1\x03\xd6\xdf\xbf\x52\x42\xf5\xf8\x2a\x4c\x9b\xff\x1b\x11\xf3\xc0\x23\xb8\xc2\xf1\x10\x6a\xa6\x0c\x7f\xd6\x5e\x1a\x41\x21\x41\xa0\x6c\x7a\x62\x7f\x03\xea\x0c\xe8\x3a\x73\x07\x00\x00")
+
+func deviceHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_deviceHtml,
+		"device.html",
+	)
+}
+
+func deviceHtml() (*asset, error) {
+	bytes, err := deviceHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "device.html", size: 1907, mode: os.FileMode(436), modTime: time.Unix(1792322440, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -273,6 +294,7 @@ var _bindata = map[string]func() (*asset, error){
 	"error.html": errorHtml,
 	"apidocumentation.html": apidocumentationHtml,
 	"smsconfirmation.html": smsconfirmationHtml,
+	"device.html": deviceHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -317,6 +339,7 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"apidocumentation.html": &bintree{apidocumentationHtml, map[string]*bintree{}},
 	"base.html": &bintree{baseHtml, map[string]*bintree{}},
+	"device.html": &bintree{deviceHtml, map[string]*bintree{}},
 	"error.html": &bintree{errorHtml, map[string]*bintree{}},
 	"index.html": &bintree{indexHtml, map[string]*bintree{}},
 	"login.html": &bintree{loginHtml, map[string]*bintree{}},
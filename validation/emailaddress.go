@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/itsyouonline/identityserver/communication"
+	"github.com/itsyouonline/identityserver/db/validation"
+)
+
+//IYOEmailValidationService is the itsyou.online implementation of an EmailValidationService
+type IYOEmailValidationService struct {
+	EmailService communication.EmailService
+}
+
+//RequestValidation validates the email address by sending an email with a confirmation link
+func (service *IYOEmailValidationService) RequestValidation(request *http.Request, username string, emailaddress string, confirmationurl string) (key string, err error) {
+	valMngr := validation.NewManager(request)
+	info, err := valMngr.NewEmailAddressValidationInformation(username, emailaddress)
+	if err != nil {
+		return
+	}
+	err = valMngr.SaveEmailAddressValidationInformation(info)
+	if err != nil {
+		return
+	}
+	data := struct {
+		Username string
+		Link     string
+	}{
+		Username: username,
+		Link:     fmt.Sprintf("%s?c=%s&k=%s", confirmationurl, url.QueryEscape(info.Secret), url.QueryEscape(info.Key)),
+	}
+	go communication.SendTemplate(service.EmailService, []string{emailaddress}, communication.EmailAddressValidationEmail, data)
+	key = info.Key
+	return
+}
+
+//ExpireValidation removes a pending validation
+func (service *IYOEmailValidationService) ExpireValidation(request *http.Request, key string) (err error) {
+	if key == "" {
+		return
+	}
+	valMngr := validation.NewManager(request)
+	err = valMngr.RemoveEmailAddressValidationInformation(key)
+	return
+}
+
+func (service *IYOEmailValidationService) getEmailAddressValidationInformation(request *http.Request, key string) (info *validation.EmailAddressValidationInformation, err error) {
+	if key == "" {
+		return
+	}
+	valMngr := validation.NewManager(request)
+	info, err = valMngr.GetByKeyEmailAddressValidationInformation(key)
+	return
+}
+
+//IsConfirmed checks wether a validation request for an email address of a user is already confirmed
+func (service *IYOEmailValidationService) IsConfirmed(request *http.Request, key string, username string, emailaddress string) (confirmed bool, err error) {
+	info, err := service.getEmailAddressValidationInformation(request, key)
+	if err != nil {
+		return
+	}
+	if info == nil || !info.IsFor(username, emailaddress) {
+		err = ErrInvalidOrExpiredKey
+		return
+	}
+	confirmed = info.Confirmed
+	return
+}
+
+//ConfirmValidation checks if the supplied secret matches the key
+func (service *IYOEmailValidationService) ConfirmValidation(request *http.Request, key, secret string) (err error) {
+	info, err := service.getEmailAddressValidationInformation(request, key)
+	if err != nil {
+		return
+	}
+	if info == nil {
+		err = ErrInvalidOrExpiredKey
+		return
+	}
+	if info.Secret != secret {
+		err = ErrInvalidCode
+		return
+	}
+	valMngr := validation.NewManager(request)
+	e := valMngr.NewValidatedEmailAddress(info.Username, info.EmailAddress)
+	err = valMngr.SaveValidatedEmailAddress(e)
+	if err != nil {
+		return
+	}
+	err = valMngr.UpdateEmailAddressValidationInformation(key, true)
+	return
+}